@@ -528,6 +528,32 @@ func AutocompleteContainerOneArg(cmd *cobra.Command, args []string, toComplete s
 	return nil, cobra.ShellCompDirectiveNoFileComp
 }
 
+// AutocompleteVolumeOneArg - Autocomplete volumes as fist arg.
+func AutocompleteVolumeOneArg(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	if !validCurrentCmdLine(cmd, args, toComplete) {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+	if len(args) == 0 {
+		return getVolumes(cmd, toComplete)
+	}
+	return nil, cobra.ShellCompDirectiveNoFileComp
+}
+
+// AutocompleteVolumeImportCmd - Autocomplete podman volume import command args.
+func AutocompleteVolumeImportCmd(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	if !validCurrentCmdLine(cmd, args, toComplete) {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+	if len(args) == 0 {
+		return getVolumes(cmd, toComplete)
+	}
+	if len(args) == 1 {
+		return nil, cobra.ShellCompDirectiveDefault
+	}
+	// don't complete more than 2 args
+	return nil, cobra.ShellCompDirectiveNoFileComp
+}
+
 // AutocompleteNetworkConnectCmd - Autocomplete podman network connect/disconnect command args.
 func AutocompleteNetworkConnectCmd(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
 	if len(args) == 0 {
@@ -840,6 +866,12 @@ func AutocompleteJSONFormat(cmd *cobra.Command, args []string, toComplete string
 	return []string{"json"}, cobra.ShellCompDirectiveNoFileComp
 }
 
+// AutocompleteStatsOutputFormat - Autocomplete stats output-format flag options.
+// -> "json", "csv"
+func AutocompleteStatsOutputFormat(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	return []string{"json", "csv"}, cobra.ShellCompDirectiveNoFileComp
+}
+
 // AutocompleteEventFilter - Autocomplete event filter flag options.
 // -> "container=", "event=", "image=", "pod=", "volume=", "type="
 func AutocompleteEventFilter(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
@@ -933,7 +965,7 @@ func AutocompleteImageSaveFormat(cmd *cobra.Command, args []string, toComplete s
 // AutocompleteWaitCondition - Autocomplete wait condition options.
 // -> "unknown", "configured", "created", "running", "stopped", "paused", "exited", "removing"
 func AutocompleteWaitCondition(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
-	states := []string{"unknown", "configured", "created", "running", "stopped", "paused", "exited", "removing"}
+	states := []string{"unknown", "configured", "created", "running", "stopped", "paused", "exited", "removing", "healthy", "removed"}
 	return states, cobra.ShellCompDirectiveNoFileComp
 }
 
@@ -983,9 +1015,14 @@ func AutocompletePsFilters(cmd *cobra.Command, args []string, toComplete string)
 				define.HealthCheckUnhealthy}, cobra.ShellCompDirectiveNoFileComp
 		},
 		"network=": func(s string) ([]string, cobra.ShellCompDirective) { return getNetworks(cmd, s) },
-		"label=":   nil,
-		"exited=":  nil,
-		"until=":   nil,
+		"restart-policy=": func(_ string) ([]string, cobra.ShellCompDirective) {
+			return []string{libpod.RestartPolicyAlways, libpod.RestartPolicyNo,
+				libpod.RestartPolicyOnFailure, libpod.RestartPolicyUnlessStopped}, cobra.ShellCompDirectiveNoFileComp
+		},
+		"label=":         nil,
+		"exited=":        nil,
+		"restart-count=": nil,
+		"until=":         nil,
 	}
 	return completeKeyValues(toComplete, kv)
 }
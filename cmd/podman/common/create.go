@@ -584,6 +584,30 @@ func DefineCreateFlags(cmd *cobra.Command, cf *ContainerCLIOpts) {
 	)
 	_ = cmd.RegisterFlagCompletionFunc(restartFlagName, AutocompleteRestartOption)
 
+	restartDelayFlagName := "restart-delay"
+	createFlags.StringVar(
+		&cf.RestartDelay,
+		restartDelayFlagName, "",
+		"Delay before the container is restarted by its restart policy, doubled on each consecutive restart (0 restarts immediately)",
+	)
+	_ = cmd.RegisterFlagCompletionFunc(restartDelayFlagName, completion.AutocompleteNone)
+
+	restartMaxDelayFlagName := "restart-max-delay"
+	createFlags.StringVar(
+		&cf.RestartMaxDelay,
+		restartMaxDelayFlagName, "",
+		"Maximum delay before the container is restarted by its restart policy",
+	)
+	_ = cmd.RegisterFlagCompletionFunc(restartMaxDelayFlagName, completion.AutocompleteNone)
+
+	restartResetAfterFlagName := "restart-reset-after"
+	createFlags.StringVar(
+		&cf.RestartResetAfter,
+		restartResetAfterFlagName, "",
+		"Time the container must run for the restart delay to be reset",
+	)
+	_ = cmd.RegisterFlagCompletionFunc(restartResetAfterFlagName, completion.AutocompleteNone)
+
 	createFlags.BoolVar(
 		&cf.Rm,
 		"rm", false,
@@ -89,6 +89,9 @@ type ContainerCLIOpts struct {
 	ReadOnly          bool
 	ReadOnlyTmpFS     bool
 	Restart           string
+	RestartDelay      string
+	RestartMaxDelay   string
+	RestartResetAfter string
 	Replace           bool
 	Rm                bool
 	RootFS            bool
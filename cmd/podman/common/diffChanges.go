@@ -3,7 +3,10 @@ package common
 import (
 	"fmt"
 	"os"
+	"strconv"
 
+	"github.com/containers/common/pkg/report"
+	"github.com/containers/podman/v2/libpod/define"
 	"github.com/containers/podman/v2/pkg/domain/entities"
 	"github.com/containers/storage/pkg/archive"
 	"github.com/pkg/errors"
@@ -15,6 +18,25 @@ type ChangesReportJSON struct {
 	Deleted []string `json:"deleted,omitempty"`
 }
 
+// PrintDiffReport prints the changes, or their details if requested, in the
+// format of the options
+func PrintDiffReport(diffs *entities.DiffReport, options entities.DiffOptions) error {
+	switch {
+	case report.IsJSON(options.Format):
+		if options.Detail {
+			return ChangeDetailsToJSON(diffs)
+		}
+		return ChangesToJSON(diffs)
+	case options.Format == "":
+		if options.Detail {
+			return ChangeDetailsToTable(diffs)
+		}
+		return ChangesToTable(diffs)
+	default:
+		return errors.New("only supported value for '--format' is 'json'")
+	}
+}
+
 func ChangesToJSON(diffs *entities.DiffReport) error {
 	body := ChangesReportJSON{}
 	for _, row := range diffs.Changes {
@@ -41,3 +63,57 @@ func ChangesToTable(diffs *entities.DiffReport) error {
 	}
 	return nil
 }
+
+// ChangeDetailsToJSON prints the details of the changes as JSON
+func ChangeDetailsToJSON(diffs *entities.DiffReport) error {
+	details := diffs.Details
+	if details == nil {
+		details = []define.ChangeDetails{}
+	}
+	enc := json.NewEncoder(os.Stdout)
+	return enc.Encode(details)
+}
+
+// ChangeDetailsToTable prints the changes followed by the attributes of the
+// changed paths.  Added paths list their attributes, modified paths list the
+// attributes which changed.
+func ChangeDetailsToTable(diffs *entities.DiffReport) error {
+	for _, row := range diffs.Details {
+		change := archive.Change{Path: row.Path, Kind: row.Kind}
+		fmt.Fprintln(os.Stdout, change.String())
+		for _, line := range fileDetailsDiff(row.Before, row.After) {
+			fmt.Fprintf(os.Stdout, "    %s\n", line)
+		}
+	}
+	return nil
+}
+
+// fileDetailsDiff returns the attributes of the file which differ between
+// before and after.  If before is not set, all attributes of after are
+// returned.
+func fileDetailsDiff(before, after *define.FileDetails) []string {
+	if after == nil {
+		return nil
+	}
+	var lines []string
+	addLine := func(name, oldValue, newValue string) {
+		switch {
+		case before == nil:
+			if newValue != "" {
+				lines = append(lines, fmt.Sprintf("%s: %s", name, newValue))
+			}
+		case oldValue != newValue:
+			lines = append(lines, fmt.Sprintf("%s: %s -> %s", name, oldValue, newValue))
+		}
+	}
+	old := define.FileDetails{}
+	if before != nil {
+		old = *before
+	}
+	addLine("size", strconv.FormatInt(old.Size, 10), strconv.FormatInt(after.Size, 10))
+	addLine("mode", old.Mode, after.Mode)
+	addLine("owner", fmt.Sprintf("%d:%d", old.UID, old.GID), fmt.Sprintf("%d:%d", after.UID, after.GID))
+	addLine("digest", old.Digest, after.Digest)
+	addLine("link", old.LinkTarget, after.LinkTarget)
+	return lines
+}
@@ -638,6 +638,27 @@ func FillOutSpecGen(s *specgen.SpecGenerator, c *ContainerCLIOpts, args []string
 		}
 		s.RestartPolicy = splitRestart[0]
 	}
+	for _, d := range []struct {
+		flag  string
+		value string
+		dest  **time.Duration
+	}{
+		{"restart-delay", c.RestartDelay, &s.RestartDelay},
+		{"restart-max-delay", c.RestartMaxDelay, &s.RestartMaxDelay},
+		{"restart-reset-after", c.RestartResetAfter, &s.RestartResetAfter},
+	} {
+		if d.value == "" {
+			continue
+		}
+		if s.RestartPolicy == "" {
+			return errors.Errorf("--%s can only be used with a restart policy", d.flag)
+		}
+		duration, err := time.ParseDuration(d.value)
+		if err != nil {
+			return errors.Wrapf(err, "invalid value for --%s", d.flag)
+		}
+		*d.dest = &duration
+	}
 	s.Remove = c.Rm
 	s.StopTimeout = &c.StopTimeout
 	s.Timezone = c.Timezone
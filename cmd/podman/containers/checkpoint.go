@@ -32,6 +32,7 @@ var (
 		ValidArgsFunction: common.AutocompleteContainersRunning,
 		Example: `podman container checkpoint --keep ctrID
   podman container checkpoint --all
+  podman container checkpoint --create-image checkpoint-image ctrID
   podman container checkpoint --leave-running --latest`,
 	}
 )
@@ -56,6 +57,10 @@ func init() {
 	flags.StringVarP(&checkpointOptions.Export, exportFlagName, "e", "", "Export the checkpoint image to a tar.gz")
 	_ = checkpointCommand.RegisterFlagCompletionFunc(exportFlagName, completion.AutocompleteDefault)
 
+	createImageFlagName := "create-image"
+	flags.StringVar(&checkpointOptions.CreateImage, createImageFlagName, "", "Create checkpoint image with specified name")
+	_ = checkpointCommand.RegisterFlagCompletionFunc(createImageFlagName, completion.AutocompleteNone)
+
 	flags.BoolVar(&checkpointOptions.IgnoreRootFS, "ignore-rootfs", false, "Do not include root file-system changes when exporting")
 	flags.BoolVar(&checkpointOptions.IgnoreVolumes, "ignore-volumes", false, "Do not export volumes associated with container")
 	flags.BoolVarP(&checkpointOptions.PreCheckPoint, "pre-checkpoint", "P", false, "Dump container's memory information only, leave the container running")
@@ -69,11 +74,14 @@ func checkpoint(cmd *cobra.Command, args []string) error {
 	if rootless.IsRootless() {
 		return errors.New("checkpointing a container requires root")
 	}
-	if checkpointOptions.Export == "" && checkpointOptions.IgnoreRootFS {
-		return errors.Errorf("--ignore-rootfs can only be used with --export")
+	if checkpointOptions.Export == "" && checkpointOptions.CreateImage == "" && checkpointOptions.IgnoreRootFS {
+		return errors.Errorf("--ignore-rootfs can only be used with --export or --create-image")
+	}
+	if checkpointOptions.Export == "" && checkpointOptions.CreateImage == "" && checkpointOptions.IgnoreVolumes {
+		return errors.Errorf("--ignore-volumes can only be used with --export or --create-image")
 	}
-	if checkpointOptions.Export == "" && checkpointOptions.IgnoreVolumes {
-		return errors.Errorf("--ignore-volumes can only be used with --export")
+	if checkpointOptions.CreateImage != "" && checkpointOptions.PreCheckPoint {
+		return errors.Errorf("--create-image can not be used with --pre-checkpoint")
 	}
 	if checkpointOptions.WithPrevious && checkpointOptions.PreCheckPoint {
 		return errors.Errorf("--with-previous can not be used with --pre-checkpoint")
@@ -28,6 +28,7 @@ var (
 		Example: `podman commit -q --message "committing container to image" reverent_golick image-committed
   podman commit -q --author "firstName lastName" reverent_golick image-committed
   podman commit -q --pause=false containerID image-committed
+  podman commit --squash-new fedora:33 containerID image-committed
   podman commit containerID`,
 	}
 
@@ -41,6 +42,7 @@ var (
 		Example: `podman container commit -q --message "committing container to image" reverent_golick image-committed
   podman container commit -q --author "firstName lastName" reverent_golick image-committed
   podman container commit -q --pause=false containerID image-committed
+  podman container commit --squash-new fedora:33 containerID image-committed
   podman container commit containerID`,
 	}
 )
@@ -78,6 +80,11 @@ func commitFlags(cmd *cobra.Command) {
 	flags.BoolVarP(&commitOptions.Pause, "pause", "p", false, "Pause container during commit")
 	flags.BoolVarP(&commitOptions.Quiet, "quiet", "q", false, "Suppress output")
 	flags.BoolVar(&commitOptions.IncludeVolumes, "include-volumes", false, "Include container volumes as image volumes")
+	flags.BoolVar(&commitOptions.Squash, "squash", false, "Squash the changes of the container and all layers of its image into a single layer")
+
+	squashNewFlagName := "squash-new"
+	flags.StringVar(&commitOptions.SquashNew, squashNewFlagName, "", "Keep the layers of the base `image` and squash all layers on top of it into a single layer")
+	_ = cmd.RegisterFlagCompletionFunc(squashNewFlagName, common.AutocompleteImages)
 }
 
 func init() {
@@ -103,6 +110,9 @@ func commit(cmd *cobra.Command, args []string) error {
 	if !commitOptions.Quiet {
 		commitOptions.Writer = os.Stderr
 	}
+	if commitOptions.Squash && commitOptions.SquashNew != "" {
+		return errors.New("--squash and --squash-new cannot be used together")
+	}
 
 	response, err := registry.ContainerEngine().ContainerCommit(context.Background(), container, commitOptions)
 	if err != nil {
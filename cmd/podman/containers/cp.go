@@ -1,6 +1,7 @@
 package containers
 
 import (
+	"archive/tar"
 	"io"
 	"io/ioutil"
 	"os"
@@ -25,11 +26,11 @@ import (
 var (
 	cpDescription = `Copy the contents of SRC_PATH to the DEST_PATH.
 
-  You can copy from the container's file system to the local machine or the reverse, from the local filesystem to the container. If "-" is specified for either the SRC_PATH or DEST_PATH, you can also stream a tar archive from STDIN or to STDOUT. The CONTAINER can be a running or stopped container. The SRC_PATH or DEST_PATH can be a file or a directory.
+  You can copy from the container's file system to the local machine or the reverse, from the local filesystem to the container. You can also copy between the file systems of two containers. If "-" is specified for either the SRC_PATH or DEST_PATH, you can also stream a tar archive from STDIN or to STDOUT. The CONTAINER can be a running or stopped container. The SRC_PATH or DEST_PATH can be a file or a directory.
 `
 	cpCommand = &cobra.Command{
 		Use:               "cp [CONTAINER:]SRC_PATH [CONTAINER:]DEST_PATH",
-		Short:             "Copy files/folders between a container and the local filesystem",
+		Short:             "Copy files/folders between a container and the local filesystem or between containers",
 		Long:              cpDescription,
 		Args:              cobra.ExactArgs(2),
 		RunE:              cp,
@@ -82,6 +83,10 @@ func cp(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
+	if len(sourceContainerStr) > 0 && len(destContainerStr) > 0 {
+		return copyContainerToContainer(sourceContainerStr, sourcePath, destContainerStr, destPath)
+	}
+
 	if len(sourceContainerStr) > 0 {
 		return copyFromContainer(sourceContainerStr, sourcePath, destPath)
 	}
@@ -201,7 +206,7 @@ func copyFromContainer(container string, containerPath string, hostPath string)
 
 	containerCopy := func() error {
 		defer writer.Close()
-		copyFunc, err := registry.ContainerEngine().ContainerCopyToArchive(registry.GetContext(), container, containerInfo.LinkTarget, writer)
+		copyFunc, err := registry.ContainerEngine().ContainerCopyToArchive(registry.GetContext(), container, containerInfo.LinkTarget, writer, entities.ContainerCopyOptions{})
 		if err != nil {
 			return err
 		}
@@ -332,7 +337,7 @@ func copyToContainer(container string, containerPath string, hostPath string) er
 			target = filepath.Dir(target)
 		}
 
-		copyFunc, err := registry.ContainerEngine().ContainerCopyFromArchive(registry.GetContext(), container, target, reader)
+		copyFunc, err := registry.ContainerEngine().ContainerCopyFromArchive(registry.GetContext(), container, target, reader, entities.ContainerCopyOptions{})
 		if err != nil {
 			return err
 		}
@@ -345,6 +350,143 @@ func copyToContainer(container string, containerPath string, hostPath string) er
 	return doCopy(hostCopy, containerCopy)
 }
 
+// copyContainerToContainer copies the sourcePath on the sourceContainer to the
+// destPath on the destContainer.  The archive is streamed from one container
+// into the other, and the ownership of the copied files is translated between
+// the ID mappings of both containers.
+func copyContainerToContainer(sourceContainer string, sourcePath string, destContainer string, destPath string) error {
+	if err := containerMustExist(sourceContainer); err != nil {
+		return err
+	}
+	if err := containerMustExist(destContainer); err != nil {
+		return err
+	}
+
+	sourceInfo, err := registry.ContainerEngine().ContainerStat(registry.GetContext(), sourceContainer, sourcePath)
+	if err != nil {
+		return errors.Wrapf(err, "%q could not be found on container %s", sourcePath, sourceContainer)
+	}
+
+	// If the path on the destination container does not exist, we need to
+	// make sure that its parent directory exists.  The destination may be
+	// created while copying.
+	var destBaseName string
+	destInfo, destInfoErr := registry.ContainerEngine().ContainerStat(registry.GetContext(), destContainer, destPath)
+	if destInfoErr != nil {
+		if strings.HasSuffix(destPath, "/") {
+			return errors.Wrapf(destInfoErr, "%q could not be found on container %s", destPath, destContainer)
+		}
+		// NOTE: destInfo may actually be set.  That happens when the
+		// container path is a symlink into nirvana.  In that case, we
+		// must use the symlinked path instead.
+		path := destPath
+		if destInfo != nil {
+			destBaseName = filepath.Base(destInfo.LinkTarget)
+			path = destInfo.LinkTarget
+		} else {
+			destBaseName = filepath.Base(destPath)
+		}
+
+		parentDir, err := containerParentDir(destContainer, path)
+		if err != nil {
+			return errors.Wrapf(err, "could not determine parent dir of %q on container %s", path, destContainer)
+		}
+		destInfo, err = registry.ContainerEngine().ContainerStat(registry.GetContext(), destContainer, parentDir)
+		if err != nil {
+			return errors.Wrapf(err, "%q could not be found on container %s", destPath, destContainer)
+		}
+	} else {
+		// If the specified path exists on the container, we must use
+		// its base path as it may have changed due to symlink
+		// evaluations.
+		destBaseName = filepath.Base(destInfo.LinkTarget)
+	}
+
+	var rename map[string]string
+	if !sourceInfo.IsDir && (!destInfo.IsDir || destInfoErr != nil) {
+		// If we're having a file-to-file copy, make sure to rename
+		// accordingly.
+		rename = map[string]string{filepath.Base(sourceInfo.LinkTarget): destBaseName}
+	}
+
+	// Keep the ownership of the files.  It is translated from the ID
+	// mappings of the source container into the ones of the destination
+	// container.
+	copyOptions := entities.ContainerCopyOptions{CopyUIDGID: true}
+
+	reader, writer := io.Pipe()
+	sourceCopy := func() error {
+		defer writer.Close()
+		copyFunc, err := registry.ContainerEngine().ContainerCopyToArchive(registry.GetContext(), sourceContainer, sourceInfo.LinkTarget, writer, copyOptions)
+		if err != nil {
+			return err
+		}
+		if err := copyFunc(); err != nil {
+			return errors.Wrap(err, "error copying from container")
+		}
+		return nil
+	}
+
+	destCopy := func() error {
+		defer reader.Close()
+		var archiveReader io.Reader = reader
+		if rename != nil {
+			renamed := renameArchiveEntries(reader, rename)
+			defer renamed.Close()
+			archiveReader = renamed
+		}
+
+		target := destInfo.FileInfo.LinkTarget
+		if !destInfo.IsDir {
+			target = filepath.Dir(target)
+		}
+		copyFunc, err := registry.ContainerEngine().ContainerCopyFromArchive(registry.GetContext(), destContainer, target, archiveReader, copyOptions)
+		if err != nil {
+			return err
+		}
+		if err := copyFunc(); err != nil {
+			return errors.Wrap(err, "error copying to container")
+		}
+		return nil
+	}
+
+	return doCopy(sourceCopy, destCopy)
+}
+
+// renameArchiveEntries returns a stream of the tar archive read from reader
+// where the entries are renamed according to the rename map.  The archive is
+// rewritten on the fly, so it is never staged on the client.
+func renameArchiveEntries(reader io.Reader, rename map[string]string) io.ReadCloser {
+	pipeReader, pipeWriter := io.Pipe()
+	go func() {
+		tarReader := tar.NewReader(reader)
+		tarWriter := tar.NewWriter(pipeWriter)
+		for {
+			header, err := tarReader.Next()
+			if err == io.EOF {
+				pipeWriter.CloseWithError(tarWriter.Close())
+				return
+			}
+			if err != nil {
+				pipeWriter.CloseWithError(err)
+				return
+			}
+			if name, ok := rename[header.Name]; ok {
+				header.Name = name
+			}
+			if err := tarWriter.WriteHeader(header); err != nil {
+				pipeWriter.CloseWithError(err)
+				return
+			}
+			if _, err := io.Copy(tarWriter, tarReader); err != nil {
+				pipeWriter.CloseWithError(err)
+				return
+			}
+		}
+	}()
+	return pipeReader
+}
+
 // containerParentDir returns the parent directory of the specified path on the
 // container.  If the path is relative, it will be resolved relative to the
 // container's working directory (or "/" if the work dir isn't set).
@@ -1,7 +1,6 @@
 package containers
 
 import (
-	"github.com/containers/common/pkg/report"
 	"github.com/containers/podman/v2/cmd/podman/common"
 	"github.com/containers/podman/v2/cmd/podman/registry"
 	"github.com/containers/podman/v2/cmd/podman/validate"
@@ -13,14 +12,15 @@ import (
 var (
 	// podman container _diff_
 	diffCmd = &cobra.Command{
-		Use:               "diff [options] CONTAINER",
-		Args:              validate.IDOrLatestArgs,
+		Use:               "diff [options] [CONTAINER|IMAGE] CONTAINER",
+		Args:              validate.DiffArgs,
 		Short:             "Inspect changes to the container's file systems",
-		Long:              `Displays changes to the container filesystem's'.  The container will be compared to its parent layer.`,
+		Long:              `Displays changes to the container filesystem's'.  The container will be compared to its parent layer or, if specified, to the container or image given before it.`,
 		RunE:              diff,
 		ValidArgsFunction: common.AutocompleteContainers,
 		Example: `podman container diff myCtr
-  podman container diff -l --format json myCtr`,
+  podman container diff -l --format json myCtr
+  podman container diff --detail myImage myCtr`,
 	}
 	diffOpts *entities.DiffOptions
 )
@@ -41,6 +41,8 @@ func init() {
 	flags.StringVar(&diffOpts.Format, formatFlagName, "", "Change the output format")
 	_ = diffCmd.RegisterFlagCompletionFunc(formatFlagName, common.AutocompleteJSONFormat)
 
+	flags.BoolVar(&diffOpts.Detail, "detail", false, "Show the size, mode, ownership and content digest of the changed paths")
+
 	validate.AddLatestFlag(diffCmd, &diffOpts.Latest)
 }
 
@@ -49,6 +51,12 @@ func diff(cmd *cobra.Command, args []string) error {
 		return errors.New("container must be specified: podman container diff [options [...]] ID-NAME")
 	}
 
+	// If two objects are given, the changes from the first to the second
+	// one are shown.
+	if len(args) == 2 || (len(args) == 1 && diffOpts.Latest) {
+		diffOpts.Parent = args[0]
+		args = args[1:]
+	}
 	var id string
 	if len(args) > 0 {
 		id = args[0]
@@ -57,15 +65,7 @@ func diff(cmd *cobra.Command, args []string) error {
 	if err != nil {
 		return err
 	}
-
-	switch {
-	case report.IsJSON(diffOpts.Format):
-		return common.ChangesToJSON(results)
-	case diffOpts.Format == "":
-		return common.ChangesToTable(results)
-	default:
-		return errors.New("only supported value for '--format' is 'json'")
-	}
+	return common.PrintDiffReport(results, *diffOpts)
 }
 
 func Diff(cmd *cobra.Command, args []string, options entities.DiffOptions) error {
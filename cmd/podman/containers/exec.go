@@ -21,7 +21,7 @@ var (
 	execDescription = `Execute the specified command inside a running container.
 `
 	execCommand = &cobra.Command{
-		Use:                   "exec [options] CONTAINER [COMMAND [ARG...]]",
+		Use:                   "exec [options] {CONTAINER [COMMAND [ARG...]] | --attach SESSION}",
 		Short:                 "Run a process in a running container",
 		Long:                  execDescription,
 		RunE:                  exec,
@@ -29,7 +29,8 @@ var (
 		ValidArgsFunction:     common.AutocompleteExecCommand,
 		Example: `podman exec -it ctrID ls
   podman exec -it -w /tmp myCtr pwd
-  podman exec --user root ctrID ls`,
+  podman exec --user root ctrID ls
+  podman exec --attach sessionID`,
 	}
 
 	containerExecCommand = &cobra.Command{
@@ -41,7 +42,8 @@ var (
 		ValidArgsFunction:     execCommand.ValidArgsFunction,
 		Example: `podman container exec -it ctrID ls
   podman container exec -it -w /tmp myCtr pwd
-  podman container exec --user root ctrID ls`,
+  podman container exec --user root ctrID ls
+  podman container exec --attach sessionID`,
 	}
 )
 
@@ -49,6 +51,7 @@ var (
 	envInput, envFile []string
 	execOpts          entities.ExecOptions
 	execDetach        bool
+	execAttachSession string
 )
 
 func execFlags(cmd *cobra.Command) {
@@ -57,6 +60,10 @@ func execFlags(cmd *cobra.Command) {
 	flags.SetInterspersed(false)
 	flags.BoolVarP(&execDetach, "detach", "d", false, "Run the exec session in detached mode (backgrounded)")
 
+	attachFlagName := "attach"
+	flags.StringVar(&execAttachSession, attachFlagName, "", "Attach to the running exec session with the given ID")
+	_ = cmd.RegisterFlagCompletionFunc(attachFlagName, completion.AutocompleteNone)
+
 	detachKeysFlagName := "detach-keys"
 	flags.StringVar(&execOpts.DetachKeys, detachKeysFlagName, containerConfig.DetachKeys(), "Select the key sequence for detaching a container. Format is a single character [a-Z] or ctrl-<value> where <value> is one of: a-z, @, ^, [, , or _")
 	_ = cmd.RegisterFlagCompletionFunc(detachKeysFlagName, common.AutocompleteDetachKeys)
@@ -71,6 +78,7 @@ func execFlags(cmd *cobra.Command) {
 
 	flags.BoolVarP(&execOpts.Interactive, "interactive", "i", false, "Keep STDIN open even if not attached")
 	flags.BoolVar(&execOpts.Privileged, "privileged", false, "Give the process extended Linux capabilities inside the container.  The default is false")
+	flags.BoolVar(&execOpts.RmSession, "rm-session", true, "Remove the exec session once it exits, when running detached or attaching to a session")
 	flags.BoolVarP(&execOpts.Tty, "tty", "t", false, "Allocate a pseudo-TTY. The default is false")
 
 	userFlagName := "user"
@@ -107,9 +115,12 @@ func init() {
 	validate.AddLatestFlag(containerExecCommand, &execOpts.Latest)
 }
 
-func exec(_ *cobra.Command, args []string) error {
+func exec(cmd *cobra.Command, args []string) error {
 	var nameOrID string
 
+	if execAttachSession != "" {
+		return execAttach(cmd, args)
+	}
 	if len(args) == 0 && !execOpts.Latest {
 		return errors.New("exec requires the name or ID of a container or the --latest flag")
 	}
@@ -164,3 +175,40 @@ func exec(_ *cobra.Command, args []string) error {
 	fmt.Println(id)
 	return nil
 }
+
+// execAttach attaches to the running exec session given with --attach.
+func execAttach(cmd *cobra.Command, args []string) error {
+	if len(args) > 0 {
+		return errors.New("--attach takes the ID of an exec session and no further arguments")
+	}
+	if execDetach || execOpts.Latest {
+		return errors.New("--attach cannot be used with --detach or --latest")
+	}
+	for _, name := range []string{"env", "env-file", "privileged", "tty", "user", "preserve-fds", "workdir"} {
+		if cmd.Flags().Changed(name) {
+			return errors.Errorf("--%s cannot be used with --attach as the exec session is already running", name)
+		}
+	}
+
+	streams := define.AttachStreams{}
+	streams.OutputStream = os.Stdout
+	streams.ErrorStream = os.Stderr
+	if execOpts.Interactive {
+		streams.InputStream = bufio.NewReader(os.Stdin)
+		streams.AttachInput = true
+	}
+	streams.AttachOutput = true
+	streams.AttachError = true
+
+	attachOpts := entities.ExecAttachOptions{
+		Interactive: execOpts.Interactive,
+		RmSession:   execOpts.RmSession,
+	}
+	if cmd.Flags().Changed("detach-keys") {
+		attachOpts.DetachKeys = &execOpts.DetachKeys
+	}
+
+	exitCode, err := registry.ContainerEngine().ContainerExecAttach(registry.GetContext(), execAttachSession, attachOpts, streams)
+	registry.SetExitCode(exitCode)
+	return err
+}
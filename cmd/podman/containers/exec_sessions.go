@@ -0,0 +1,154 @@
+package containers
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+	"text/template"
+
+	"github.com/containers/common/pkg/report"
+	"github.com/containers/podman/v2/cmd/podman/common"
+	"github.com/containers/podman/v2/cmd/podman/parse"
+	"github.com/containers/podman/v2/cmd/podman/registry"
+	"github.com/containers/podman/v2/cmd/podman/validate"
+	"github.com/containers/podman/v2/libpod/define"
+	"github.com/containers/podman/v2/pkg/domain/entities"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+)
+
+var (
+	execSessionsCommand = &cobra.Command{
+		Use:   "exec-sessions",
+		Short: "Manage the exec sessions of a container",
+		Long:  "Manage the exec sessions of a container",
+		RunE:  validate.SubCommandExists,
+	}
+
+	execSessionsLsDescription = `List the exec sessions of a container.
+
+  Sessions that exited are listed with their exit code until they are removed.`
+	execSessionsLsCommand = &cobra.Command{
+		Use:               "ls [options] CONTAINER",
+		Aliases:           []string{"list"},
+		Short:             "List the exec sessions of a container",
+		Long:              execSessionsLsDescription,
+		RunE:              execSessionsLs,
+		Args:              validate.IDOrLatestArgs,
+		ValidArgsFunction: common.AutocompleteContainers,
+		Example: `podman container exec-sessions ls ctrID
+  podman container exec-sessions ls --format "{{.ID}} {{.ExitCode}}" ctrID
+  podman container exec-sessions ls --latest`,
+	}
+)
+
+var (
+	execSessionsLsOpts = struct {
+		Format string
+		Quiet  bool
+	}{}
+	execSessionsOpts entities.ContainerExecSessionsOptions
+)
+
+type execSessionReporter struct {
+	*define.InspectExecSession
+}
+
+func init() {
+	registry.Commands = append(registry.Commands, registry.CliCommand{
+		Mode:    []entities.EngineMode{entities.ABIMode, entities.TunnelMode},
+		Command: execSessionsCommand,
+		Parent:  containerCmd,
+	})
+	registry.Commands = append(registry.Commands, registry.CliCommand{
+		Mode:    []entities.EngineMode{entities.ABIMode, entities.TunnelMode},
+		Command: execSessionsLsCommand,
+		Parent:  execSessionsCommand,
+	})
+
+	flags := execSessionsLsCommand.Flags()
+
+	formatFlagName := "format"
+	flags.StringVar(&execSessionsLsOpts.Format, formatFlagName, "", "Pretty-print exec sessions to JSON or using a Go template")
+	_ = execSessionsLsCommand.RegisterFlagCompletionFunc(formatFlagName, common.AutocompleteJSONFormat)
+
+	flags.BoolVarP(&execSessionsLsOpts.Quiet, "quiet", "q", false, "Print only the exec session IDs")
+	validate.AddLatestFlag(execSessionsLsCommand, &execSessionsOpts.Latest)
+}
+
+func execSessionsLs(cmd *cobra.Command, args []string) error {
+	if execSessionsLsOpts.Quiet && cmd.Flag("format").Changed {
+		return errors.New("quiet and format flags cannot be used together")
+	}
+	var nameOrID string
+	if len(args) > 0 {
+		nameOrID = args[0]
+	}
+
+	sessions, err := registry.ContainerEngine().ContainerExecSessions(registry.GetContext(), nameOrID, execSessionsOpts)
+	if err != nil {
+		return err
+	}
+
+	if report.IsJSON(execSessionsLsOpts.Format) {
+		b, err := json.MarshalIndent(sessions, "", "  ")
+		if err != nil {
+			return err
+		}
+		fmt.Println(string(b))
+		return nil
+	}
+
+	rows := make([]execSessionReporter, 0, len(sessions))
+	for _, s := range sessions {
+		rows = append(rows, execSessionReporter{s})
+	}
+
+	headers := report.Headers(execSessionReporter{}, map[string]string{
+		"ID":      "SESSION ID",
+		"Command": "COMMAND",
+		"Status":  "STATUS",
+		"Pid":     "PID",
+	})
+
+	row := "{{.ID}}\t{{.Command}}\t{{.Status}}\t{{.Pid}}\n"
+	switch {
+	case execSessionsLsOpts.Quiet:
+		row = "{{.ID}}\n"
+	case cmd.Flag("format").Changed:
+		row = report.NormalizeFormat(execSessionsLsOpts.Format)
+	}
+	format := parse.EnforceRange(row)
+
+	tmpl, err := template.New("list exec sessions").Parse(format)
+	if err != nil {
+		return err
+	}
+	w := tabwriter.NewWriter(os.Stdout, 12, 2, 2, ' ', 0)
+	defer w.Flush()
+
+	if !execSessionsLsOpts.Quiet && !cmd.Flag("format").Changed {
+		if err := tmpl.Execute(w, headers); err != nil {
+			return errors.Wrapf(err, "failed to write report column headers")
+		}
+	}
+	return tmpl.Execute(w, rows)
+}
+
+// Command returns the command line of the exec session.
+func (s execSessionReporter) Command() string {
+	if s.ProcessConfig == nil {
+		return ""
+	}
+	return strings.Join(append([]string{s.ProcessConfig.Entrypoint}, s.ProcessConfig.Arguments...), " ")
+}
+
+// Status returns whether the exec session is running or, if it exited, its
+// exit code.
+func (s execSessionReporter) Status() string {
+	if s.Running {
+		return "Running"
+	}
+	return fmt.Sprintf("Exited (%d)", s.ExitCode)
+}
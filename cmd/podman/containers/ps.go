@@ -186,6 +186,13 @@ func ps(cmd *cobra.Command, _ []string) error {
 		return err
 	}
 	for _, f := range filters {
+		// restart-count filters compare the count with an operator
+		// instead of just matching it, e.g. restart-count>2.
+		if strings.HasPrefix(f, "restart-count") {
+			value := strings.TrimPrefix(strings.TrimPrefix(f, "restart-count"), "=")
+			listOpts.Filters["restart-count"] = append(listOpts.Filters["restart-count"], value)
+			continue
+		}
 		split := strings.SplitN(f, "=", 2)
 		if len(split) == 1 {
 			return errors.Errorf("invalid filter %q", f)
@@ -348,11 +355,18 @@ func (l psReporter) State() string {
 	case "running":
 		t := units.HumanDuration(time.Since(time.Unix(l.StartedAt, 0)))
 		state = "Up " + t + " ago"
+		if l.Health != "" {
+			state += " (" + l.Health + ")"
+		}
 	case "configured":
 		state = "Created"
 	case "exited", "stopped":
 		t := units.HumanDuration(time.Since(time.Unix(l.ExitedAt, 0)))
-		state = fmt.Sprintf("Exited (%d) %s ago", l.ExitCode, t)
+		if l.Restarting {
+			state = fmt.Sprintf("Restarting (%d) %s ago", l.ExitCode, t)
+		} else {
+			state = fmt.Sprintf("Exited (%d) %s ago", l.ExitCode, t)
+		}
 	default:
 		state = l.ListContainer.State
 	}
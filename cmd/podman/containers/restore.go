@@ -20,9 +20,10 @@ var (
    podman container restore
 
    Restores a container from a checkpoint. The container name or ID can be used.
+   The name or ID of a checkpoint image created with 'podman container checkpoint --create-image' can be used as well.
 `
 	restoreCommand = &cobra.Command{
-		Use:   "restore [options] CONTAINER [CONTAINER...]",
+		Use:   "restore [options] CONTAINER|IMAGE [CONTAINER|IMAGE...]",
 		Short: "Restores one or more containers from a checkpoint",
 		Long:  restoreDescription,
 		RunE:  restore,
@@ -32,7 +33,8 @@ var (
 		ValidArgsFunction: common.AutocompleteContainers,
 		Example: `podman container restore ctrID
   podman container restore --latest
-  podman container restore --all`,
+  podman container restore --all
+  podman container restore checkpoint-image`,
 	}
 )
 
@@ -78,14 +80,17 @@ func restore(_ *cobra.Command, args []string) error {
 	if restoreOptions.Import == "" && restoreOptions.ImportPrevious != "" {
 		return errors.Errorf("--import-previous can only be used with --import")
 	}
-	if restoreOptions.Import == "" && restoreOptions.IgnoreRootFS {
-		return errors.Errorf("--ignore-rootfs can only be used with --import")
+	// Checkpoint images are given as positional arguments, so the
+	// import-only options are checked by the engine in that case.
+	importOnly := restoreOptions.Import == "" && (restoreOptions.All || restoreOptions.Latest)
+	if importOnly && restoreOptions.IgnoreRootFS {
+		return errors.Errorf("--ignore-rootfs can only be used with --import or a checkpoint image")
 	}
-	if restoreOptions.Import == "" && restoreOptions.IgnoreVolumes {
-		return errors.Errorf("--ignore-volumes can only be used with --import")
+	if importOnly && restoreOptions.IgnoreVolumes {
+		return errors.Errorf("--ignore-volumes can only be used with --import or a checkpoint image")
 	}
-	if restoreOptions.Import == "" && restoreOptions.Name != "" {
-		return errors.Errorf("--name can only be used with --import")
+	if importOnly && restoreOptions.Name != "" {
+		return errors.Errorf("--name can only be used with --import or a checkpoint image")
 	}
 	if restoreOptions.Name != "" && restoreOptions.TCPEstablished {
 		return errors.Errorf("--tcp-established cannot be used with --name")
@@ -3,6 +3,7 @@ package containers
 import (
 	"fmt"
 	"os"
+	"strings"
 
 	"github.com/containers/podman/v2/cmd/podman/common"
 	"github.com/containers/podman/v2/cmd/podman/registry"
@@ -25,7 +26,8 @@ var (
 		ValidArgsFunction: common.AutocompleteContainersStartable,
 		Example: `podman start --latest
   podman start 860a4b231279 5421ab43b45
-  podman start --interactive --attach imageID`,
+  podman start --interactive --attach imageID
+  podman start --all --filter restart-policy=always`,
 	}
 
 	containerStartCommand = &cobra.Command{
@@ -37,23 +39,30 @@ var (
 		ValidArgsFunction: startCommand.ValidArgsFunction,
 		Example: `podman container start --latest
   podman container start 860a4b231279 5421ab43b45
-  podman container start --interactive --attach imageID`,
+  podman container start --interactive --attach imageID
+  podman container start --all --filter restart-policy=always`,
 	}
 )
 
 var (
 	startOptions entities.ContainerStartOptions
+	startFilters []string
 )
 
 func startFlags(cmd *cobra.Command) {
 	flags := cmd.Flags()
 
+	flags.BoolVar(&startOptions.All, "all", false, "Start all containers regardless of their state or configuration")
 	flags.BoolVarP(&startOptions.Attach, "attach", "a", false, "Attach container's STDOUT and STDERR")
 
 	detachKeysFlagName := "detach-keys"
 	flags.StringVar(&startOptions.DetachKeys, detachKeysFlagName, containerConfig.DetachKeys(), "Select the key sequence for detaching a container. Format is a single character `[a-Z]` or a comma separated sequence of `ctrl-<value>`, where `<value>` is one of: `a-z`, `@`, `^`, `[`, `\\`, `]`, `^` or `_`")
 	_ = cmd.RegisterFlagCompletionFunc(detachKeysFlagName, common.AutocompleteDetachKeys)
 
+	filterFlagName := "filter"
+	flags.StringSliceVarP(&startFilters, filterFlagName, "f", []string{}, "Filter the containers to start when using --all")
+	_ = cmd.RegisterFlagCompletionFunc(filterFlagName, common.AutocompletePsFilters)
+
 	flags.BoolVarP(&startOptions.Interactive, "interactive", "i", false, "Keep STDIN open even if not attached")
 	flags.BoolVar(&startOptions.SigProxy, "sig-proxy", false, "Proxy received signals to the process (default true if attaching, false otherwise)")
 
@@ -79,12 +88,21 @@ func init() {
 }
 
 func validateStart(cmd *cobra.Command, args []string) error {
-	if len(args) == 0 && !startOptions.Latest {
+	if len(args) == 0 && !startOptions.Latest && !startOptions.All {
 		return errors.New("start requires at least one argument")
 	}
 	if len(args) > 0 && startOptions.Latest {
 		return errors.Errorf("--latest and containers cannot be used together")
 	}
+	if startOptions.All && (len(args) > 0 || startOptions.Latest) {
+		return errors.Errorf("--all, --latest and containers cannot be used together")
+	}
+	if startOptions.All && startOptions.Attach {
+		return errors.Errorf("--all and --attach cannot be used together")
+	}
+	if len(startFilters) > 0 && !startOptions.All {
+		return errors.Errorf("--filter can only be used with --all")
+	}
 	if len(args) > 1 && startOptions.Attach {
 		return errors.Errorf("you cannot start and attach multiple containers at once")
 	}
@@ -102,6 +120,14 @@ func start(cmd *cobra.Command, args []string) error {
 	if sigProxy && !startOptions.Attach {
 		return errors.Wrapf(define.ErrInvalidArg, "you cannot use sig-proxy without --attach")
 	}
+	startOptions.Filters = make(map[string][]string)
+	for _, f := range startFilters {
+		split := strings.SplitN(f, "=", 2)
+		if len(split) == 1 {
+			return errors.Errorf("invalid filter %q", f)
+		}
+		startOptions.Filters[split[0]] = append(startOptions.Filters[split[0]], split[1])
+	}
 	if startOptions.Attach {
 		startOptions.Stdin = os.Stdin
 		startOptions.Stderr = os.Stderr
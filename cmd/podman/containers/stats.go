@@ -1,12 +1,16 @@
 package containers
 
 import (
+	"encoding/csv"
 	"fmt"
 	"os"
+	"strconv"
 	"text/tabwriter"
 	"text/template"
+	"time"
 
 	tm "github.com/buger/goterm"
+	"github.com/containers/common/pkg/completion"
 	"github.com/containers/common/pkg/report"
 	"github.com/containers/podman/v2/cmd/podman/common"
 	"github.com/containers/podman/v2/cmd/podman/parse"
@@ -34,7 +38,8 @@ var (
 		ValidArgsFunction: common.AutocompleteContainersRunning,
 		Example: `podman stats --all --no-stream
   podman stats ctrID
-  podman stats --no-stream --format "table {{.ID}} {{.Name}} {{.MemUsage}}" ctrID`,
+  podman stats --no-stream --format "table {{.ID}} {{.Name}} {{.MemUsage}}" ctrID
+  podman stats --interval 10s --output stats.csv --output-format csv ctrID`,
 	}
 
 	containerStatsCommand = &cobra.Command{
@@ -53,11 +58,14 @@ var (
 // statsOptionsCLI is used for storing CLI arguments. Some fields are later
 // used in the backend.
 type statsOptionsCLI struct {
-	All      bool
-	Format   string
-	Latest   bool
-	NoReset  bool
-	NoStream bool
+	All          bool
+	Format       string
+	Interval     time.Duration
+	Latest       bool
+	NoReset      bool
+	NoStream     bool
+	Output       string
+	OutputFormat string
 }
 
 var (
@@ -73,8 +81,20 @@ func statFlags(cmd *cobra.Command) {
 	flags.StringVar(&statsOptions.Format, formatFlagName, "", "Pretty-print container statistics to JSON or using a Go template")
 	_ = cmd.RegisterFlagCompletionFunc(formatFlagName, common.AutocompleteJSONFormat)
 
+	intervalFlagName := "interval"
+	flags.DurationVar(&statsOptions.Interval, intervalFlagName, time.Second, "Time between two samples when streaming stats")
+	_ = cmd.RegisterFlagCompletionFunc(intervalFlagName, completion.AutocompleteNone)
+
 	flags.BoolVar(&statsOptions.NoReset, "no-reset", false, "Disable resetting the screen between intervals")
 	flags.BoolVar(&statsOptions.NoStream, "no-stream", false, "Disable streaming stats and only pull the first result, default setting is false")
+
+	outputFlagName := "output"
+	flags.StringVar(&statsOptions.Output, outputFlagName, "", "Append the samples to a file instead of displaying them")
+	_ = cmd.RegisterFlagCompletionFunc(outputFlagName, completion.AutocompleteDefault)
+
+	outputFormatFlagName := "output-format"
+	flags.StringVar(&statsOptions.OutputFormat, outputFormatFlagName, "json", "Format of the samples appended to the output file (json, csv)")
+	_ = cmd.RegisterFlagCompletionFunc(outputFormatFlagName, common.AutocompleteStatsOutputFormat)
 }
 
 func init() {
@@ -110,6 +130,17 @@ func checkStatOptions(cmd *cobra.Command, args []string) error {
 	if opts > 1 {
 		return errors.Errorf("--all, --latest and containers cannot be used together")
 	}
+	if statsOptions.Interval < time.Second {
+		return errors.Errorf("--interval must be at least one second")
+	}
+	if statsOptions.Output != "" && statsOptions.Format != "" {
+		return errors.Errorf("--output and --format cannot be used together")
+	}
+	switch statsOptions.OutputFormat {
+	case "json", "csv":
+	default:
+		return errors.Errorf("invalid --output-format %q, must be json or csv", statsOptions.OutputFormat)
+	}
 	return nil
 }
 
@@ -127,9 +158,21 @@ func stats(cmd *cobra.Command, args []string) error {
 	// Convert to the entities options.  We should not leak CLI-only
 	// options into the backend and separate concerns.
 	opts := entities.ContainerStatsOptions{
-		Latest: statsOptions.Latest,
-		Stream: !statsOptions.NoStream,
+		Latest:   statsOptions.Latest,
+		Stream:   !statsOptions.NoStream,
+		Interval: statsOptions.Interval,
 	}
+
+	var recorder *statsRecorder
+	if statsOptions.Output != "" {
+		var err error
+		recorder, err = newStatsRecorder(statsOptions.Output, statsOptions.OutputFormat)
+		if err != nil {
+			return err
+		}
+		defer recorder.Close()
+	}
+
 	statsChan, err := registry.ContainerEngine().ContainerStats(registry.Context(), args, opts)
 	if err != nil {
 		return err
@@ -138,6 +181,12 @@ func stats(cmd *cobra.Command, args []string) error {
 		if report.Error != nil {
 			return report.Error
 		}
+		if recorder != nil {
+			if err := recorder.Record(report.Stats); err != nil {
+				return err
+			}
+			continue
+		}
 		if err := outputStats(report.Stats); err != nil {
 			logrus.Error(err)
 		}
@@ -286,3 +335,87 @@ func outputJSON(stats []containerStats) error {
 	fmt.Println(string(b))
 	return nil
 }
+
+// statsRecorder appends stats samples to a file, either as JSON lines or as
+// CSV records.
+type statsRecorder struct {
+	file   *os.File
+	format string
+	csv    *csv.Writer
+}
+
+// statsRecord is a single JSON line written by a statsRecorder.
+type statsRecord struct {
+	Time time.Time `json:"time"`
+	define.ContainerStats
+}
+
+var statsCSVHeader = []string{
+	"time", "id", "name", "cpu_percent", "cpu_nano", "cpu_system_nano",
+	"mem_usage", "mem_limit", "mem_percent", "mem_cache", "mem_rss", "mem_swap",
+	"net_input", "net_output", "block_input", "block_output", "pids",
+}
+
+func newStatsRecorder(path, format string) (*statsRecorder, error) {
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error opening stats output file %s", path)
+	}
+	r := &statsRecorder{file: f, format: format}
+	if format != "csv" {
+		return r, nil
+	}
+	r.csv = csv.NewWriter(f)
+	info, err := f.Stat()
+	if err != nil {
+		f.Close()
+		return nil, errors.Wrapf(err, "error getting size of stats output file %s", path)
+	}
+	// Only write the header when starting a new file, so that recordings
+	// can be resumed.
+	if info.Size() == 0 {
+		if err := r.csv.Write(statsCSVHeader); err != nil {
+			f.Close()
+			return nil, err
+		}
+		r.csv.Flush()
+	}
+	return r, r.csv.Error()
+}
+
+// Record appends one sample per container.
+func (r *statsRecorder) Record(reports []define.ContainerStats) error {
+	for _, s := range reports {
+		t := time.Unix(0, int64(s.SystemNano)).UTC()
+		if r.csv == nil {
+			b, err := json.Marshal(statsRecord{Time: t, ContainerStats: s})
+			if err != nil {
+				return err
+			}
+			if _, err := r.file.Write(append(b, '\n')); err != nil {
+				return errors.Wrapf(err, "error writing to stats output file %s", r.file.Name())
+			}
+			continue
+		}
+		u := func(v uint64) string { return strconv.FormatUint(v, 10) }
+		f := func(v float64) string { return strconv.FormatFloat(v, 'f', 2, 64) }
+		if err := r.csv.Write([]string{
+			t.Format(time.RFC3339Nano), s.ContainerID, s.Name,
+			f(s.CPU), u(s.CPUNano), u(s.CPUSystemNano),
+			u(s.MemUsage), u(s.MemLimit), f(s.MemPerc), u(s.MemCache), u(s.MemRSS), u(s.MemSwap),
+			u(s.NetInput), u(s.NetOutput), u(s.BlockInput), u(s.BlockOutput), u(s.PIDs),
+		}); err != nil {
+			return errors.Wrapf(err, "error writing to stats output file %s", r.file.Name())
+		}
+	}
+	if r.csv != nil {
+		r.csv.Flush()
+		return r.csv.Error()
+	}
+	return nil
+}
+
+// Close closes the output file.
+func (r *statsRecorder) Close() error {
+	return r.file.Close()
+}
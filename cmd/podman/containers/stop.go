@@ -68,6 +68,11 @@ func stopFlags(cmd *cobra.Command) {
 	if registry.IsRemote() {
 		_ = flags.MarkHidden("cidfile")
 		_ = flags.MarkHidden("ignore")
+	} else {
+		// Used by podman-restart.service, so that containers with the
+		// unless-stopped restart policy are started again at boot.
+		flags.BoolVar(&stopOptions.Shutdown, "system-shutdown", false, "Do not record the stop as an explicit stop by the user")
+		_ = flags.MarkHidden("system-shutdown")
 	}
 	flags.SetNormalizeFunc(utils.AliasFlags)
 }
@@ -17,7 +17,7 @@ import (
 )
 
 var (
-	waitDescription = `Block until one or more containers stop and then print their exit codes.
+	waitDescription = `Block until one or more containers stop, or meet one of the given conditions, and then print their exit codes.
 `
 	waitCommand = &cobra.Command{
 		Use:               "wait [options] CONTAINER [CONTAINER...]",
@@ -26,7 +26,8 @@ var (
 		RunE:              wait,
 		ValidArgsFunction: common.AutocompleteContainers,
 		Example: `podman wait --interval 5s ctrID
-  podman wait ctrID1 ctrID2`,
+  podman wait ctrID1 ctrID2
+  podman wait --condition healthy --condition stopped ctrID`,
 	}
 
 	containerWaitCommand = &cobra.Command{
@@ -36,25 +37,25 @@ var (
 		RunE:              waitCommand.RunE,
 		ValidArgsFunction: waitCommand.ValidArgsFunction,
 		Example: `podman container wait --interval 5s ctrID
-  podman container wait ctrID1 ctrID2`,
+  podman container wait ctrID1 ctrID2
+  podman container wait --condition healthy --condition stopped ctrID`,
 	}
 )
 
 var (
-	waitOptions   = entities.WaitOptions{}
-	waitCondition string
-	waitInterval  string
+	waitOptions  = entities.WaitOptions{}
+	waitInterval string
 )
 
 func waitFlags(cmd *cobra.Command) {
 	flags := cmd.Flags()
 
 	intervalFlagName := "interval"
-	flags.StringVarP(&waitInterval, intervalFlagName, "i", "250ns", "Time Interval to wait before polling for completion")
+	flags.StringVarP(&waitInterval, intervalFlagName, "i", "250ns", "Time Interval to wait before polling for completion if events cannot be read")
 	_ = cmd.RegisterFlagCompletionFunc(intervalFlagName, completion.AutocompleteNone)
 
 	conditionFlagName := "condition"
-	flags.StringVar(&waitCondition, conditionFlagName, "stopped", "Condition to wait on")
+	flags.StringSliceVar(&waitOptions.Condition, conditionFlagName, []string{define.ContainerStateStopped.String()}, "Conditions to wait on, the first one met ends the wait")
 	_ = cmd.RegisterFlagCompletionFunc(conditionFlagName, common.AutocompleteWaitCondition)
 
 }
@@ -95,9 +96,10 @@ func wait(cmd *cobra.Command, args []string) error {
 		return errors.New("--latest and containers cannot be used together")
 	}
 
-	waitOptions.Condition, err = define.StringToContainerStatus(waitCondition)
-	if err != nil {
-		return err
+	for _, condition := range waitOptions.Condition {
+		if err := define.ValidateWaitCondition(condition); err != nil {
+			return err
+		}
 	}
 
 	responses, err := registry.ContainerEngine().ContainerWait(context.Background(), args, waitOptions)
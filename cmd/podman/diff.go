@@ -16,17 +16,18 @@ import (
 
 var (
 	// Command: podman _diff_ Object_ID
-	diffDescription = `Displays changes on a container or image's filesystem.  The container or image will be compared to its parent layer.`
+	diffDescription = `Displays changes on a container or image's filesystem.  The container or image will be compared to its parent layer or, if specified, to the container or image given before it.`
 	diffCmd         = &cobra.Command{
-		Use:               "diff [options] {CONTAINER|IMAGE}",
-		Args:              validate.IDOrLatestArgs,
+		Use:               "diff [options] [{CONTAINER|IMAGE}] {CONTAINER|IMAGE}",
+		Args:              validate.DiffArgs,
 		Short:             "Display the changes to the object's file system",
 		Long:              diffDescription,
 		RunE:              diff,
 		ValidArgsFunction: common.AutocompleteContainersAndImages,
 		Example: `podman diff imageID
   podman diff ctrID
-  podman diff --format json redis:alpine`,
+  podman diff --format json redis:alpine
+  podman diff --detail myImage:1.0 myImage:2.0`,
 	}
 
 	diffOpts = entities.DiffOptions{}
@@ -45,6 +46,8 @@ func init() {
 	flags.StringVar(&diffOpts.Format, formatFlagName, "", "Change the output format")
 	_ = diffCmd.RegisterFlagCompletionFunc(formatFlagName, common.AutocompleteJSONFormat)
 
+	flags.BoolVar(&diffOpts.Detail, "detail", false, "Show the size, mode, ownership and content digest of the changed paths")
+
 	validate.AddLatestFlag(diffCmd, &diffOpts.Latest)
 }
 
@@ -54,20 +57,22 @@ func diff(cmd *cobra.Command, args []string) error {
 		return containers.Diff(cmd, args, diffOpts)
 	}
 
+	// The last argument is compared to the first one, if any
+	nameOrID := args[len(args)-1]
 	options := entities.ContainerExistsOptions{
 		External: true,
 	}
-	if found, err := registry.ContainerEngine().ContainerExists(registry.GetContext(), args[0], options); err != nil {
+	if found, err := registry.ContainerEngine().ContainerExists(registry.GetContext(), nameOrID, options); err != nil {
 		return err
 	} else if found.Value {
 		return containers.Diff(cmd, args, diffOpts)
 	}
 
-	if found, err := registry.ImageEngine().Exists(registry.GetContext(), args[0]); err != nil {
+	if found, err := registry.ImageEngine().Exists(registry.GetContext(), nameOrID); err != nil {
 		return err
 	} else if found.Value {
 		return images.Diff(cmd, args, diffOpts)
 	}
 
-	return fmt.Errorf("%s not found on system", args[0])
+	return fmt.Errorf("%s not found on system", nameOrID)
 }
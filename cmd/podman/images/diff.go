@@ -1,7 +1,6 @@
 package images
 
 import (
-	"github.com/containers/common/pkg/report"
 	"github.com/containers/podman/v2/cmd/podman/common"
 	"github.com/containers/podman/v2/cmd/podman/registry"
 	"github.com/containers/podman/v2/pkg/domain/entities"
@@ -13,14 +12,15 @@ import (
 var (
 	// podman container _inspect_
 	diffCmd = &cobra.Command{
-		Use:               "diff [options] IMAGE",
-		Args:              cobra.ExactArgs(1),
+		Use:               "diff [options] [IMAGE] IMAGE",
+		Args:              cobra.RangeArgs(1, 2),
 		Short:             "Inspect changes to the image's file systems",
-		Long:              `Displays changes to the image's filesystem.  The image will be compared to its parent layer.`,
+		Long:              `Displays changes to the image's filesystem.  The image will be compared to its parent layer or, if specified, to the image given before it.`,
 		RunE:              diff,
 		ValidArgsFunction: common.AutocompleteImages,
 		Example: `podman image diff myImage
-  podman image diff --format json redis:alpine`,
+  podman image diff --format json redis:alpine
+  podman image diff --detail --format json myImage:1.0 myImage:2.0`,
 	}
 	diffOpts *entities.DiffOptions
 )
@@ -42,6 +42,8 @@ func diffFlags(flags *pflag.FlagSet) {
 	formatFlagName := "format"
 	flags.StringVar(&diffOpts.Format, formatFlagName, "", "Change the output format")
 	_ = diffCmd.RegisterFlagCompletionFunc(formatFlagName, common.AutocompleteJSONFormat)
+
+	flags.BoolVar(&diffOpts.Detail, "detail", false, "Show the size, mode, ownership and content digest of the changed paths")
 }
 
 func diff(cmd *cobra.Command, args []string) error {
@@ -49,19 +51,17 @@ func diff(cmd *cobra.Command, args []string) error {
 		return errors.New("image diff does not support --latest")
 	}
 
+	// If two images are given, the changes from the first to the second
+	// one are shown.
+	if len(args) == 2 {
+		diffOpts.Parent = args[0]
+		args = args[1:]
+	}
 	results, err := registry.ImageEngine().Diff(registry.GetContext(), args[0], *diffOpts)
 	if err != nil {
 		return err
 	}
-
-	switch {
-	case report.IsJSON(diffOpts.Format):
-		return common.ChangesToJSON(results)
-	case diffOpts.Format == "":
-		return common.ChangesToTable(results)
-	default:
-		return errors.New("only supported value for '--format' is 'json'")
-	}
+	return common.PrintDiffReport(results, *diffOpts)
 }
 
 func Diff(cmd *cobra.Command, args []string, options entities.DiffOptions) error {
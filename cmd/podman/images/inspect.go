@@ -1,10 +1,16 @@
 package images
 
 import (
+	"os"
+
+	"github.com/containers/common/pkg/auth"
+	"github.com/containers/common/pkg/completion"
+	"github.com/containers/image/v5/types"
 	"github.com/containers/podman/v2/cmd/podman/common"
 	"github.com/containers/podman/v2/cmd/podman/inspect"
 	"github.com/containers/podman/v2/cmd/podman/registry"
 	"github.com/containers/podman/v2/pkg/domain/entities"
+	"github.com/containers/podman/v2/pkg/util"
 	"github.com/spf13/cobra"
 )
 
@@ -18,9 +24,13 @@ var (
 		ValidArgsFunction: common.AutocompleteImages,
 		Example: `podman inspect alpine
   podman inspect --format "imageId: {{.Id}} size: {{.Size}}" alpine
-  podman inspect --format "image: {{.ImageName}} driver: {{.Driver}}" myctr`,
+  podman inspect --format "image: {{.ImageName}} driver: {{.Driver}}" myctr
+  podman image inspect --remote --format "{{.Digest}}" quay.io/libpod/alpine:latest`,
 	}
-	inspectOpts *entities.InspectOptions
+	inspectOpts       *entities.InspectOptions
+	inspectRemoteOpts entities.ImageInspectRemoteOptions
+	inspectCreds      string
+	inspectTLSVerify  bool
 )
 
 func init() {
@@ -35,9 +45,47 @@ func init() {
 	formatFlagName := "format"
 	flags.StringVarP(&inspectOpts.Format, formatFlagName, "f", "json", "Format the output to a Go template or json")
 	_ = inspectCmd.RegisterFlagCompletionFunc(formatFlagName, common.AutocompleteJSONFormat)
+
+	flags.BoolVar(&inspectOpts.Remote, "remote", false, "Inspect the image on its registry without pulling it")
+
+	authfileFlagName := "authfile"
+	flags.StringVar(&inspectRemoteOpts.Authfile, authfileFlagName, auth.GetDefaultAuthFile(), "Path of the authentication file used with --remote. Use REGISTRY_AUTH_FILE environment variable to override")
+	_ = inspectCmd.RegisterFlagCompletionFunc(authfileFlagName, completion.AutocompleteDefault)
+
+	credsFlagName := "creds"
+	flags.StringVar(&inspectCreds, credsFlagName, "", "`Credentials` (USERNAME:PASSWORD) to use for authenticating to a registry with --remote")
+	_ = inspectCmd.RegisterFlagCompletionFunc(credsFlagName, completion.AutocompleteNone)
+
+	flags.BoolVar(&inspectTLSVerify, "tls-verify", true, "Require HTTPS and verify certificates when contacting registries with --remote")
+
+	if !registry.IsRemote() {
+		certDirFlagName := "cert-dir"
+		flags.StringVar(&inspectRemoteOpts.CertDir, certDirFlagName, "", "`Pathname` of a directory containing TLS certificates and keys used with --remote")
+		_ = inspectCmd.RegisterFlagCompletionFunc(certDirFlagName, completion.AutocompleteDefault)
+	}
 }
 
 func inspectExec(cmd *cobra.Command, args []string) error {
 	inspectOpts.Type = inspect.ImageType
-	return inspect.Inspect(args, *inspectOpts)
+	if !inspectOpts.Remote {
+		return inspect.Inspect(args, *inspectOpts)
+	}
+
+	if cmd.Flags().Changed("tls-verify") {
+		inspectRemoteOpts.SkipTLSVerify = types.NewOptionalBool(!inspectTLSVerify)
+	}
+	if inspectRemoteOpts.Authfile != "" {
+		if _, err := os.Stat(inspectRemoteOpts.Authfile); err != nil {
+			return err
+		}
+	}
+	if inspectCreds != "" {
+		creds, err := util.ParseRegistryCreds(inspectCreds)
+		if err != nil {
+			return err
+		}
+		inspectRemoteOpts.Username = creds.Username
+		inspectRemoteOpts.Password = creds.Password
+	}
+	return inspect.InspectRemote(args, *inspectOpts, inspectRemoteOpts)
 }
@@ -10,7 +10,9 @@ import (
 	"github.com/containers/podman/v2/cmd/podman/registry"
 	"github.com/containers/podman/v2/cmd/podman/utils"
 	"github.com/containers/podman/v2/cmd/podman/validate"
+	"github.com/containers/podman/v2/libpod/image"
 	"github.com/containers/podman/v2/pkg/domain/entities"
+	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
 )
 
@@ -31,6 +33,7 @@ var (
 	pruneOpts = entities.ImagePruneOptions{}
 	force     bool
 	filter    = []string{}
+	until     string
 )
 
 func init() {
@@ -43,16 +46,44 @@ func init() {
 	flags := pruneCmd.Flags()
 	flags.BoolVarP(&pruneOpts.All, "all", "a", false, "Remove all unused images, not just dangling ones")
 	flags.BoolVarP(&force, "force", "f", false, "Do not prompt for confirmation")
+	flags.BoolVar(&pruneOpts.DryRun, "dry-run", false, "Print the images that would be removed without removing them")
+	flags.BoolVar(&pruneOpts.GC, "gc", false, "Evict unused images in least-recently-used order if the graph root's file system usage exceeds --gc-high-threshold")
+
+	gcHighThresholdFlagName := "gc-high-threshold"
+	flags.UintVar(&pruneOpts.GCHighThreshold, gcHighThresholdFlagName, image.DefaultGCPolicy.HighThreshold, "Percentage of the graph root's file system usage above which --gc evicts images")
+	_ = pruneCmd.RegisterFlagCompletionFunc(gcHighThresholdFlagName, completion.AutocompleteNone)
+
+	gcLowThresholdFlagName := "gc-low-threshold"
+	flags.UintVar(&pruneOpts.GCLowThreshold, gcLowThresholdFlagName, image.DefaultGCPolicy.LowThreshold, "Percentage of the graph root's file system usage --gc frees space down to")
+	_ = pruneCmd.RegisterFlagCompletionFunc(gcLowThresholdFlagName, completion.AutocompleteNone)
+
+	gcMinAgeFlagName := "gc-min-age"
+	flags.DurationVar(&pruneOpts.GCMinAge, gcMinAgeFlagName, image.DefaultGCPolicy.MinAge, "Minimum time since an image was last used before --gc may evict it")
+	_ = pruneCmd.RegisterFlagCompletionFunc(gcMinAgeFlagName, completion.AutocompleteNone)
 
 	filterFlagName := "filter"
 	flags.StringArrayVar(&filter, filterFlagName, []string{}, "Provide filter values (e.g. 'label=<key>=<value>')")
 	//TODO: add completion for filters
 	_ = pruneCmd.RegisterFlagCompletionFunc(filterFlagName, completion.AutocompleteNone)
 
+	untilFlagName := "until"
+	flags.StringVar(&until, untilFlagName, "", "Only remove images created before the given timestamp")
+	_ = pruneCmd.RegisterFlagCompletionFunc(untilFlagName, completion.AutocompleteNone)
 }
 
 func prune(cmd *cobra.Command, args []string) error {
-	if !force {
+	if pruneOpts.GC && (pruneOpts.All || len(filter) > 0 || cmd.Flags().Changed("until")) {
+		return errors.New("--gc cannot be combined with --all, --filter or --until")
+	}
+	if !pruneOpts.GC && (cmd.Flags().Changed("gc-high-threshold") || cmd.Flags().Changed("gc-low-threshold") || cmd.Flags().Changed("gc-min-age")) {
+		return errors.New("--gc-high-threshold, --gc-low-threshold and --gc-min-age require --gc")
+	}
+	pruneOpts.Filter = filter
+	if cmd.Flags().Changed("until") {
+		pruneOpts.Filter = append(pruneOpts.Filter, "until="+until)
+	}
+
+	if !force && !pruneOpts.DryRun {
 		reader := bufio.NewReader(os.Stdin)
 		fmt.Printf(`
 WARNING! This will remove all dangling images.
@@ -0,0 +1,124 @@
+package images
+
+import (
+	"fmt"
+	"os"
+	"text/tabwriter"
+	"text/template"
+
+	"github.com/containers/common/pkg/auth"
+	"github.com/containers/common/pkg/completion"
+	"github.com/containers/image/v5/types"
+	"github.com/containers/podman/v2/cmd/podman/common"
+	"github.com/containers/podman/v2/cmd/podman/registry"
+	"github.com/containers/podman/v2/pkg/domain/entities"
+	"github.com/containers/podman/v2/pkg/util"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+)
+
+var (
+	verifyDescription = `Verify an image against the trust policy of the system.
+
+  Reports which requirements of the trust policy the image does or does not satisfy.  Only the manifest and the signatures of the image are fetched, the image is not pulled into the local storage.`
+	verifyCommand = &cobra.Command{
+		Use:               "verify [options] IMAGE",
+		Short:             "Verify an image against the trust policy",
+		Long:              verifyDescription,
+		RunE:              verify,
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: common.AutocompleteImages,
+		Example: `podman image verify quay.io/podman/stable:latest
+  podman image verify --json docker://registry.example.com/app:1.0`,
+	}
+)
+
+var (
+	verifyOptions entities.ImageVerifyOptions
+	verifyJSON    bool
+	verifyCreds   string
+	verifyTLS     bool
+)
+
+func init() {
+	registry.Commands = append(registry.Commands, registry.CliCommand{
+		Mode:    []entities.EngineMode{entities.ABIMode},
+		Command: verifyCommand,
+		Parent:  imageCmd,
+	})
+	flags := verifyCommand.Flags()
+
+	authfileFlagName := "authfile"
+	flags.StringVar(&verifyOptions.Authfile, authfileFlagName, auth.GetDefaultAuthFile(), "Path of the authentication file. Use REGISTRY_AUTH_FILE environment variable to override")
+	_ = verifyCommand.RegisterFlagCompletionFunc(authfileFlagName, completion.AutocompleteDefault)
+
+	certDirFlagName := "cert-dir"
+	flags.StringVar(&verifyOptions.CertDir, certDirFlagName, "", "`Pathname` of a directory containing TLS certificates and keys")
+	_ = verifyCommand.RegisterFlagCompletionFunc(certDirFlagName, completion.AutocompleteDefault)
+
+	credsFlagName := "creds"
+	flags.StringVar(&verifyCreds, credsFlagName, "", "`Credentials` (USERNAME:PASSWORD) to use for authenticating to a registry")
+	_ = verifyCommand.RegisterFlagCompletionFunc(credsFlagName, completion.AutocompleteNone)
+
+	flags.BoolVarP(&verifyJSON, "json", "j", false, "Output as json")
+	flags.BoolVar(&verifyTLS, "tls-verify", true, "Require HTTPS and verify certificates when contacting registries")
+	flags.StringVar(&verifyOptions.PolicyPath, "policypath", "", "")
+	_ = flags.MarkHidden("policypath")
+}
+
+func verify(cmd *cobra.Command, args []string) error {
+	if cmd.Flags().Changed("tls-verify") {
+		verifyOptions.SkipTLSVerify = types.NewOptionalBool(!verifyTLS)
+	}
+	if verifyOptions.Authfile != "" {
+		if _, err := os.Stat(verifyOptions.Authfile); err != nil {
+			return err
+		}
+	}
+	if verifyCreds != "" {
+		creds, err := util.ParseRegistryCreds(verifyCreds)
+		if err != nil {
+			return err
+		}
+		verifyOptions.Username = creds.Username
+		verifyOptions.Password = creds.Password
+	}
+
+	report, err := registry.ImageEngine().Verify(registry.Context(), args[0], verifyOptions)
+	if err != nil {
+		return err
+	}
+	if verifyJSON {
+		b, err := json.MarshalIndent(report, "", "  ")
+		if err != nil {
+			return err
+		}
+		fmt.Println(string(b))
+	} else if err := printVerifyReport(report); err != nil {
+		return err
+	}
+	if !report.Allowed {
+		return errors.Errorf("%s does not satisfy the trust policy", report.Image)
+	}
+	return nil
+}
+
+func printVerifyReport(report *entities.ImageVerifyReport) error {
+	scope := report.Scope
+	if scope == "" {
+		scope = "default"
+	}
+	fmt.Printf("Image: %s\nPolicy scope: %s\n\n", report.Image, scope)
+
+	row := "{{.Type}}\t{{.KeyPath}}\t{{.Satisfied}}\t{{.Reason}}\n"
+	format := "TYPE\tKEY\tSATISFIED\tREASON\n{{range . }}" + row + "{{end}}"
+	tmpl, err := template.New("verifyImage").Parse(format)
+	if err != nil {
+		return err
+	}
+	w := tabwriter.NewWriter(os.Stdout, 8, 2, 2, ' ', 0)
+	if err := tmpl.Execute(w, report.Requirements); err != nil {
+		return err
+	}
+	return w.Flush()
+}
@@ -67,12 +67,24 @@ func Inspect(namesOrIDs []string, options entities.InspectOptions) error {
 	return inspector.inspect(namesOrIDs)
 }
 
+// InspectRemote inspects the specified images on their registries.
+func InspectRemote(names []string, options entities.InspectOptions, remoteOptions entities.ImageInspectRemoteOptions) error {
+	options.Remote = true
+	inspector, err := newInspector(options)
+	if err != nil {
+		return err
+	}
+	inspector.remoteOptions = remoteOptions
+	return inspector.inspect(names)
+}
+
 // inspector allows for inspecting images and containers.
 type inspector struct {
 	containerEngine entities.ContainerEngine
 	imageEngine     entities.ImageEngine
 	options         entities.InspectOptions
 	podOptions      entities.PodInspectOptions
+	remoteOptions   entities.ImageInspectRemoteOptions
 }
 
 // newInspector creates a new inspector based on the specified options.
@@ -91,6 +103,9 @@ func newInspector(options entities.InspectOptions) (*inspector, error) {
 			return nil, errors.Errorf("size is not supported for type %q", ImageType)
 		}
 	}
+	if options.Remote && options.Type != ImageType {
+		return nil, errors.Errorf("remote is only supported for type %q", ImageType)
+	}
 	if options.Type == PodType && options.Size {
 		return nil, errors.Errorf("size is not supported for type %q", PodType)
 	}
@@ -139,6 +154,17 @@ func (i *inspector) inspect(namesOrIDs []string) error {
 		data = allData
 		errs = allErrs
 	case ImageType:
+		if i.options.Remote {
+			imgData, allErrs, err := i.imageEngine.InspectRemote(ctx, namesOrIDs, i.remoteOptions)
+			if err != nil {
+				return err
+			}
+			errs = allErrs
+			for i := range imgData {
+				data = append(data, imgData[i])
+			}
+			break
+		}
 		imgData, allErrs, err := i.imageEngine.Inspect(ctx, namesOrIDs, i.options)
 		if err != nil {
 			return err
@@ -0,0 +1,44 @@
+package manifest
+
+import (
+	"fmt"
+
+	"github.com/containers/podman/v2/cmd/podman/common"
+	"github.com/containers/podman/v2/cmd/podman/registry"
+	"github.com/containers/podman/v2/pkg/domain/entities"
+	"github.com/containers/podman/v2/pkg/errorhandling"
+	"github.com/spf13/cobra"
+)
+
+var (
+	rmCmd = &cobra.Command{
+		Use:               "rm LIST [LIST...]",
+		Short:             "Remove manifest list or image index from local storage",
+		Long:              "Remove manifest lists or image indexes from local storage.  The images referenced by the lists are not removed.",
+		RunE:              rm,
+		ValidArgsFunction: common.AutocompleteImages,
+		Example: `podman manifest rm mylist:v1.11
+  podman manifest rm mylist otherlist`,
+		Args:                  cobra.MinimumNArgs(1),
+		DisableFlagsInUseLine: true,
+	}
+)
+
+func init() {
+	registry.Commands = append(registry.Commands, registry.CliCommand{
+		Mode:    []entities.EngineMode{entities.ABIMode, entities.TunnelMode},
+		Command: rmCmd,
+		Parent:  manifestCmd,
+	})
+}
+
+func rm(cmd *cobra.Command, args []string) error {
+	report, rmErrors := registry.ImageEngine().ManifestRm(registry.GetContext(), args)
+	if report != nil {
+		for _, d := range report.Deleted {
+			fmt.Println("Deleted: " + d)
+		}
+		registry.SetExitCode(report.ExitCode)
+	}
+	return errorhandling.JoinErrors(rmErrors)
+}
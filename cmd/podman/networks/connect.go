@@ -1,10 +1,14 @@
 package network
 
 import (
+	"net"
+
 	"github.com/containers/common/pkg/completion"
 	"github.com/containers/podman/v2/cmd/podman/common"
 	"github.com/containers/podman/v2/cmd/podman/registry"
+	"github.com/containers/podman/v2/libpod/define"
 	"github.com/containers/podman/v2/pkg/domain/entities"
+	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
 )
 
@@ -15,14 +19,17 @@ var (
 		Short:             "network connect",
 		Long:              networkConnectDescription,
 		RunE:              networkConnect,
-		Example:           `podman network connect web secondary`,
 		Args:              cobra.ExactArgs(2),
 		ValidArgsFunction: common.AutocompleteNetworkConnectCmd,
+		Example: `podman network connect web secondary
+  podman network connect --ip 10.89.1.5 --mac-address 92:d0:c6:0a:29:33 web secondary`,
 	}
 )
 
 var (
 	networkConnectOptions entities.NetworkConnectOptions
+	networkConnectIP      string
+	networkConnectMAC     string
 )
 
 func networkConnectFlags(cmd *cobra.Command) {
@@ -30,6 +37,14 @@ func networkConnectFlags(cmd *cobra.Command) {
 	aliasFlagName := "alias"
 	flags.StringSliceVar(&networkConnectOptions.Aliases, aliasFlagName, []string{}, "network scoped alias for container")
 	_ = cmd.RegisterFlagCompletionFunc(aliasFlagName, completion.AutocompleteNone)
+
+	ipFlagName := "ip"
+	flags.StringVar(&networkConnectIP, ipFlagName, "", "Specify a static IPv4 address for the container in the network")
+	_ = cmd.RegisterFlagCompletionFunc(ipFlagName, completion.AutocompleteNone)
+
+	macAddressFlagName := "mac-address"
+	flags.StringVar(&networkConnectMAC, macAddressFlagName, "", "Specify a static MAC address for the container in the network (e.g. 92:d0:c6:0a:29:33)")
+	_ = cmd.RegisterFlagCompletionFunc(macAddressFlagName, completion.AutocompleteNone)
 }
 
 func init() {
@@ -43,5 +58,22 @@ func init() {
 
 func networkConnect(cmd *cobra.Command, args []string) error {
 	networkConnectOptions.Container = args[1]
+	if networkConnectIP != "" {
+		staticIP := net.ParseIP(networkConnectIP)
+		if staticIP == nil {
+			return errors.Errorf("%s is not an ip address", networkConnectIP)
+		}
+		if staticIP.To4() == nil {
+			return errors.Wrapf(define.ErrInvalidArg, "%s is not an IPv4 address", networkConnectIP)
+		}
+		networkConnectOptions.StaticIP = staticIP
+	}
+	if networkConnectMAC != "" {
+		mac, err := net.ParseMAC(networkConnectMAC)
+		if err != nil {
+			return err
+		}
+		networkConnectOptions.StaticMAC = mac
+	}
 	return registry.ContainerEngine().NetworkConnect(registry.Context(), args[0], networkConnectOptions)
 }
@@ -0,0 +1,42 @@
+package network
+
+import (
+	"github.com/containers/podman/v2/cmd/podman/common"
+	"github.com/containers/podman/v2/cmd/podman/registry"
+	"github.com/containers/podman/v2/pkg/domain/entities"
+	"github.com/spf13/cobra"
+)
+
+var (
+	networkExistsDescription = `If the named network exists, podman network exists exits with 0, otherwise the exit code will be 1.`
+	networkExistsCommand     = &cobra.Command{
+		Use:               "exists NETWORK",
+		Short:             "Check if a network exists",
+		Long:              networkExistsDescription,
+		RunE:              networkExists,
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: common.AutocompleteNetworks,
+		Example: `podman network exists net1
+  podman network exists net1 || podman network create net1`,
+		DisableFlagsInUseLine: true,
+	}
+)
+
+func init() {
+	registry.Commands = append(registry.Commands, registry.CliCommand{
+		Mode:    []entities.EngineMode{entities.ABIMode, entities.TunnelMode},
+		Command: networkExistsCommand,
+		Parent:  networkCmd,
+	})
+}
+
+func networkExists(cmd *cobra.Command, args []string) error {
+	response, err := registry.ContainerEngine().NetworkExists(registry.GetContext(), args[0])
+	if err != nil {
+		return err
+	}
+	if !response.Value {
+		registry.SetExitCode(1)
+	}
+	return nil
+}
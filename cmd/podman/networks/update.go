@@ -0,0 +1,74 @@
+package network
+
+import (
+	"github.com/containers/common/pkg/completion"
+	"github.com/containers/podman/v2/cmd/podman/common"
+	"github.com/containers/podman/v2/cmd/podman/parse"
+	"github.com/containers/podman/v2/cmd/podman/registry"
+	"github.com/containers/podman/v2/pkg/domain/entities"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+)
+
+var (
+	networkUpdateDescription = `Update the DNS servers and labels of an existing network.
+
+  Running containers attached to the network are reloaded to use the updated configuration.`
+	networkUpdateCommand = &cobra.Command{
+		Use:               "update [options] NETWORK",
+		Short:             "update an existing network",
+		Long:              networkUpdateDescription,
+		RunE:              networkUpdate,
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: common.AutocompleteNetworks,
+		Example: `podman network update --dns-add 10.89.0.254 podman1
+  podman network update --label-add env=prod --label-rm stage podman1`,
+	}
+)
+
+var (
+	networkUpdateOptions entities.NetworkUpdateOptions
+	labelAdd             []string
+)
+
+func networkUpdateFlags(cmd *cobra.Command) {
+	flags := cmd.Flags()
+
+	dnsAddFlagName := "dns-add"
+	flags.StringSliceVar(&networkUpdateOptions.DNSAdd, dnsAddFlagName, nil, "add a DNS server to the network")
+	_ = cmd.RegisterFlagCompletionFunc(dnsAddFlagName, completion.AutocompleteNone)
+
+	dnsDropFlagName := "dns-drop"
+	flags.StringSliceVar(&networkUpdateOptions.DNSDrop, dnsDropFlagName, nil, "drop a DNS server from the network")
+	_ = cmd.RegisterFlagCompletionFunc(dnsDropFlagName, completion.AutocompleteNone)
+
+	labelAddFlagName := "label-add"
+	flags.StringArrayVar(&labelAdd, labelAddFlagName, nil, "add or replace a label of the network")
+	_ = cmd.RegisterFlagCompletionFunc(labelAddFlagName, completion.AutocompleteNone)
+
+	labelRmFlagName := "label-rm"
+	flags.StringSliceVar(&networkUpdateOptions.LabelRm, labelRmFlagName, nil, "remove the label with the given key from the network")
+	_ = cmd.RegisterFlagCompletionFunc(labelRmFlagName, completion.AutocompleteNone)
+}
+
+func init() {
+	registry.Commands = append(registry.Commands, registry.CliCommand{
+		Mode:    []entities.EngineMode{entities.ABIMode, entities.TunnelMode},
+		Command: networkUpdateCommand,
+		Parent:  networkCmd,
+	})
+	networkUpdateFlags(networkUpdateCommand)
+}
+
+func networkUpdate(cmd *cobra.Command, args []string) error {
+	var err error
+	networkUpdateOptions.LabelAdd, err = parse.GetAllLabels([]string{}, labelAdd)
+	if err != nil {
+		return errors.Wrap(err, "failed to parse labels")
+	}
+	if len(networkUpdateOptions.DNSAdd) == 0 && len(networkUpdateOptions.DNSDrop) == 0 &&
+		len(networkUpdateOptions.LabelAdd) == 0 && len(networkUpdateOptions.LabelRm) == 0 {
+		return errors.New("at least one of --dns-add, --dns-drop, --label-add or --label-rm must be specified")
+	}
+	return registry.ContainerEngine().NetworkUpdate(registry.Context(), args[0], networkUpdateOptions)
+}
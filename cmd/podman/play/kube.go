@@ -12,6 +12,7 @@ import (
 	"github.com/containers/podman/v2/cmd/podman/utils"
 	"github.com/containers/podman/v2/pkg/domain/entities"
 	"github.com/containers/podman/v2/pkg/util"
+	"github.com/coreos/go-systemd/v22/daemon"
 	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
 )
@@ -24,6 +25,7 @@ type playKubeOptionsWrapper struct {
 	TLSVerifyCLI   bool
 	CredentialsCLI string
 	StartCLI       bool
+	Down           bool
 }
 
 var (
@@ -32,7 +34,8 @@ var (
 	kubeOptions        = playKubeOptionsWrapper{}
 	kubeDescription    = `Command reads in a structured file of Kubernetes YAML.
 
-  It creates the pod and containers described in the YAML.  The containers within the pod are then started and the ID of the new Pod is output.`
+  It creates the pod and containers described in the YAML.  The containers within the pod are then started and the ID of the new Pod is output.
+  With --down, the pods created for the YAML are stopped and removed.`
 
 	kubeCmd = &cobra.Command{
 		Use:               "kube [options] KUBEFILE",
@@ -42,7 +45,8 @@ var (
 		Args:              cobra.ExactArgs(1),
 		ValidArgsFunction: common.AutocompleteDefaultOneArg,
 		Example: `podman play kube nginx.yml
-  podman play kube --creds user:password --seccomp-profile-root /custom/path apache.yml`,
+  podman play kube --creds user:password --seccomp-profile-root /custom/path apache.yml
+  podman play kube --down nginx.yml`,
 	}
 )
 
@@ -91,11 +95,17 @@ func init() {
 		configmapFlagName := "configmap"
 		flags.StringSliceVar(&kubeOptions.ConfigMaps, configmapFlagName, []string{}, "`Pathname` of a YAML file containing a kubernetes configmap")
 		_ = kubeCmd.RegisterFlagCompletionFunc(configmapFlagName, completion.AutocompleteDefault)
+
+		flags.BoolVar(&kubeOptions.Down, "down", false, "Stop and remove the pods created for the YAML")
 	}
 	_ = flags.MarkHidden("signature-policy")
 }
 
 func kube(cmd *cobra.Command, args []string) error {
+	if kubeOptions.Down {
+		return kubeDown(args[0])
+	}
+
 	// TLS verification in c/image is controlled via a `types.OptionalBool`
 	// which allows for distinguishing among set-true, set-false, unspecified
 	// which is important to implement a sane way of dealing with defaults of
@@ -120,6 +130,16 @@ func kube(cmd *cobra.Command, args []string) error {
 		kubeOptions.Password = creds.Password
 	}
 
+	// When run by a systemd unit, e.g. podman-kube@.service, systemd is
+	// notified once all pods are running.  Hide the socket from the
+	// containers, so they do not notify systemd themselves.
+	notifySocket, notify := os.LookupEnv("NOTIFY_SOCKET")
+	if notify && !registry.IsRemote() {
+		if err := os.Unsetenv("NOTIFY_SOCKET"); err != nil {
+			return err
+		}
+	}
+
 	report, err := registry.ContainerEngine().PlayKube(registry.GetContext(), args[0], kubeOptions.PlayKubeOptions)
 	if err != nil {
 		return err
@@ -164,5 +184,28 @@ func kube(cmd *cobra.Command, args []string) error {
 		return errors.Errorf("failed to start %d containers", ctrsFailed)
 	}
 
+	if notify {
+		if err := os.Setenv("NOTIFY_SOCKET", notifySocket); err != nil {
+			return err
+		}
+		if _, err := daemon.SdNotify(false, daemon.SdNotifyReady); err != nil {
+			return errors.Wrap(err, "error notifying systemd")
+		}
+	}
+
+	return nil
+}
+
+func kubeDown(path string) error {
+	report, err := registry.ContainerEngine().PlayKubeDown(registry.GetContext(), path)
+	if err != nil {
+		return err
+	}
+	if len(report.Pods) > 0 {
+		fmt.Println("Pods removed:")
+	}
+	for _, pod := range report.Pods {
+		fmt.Println(pod)
+	}
 	return nil
 }
@@ -188,8 +188,9 @@ func printVerbose(w *tabwriter.Writer, cmd *cobra.Command, reports *entities.Sys
 	}
 	hdrs = report.Headers(entities.SystemDfVolumeReport{}, map[string]string{
 		"VolumeName": "VOLUME NAME",
+		"SizeLimit":  "SIZE LIMIT",
 	})
-	volumeRow := "{{.VolumeName}}\t{{.Links}}\t{{.Size}}\n"
+	volumeRow := "{{.VolumeName}}\t{{.Links}}\t{{.Size}}\t{{.SizeLimit}}\n"
 	return writeTemplate(w, cmd, hdrs, volumeRow, dfVolumes)
 }
 
@@ -266,6 +267,13 @@ func (d *dfVolume) Size() string {
 	return units.HumanSize(float64(d.SystemDfVolumeReport.Size))
 }
 
+func (d *dfVolume) SizeLimit() string {
+	if d.SystemDfVolumeReport.SizeLimit == 0 {
+		return "-"
+	}
+	return units.HumanSize(float64(d.SystemDfVolumeReport.SizeLimit))
+}
+
 type dfSummary struct {
 	Type        string
 	Total       int
@@ -3,9 +3,11 @@
 package system
 
 import (
+	"net"
 	"net/url"
 	"os"
 	"path/filepath"
+	"strings"
 	"syscall"
 	"time"
 
@@ -16,6 +18,7 @@ import (
 	"github.com/containers/podman/v2/pkg/rootless"
 	"github.com/containers/podman/v2/pkg/systemd"
 	"github.com/containers/podman/v2/pkg/util"
+	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
@@ -34,11 +37,14 @@ Enable a listening service for API access to Podman commands.
 		Long:              srvDescription,
 		RunE:              service,
 		ValidArgsFunction: common.AutocompleteDefaultOneArg,
-		Example:           `podman system service --time=0 unix:///tmp/podman.sock`,
+		Example: `podman system service --time=0 unix:///tmp/podman.sock
+  podman system service --time=0 --registry tcp:localhost:5000`,
 	}
 
 	srvArgs = struct {
-		Timeout int64
+		Registry       bool
+		RegistryPublic bool
+		Timeout        int64
 	}{}
 )
 
@@ -55,6 +61,9 @@ func init() {
 	flags.Int64VarP(&srvArgs.Timeout, timeFlagName, "t", 5, "Time until the service session expires in seconds.  Use 0 to disable the timeout")
 	_ = srvCmd.RegisterFlagCompletionFunc(timeFlagName, completion.AutocompleteNone)
 
+	flags.BoolVar(&srvArgs.Registry, "registry", false, "Serve the images in local storage as a read-only registry instead of the API")
+	flags.BoolVar(&srvArgs.RegistryPublic, "registry-public", false, "Allow the registry to listen on addresses other than unix sockets and loopback addresses")
+
 	flags.SetNormalizeFunc(aliasTimeoutFlag)
 }
 
@@ -66,10 +75,21 @@ func aliasTimeoutFlag(_ *pflag.FlagSet, name string) pflag.NormalizedName {
 }
 
 func service(cmd *cobra.Command, args []string) error {
+	// The registry must not take over the default API socket.
+	if srvArgs.Registry && len(args) == 0 && !systemd.SocketActivated() {
+		return errors.New("a URI to listen on must be given with --registry")
+	}
 	apiURI, err := resolveAPIURI(args)
 	if err != nil {
 		return err
 	}
+	// The registry does not authenticate its clients, so it only serves
+	// the local storage to everyone when explicitly asked to.
+	if srvArgs.Registry && !srvArgs.RegistryPublic && apiURI != "" {
+		if err := validateRegistryURI(apiURI); err != nil {
+			return err
+		}
+	}
 	logrus.Infof("using API endpoint: '%s'", apiURI)
 
 	// Clean up any old existing unix domain socket
@@ -90,14 +110,32 @@ func service(cmd *cobra.Command, args []string) error {
 	}
 
 	opts := entities.ServiceOptions{
-		URI:     apiURI,
-		Command: cmd,
+		URI:      apiURI,
+		Command:  cmd,
+		Registry: srvArgs.Registry,
 	}
 
 	opts.Timeout = time.Duration(srvArgs.Timeout) * time.Second
 	return restService(opts, cmd.Flags(), registry.PodmanConfig())
 }
 
+// validateRegistryURI returns an error if the registry would listen on
+// something else than a unix socket or a loopback address.
+func validateRegistryURI(uri string) error {
+	fields := strings.SplitN(uri, ":", 2)
+	if len(fields) == 1 || fields[0] == "unix" {
+		return nil
+	}
+	host, _, err := net.SplitHostPort(strings.TrimPrefix(fields[1], "//"))
+	if err != nil {
+		return errors.Wrapf(err, "invalid registry endpoint %q", uri)
+	}
+	if ip := net.ParseIP(host); host == "localhost" || (ip != nil && ip.IsLoopback()) {
+		return nil
+	}
+	return errors.Errorf("the registry serves all images in local storage without authentication, use --registry-public to listen on %q", uri)
+}
+
 func resolveAPIURI(_url []string) (string, error) {
 	// When determining _*THE*_ listening endpoint --
 	// 1) User input wins always
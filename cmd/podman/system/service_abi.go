@@ -51,7 +51,11 @@ func restService(opts entities.ServiceOptions, flags *pflag.FlagSet, cfg *entiti
 	}
 
 	infra.StartWatcher(rt)
-	server, err := api.NewServerWithSettings(rt, opts.Timeout, listener)
+	newServer := api.NewServerWithSettings
+	if opts.Registry {
+		newServer = api.NewRegistryServerWithSettings
+	}
+	server, err := newServer(rt, opts.Timeout, listener)
 	if err != nil {
 		return err
 	}
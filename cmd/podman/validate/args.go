@@ -44,6 +44,29 @@ func IDOrLatestArgs(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
+// DiffArgs used to validate the arguments of the diff commands.  An object to
+// compare against may be specified in addition to the object or the latest
+// container.
+func DiffArgs(cmd *cobra.Command, args []string) error {
+	latest := cmd.Flag("latest")
+	given := false
+	if latest != nil {
+		given, _ = strconv.ParseBool(latest.Value.String())
+	}
+	switch {
+	case given && len(args) > 1:
+		return fmt.Errorf("`%s --latest` accepts at most one argument", cmd.CommandPath())
+	case len(args) > 2:
+		return fmt.Errorf("`%s` accepts at most two arguments", cmd.CommandPath())
+	case len(args) == 0 && !given:
+		if latest != nil {
+			return fmt.Errorf("%q requires a name, id, or the \"--latest\" flag", cmd.CommandPath())
+		}
+		return fmt.Errorf("%q requires a name or id", cmd.CommandPath())
+	}
+	return nil
+}
+
 // TODO: the two functions CheckAllLatestAndCIDFile and CheckAllLatestAndPodIDFile are almost identical.
 //       It may be worth looking into generalizing the two a bit more and share code but time is scarce and
 //       we only live once.
@@ -0,0 +1,68 @@
+package volumes
+
+import (
+	"context"
+	"os"
+
+	"github.com/containers/common/pkg/completion"
+	"github.com/containers/podman/v2/cmd/podman/common"
+	"github.com/containers/podman/v2/cmd/podman/parse"
+	"github.com/containers/podman/v2/cmd/podman/registry"
+	"github.com/containers/podman/v2/pkg/domain/entities"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"golang.org/x/crypto/ssh/terminal"
+)
+
+var (
+	volumeExportDescription = `Export the contents of a volume as a tar archive.
+
+  The archive is written to stdout by default, or to the file given with the --output flag.`
+	exportCommand = &cobra.Command{
+		Use:               "export [options] VOLUME",
+		Short:             "Export the contents of a volume as a tar archive",
+		Long:              volumeExportDescription,
+		RunE:              export,
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: common.AutocompleteVolumeOneArg,
+		Example: `podman volume export myvol > myvol.tar
+  podman volume export --output myvol.tar myvol`,
+	}
+)
+
+var (
+	exportOutput string
+)
+
+func init() {
+	registry.Commands = append(registry.Commands, registry.CliCommand{
+		Mode:    []entities.EngineMode{entities.ABIMode, entities.TunnelMode},
+		Command: exportCommand,
+		Parent:  volumeCmd,
+	})
+	flags := exportCommand.Flags()
+
+	outputFlagName := "output"
+	flags.StringVarP(&exportOutput, outputFlagName, "o", "", "Write to a specified file (default: stdout, which must be redirected)")
+	_ = exportCommand.RegisterFlagCompletionFunc(outputFlagName, completion.AutocompleteDefault)
+}
+
+func export(cmd *cobra.Command, args []string) error {
+	out := os.Stdout
+	if len(exportOutput) == 0 {
+		if terminal.IsTerminal(int(out.Fd())) {
+			return errors.Errorf("refusing to export to terminal. Use -o flag or redirect")
+		}
+	} else {
+		if err := parse.ValidateFileName(exportOutput); err != nil {
+			return err
+		}
+		f, err := os.Create(exportOutput)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		out = f
+	}
+	return registry.ContainerEngine().VolumeExport(context.Background(), args[0], entities.VolumeExportOptions{Output: out})
+}
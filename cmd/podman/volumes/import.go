@@ -0,0 +1,54 @@
+package volumes
+
+import (
+	"context"
+	"os"
+
+	"github.com/containers/podman/v2/cmd/podman/common"
+	"github.com/containers/podman/v2/cmd/podman/registry"
+	"github.com/containers/podman/v2/pkg/domain/entities"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"golang.org/x/crypto/ssh/terminal"
+)
+
+var (
+	volumeImportDescription = `Extract a tar archive into a volume.
+
+  The archive, which may be compressed, is read from SOURCE, or from stdin if SOURCE is omitted or "-". Existing files with the same names are overwritten.`
+	importCommand = &cobra.Command{
+		Use:               "import VOLUME [SOURCE]",
+		Short:             "Import a tar archive into a volume",
+		Long:              volumeImportDescription,
+		RunE:              importVolume,
+		Args:              cobra.RangeArgs(1, 2),
+		ValidArgsFunction: common.AutocompleteVolumeImportCmd,
+		Example: `podman volume import myvol myvol.tar
+  cat myvol.tar | podman volume import myvol -`,
+	}
+)
+
+func init() {
+	registry.Commands = append(registry.Commands, registry.CliCommand{
+		Mode:    []entities.EngineMode{entities.ABIMode, entities.TunnelMode},
+		Command: importCommand,
+		Parent:  volumeCmd,
+	})
+}
+
+func importVolume(cmd *cobra.Command, args []string) error {
+	in := os.Stdin
+	if len(args) < 2 || args[1] == "-" {
+		if terminal.IsTerminal(int(in.Fd())) {
+			return errors.Errorf("refusing to import from terminal. Provide a file or redirect stdin")
+		}
+	} else {
+		f, err := os.Open(args[1])
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		in = f
+	}
+	return registry.ContainerEngine().VolumeImport(context.Background(), args[0], entities.VolumeImportOptions{Input: in})
+}
@@ -0,0 +1,175 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/containers/podman/v2/pkg/systemd/quadlet"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+// podmanExecutable is the podman executable used in the generated services.
+// It is set at build time.
+var podmanExecutable = "/usr/bin/podman"
+
+// unitDirsEnv overrides the directories quadlet files are read from.
+const unitDirsEnv = "QUADLET_UNIT_DIRS"
+
+var (
+	dryRun  = flag.Bool("dryrun", false, "Print the generated services instead of writing them")
+	user    = flag.Bool("user", false, "Generate user services")
+	verbose = flag.Bool("v", false, "Print debug information")
+)
+
+func main() {
+	flag.Usage = func() {
+		fmt.Fprintf(os.Stderr, "Usage: %s [flags] OUTPUT-DIR [EARLY-DIR] [LATE-DIR]\n", os.Args[0])
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+	if *verbose {
+		logrus.SetLevel(logrus.DebugLevel)
+	}
+
+	// systemd runs the generator as podman-user-generator for user
+	// services.
+	isUser := *user || strings.Contains(filepath.Base(os.Args[0]), "user")
+
+	if !*dryRun && flag.NArg() < 1 {
+		flag.Usage()
+		os.Exit(1)
+	}
+
+	if err := run(isUser, flag.Arg(0)); err != nil {
+		logrus.Error(err)
+		os.Exit(1)
+	}
+}
+
+func run(isUser bool, outputDir string) error {
+	units, paths, err := loadUnits(unitDirs(isUser))
+	if err != nil {
+		return err
+	}
+
+	generator := quadlet.NewGenerator(podmanExecutable, units)
+	services, errs := generator.Generate()
+	for _, err := range errs {
+		logrus.Error(err)
+	}
+
+	names := make([]string, 0, len(services))
+	for name := range services {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		service := services[name]
+		source := paths[name]
+		service.Add("Unit", "SourcePath", source)
+		content := fmt.Sprintf("# Automatically generated by quadlet from %s\n#\n%s", source, service.String())
+
+		if *dryRun {
+			fmt.Printf("---%s---\n%s\n", name, content)
+			continue
+		}
+		logrus.Debugf("Writing %s", filepath.Join(outputDir, name))
+		if err := ioutil.WriteFile(filepath.Join(outputDir, name), []byte(content), 0644); err != nil {
+			return errors.Wrapf(err, "error writing %s", name)
+		}
+		if err := enableService(outputDir, name, service); err != nil {
+			return err
+		}
+	}
+
+	if len(errs) > 0 {
+		return errors.Errorf("%d file(s) could not be converted", len(errs))
+	}
+	return nil
+}
+
+// unitDirs returns the directories to read quadlet files from in the order
+// of their priority.
+func unitDirs(isUser bool) []string {
+	if dirs, ok := os.LookupEnv(unitDirsEnv); ok {
+		return filepath.SplitList(dirs)
+	}
+	if isUser {
+		dirs := []string{}
+		if configDir, err := os.UserConfigDir(); err == nil {
+			dirs = append(dirs, filepath.Join(configDir, "containers", "systemd"))
+		}
+		return append(dirs, "/etc/containers/systemd/users")
+	}
+	return []string{"/etc/containers/systemd", "/usr/share/containers/systemd"}
+}
+
+// loadUnits parses the quadlet files in the directories.  A file shadows
+// files with the same name in directories of lower priority.  The returned
+// maps are indexed by the file names and the service names, respectively.
+func loadUnits(dirs []string) (map[string]*quadlet.UnitFile, map[string]string, error) {
+	units := make(map[string]*quadlet.UnitFile)
+	paths := make(map[string]string)
+	for _, dir := range dirs {
+		entries, err := ioutil.ReadDir(dir)
+		if err != nil {
+			if os.IsNotExist(err) {
+				continue
+			}
+			return nil, nil, errors.Wrapf(err, "error reading %s", dir)
+		}
+		for _, entry := range entries {
+			name := entry.Name()
+			if _, ok := quadlet.Extensions[filepath.Ext(name)]; !ok || entry.IsDir() {
+				continue
+			}
+			if _, ok := units[name]; ok {
+				logrus.Debugf("Skipping %s shadowed by a file of higher priority", filepath.Join(dir, name))
+				continue
+			}
+			path := filepath.Join(dir, name)
+			unit, err := quadlet.ParseUnitFilePath(path)
+			if err != nil {
+				logrus.Error(err)
+				continue
+			}
+			serviceName, err := quadlet.ServiceName(name)
+			if err != nil {
+				return nil, nil, err
+			}
+			logrus.Debugf("Loaded %s", path)
+			units[name] = unit
+			paths[serviceName] = path
+		}
+	}
+	return units, paths, nil
+}
+
+// enableService creates the symlinks for the [Install] section of the
+// service, as `systemctl enable` cannot be used for generated units.
+func enableService(outputDir, name string, service *quadlet.UnitFile) error {
+	for key, suffix := range map[string]string{"WantedBy": ".wants", "RequiredBy": ".requires"} {
+		targets, err := service.LookupWords("Install", key)
+		if err != nil {
+			return err
+		}
+		for _, target := range targets {
+			dir := filepath.Join(outputDir, target+suffix)
+			if err := os.MkdirAll(dir, 0755); err != nil {
+				return err
+			}
+			link := filepath.Join(dir, name)
+			if err := os.Symlink(filepath.Join("..", name), link); err != nil && !os.IsExist(err) {
+				return errors.Wrapf(err, "error enabling %s", name)
+			}
+		}
+	}
+	return nil
+}
@@ -49,6 +49,7 @@ require (
 	github.com/opentracing/opentracing-go v1.2.0
 	github.com/pkg/errors v0.9.1
 	github.com/pmezard/go-difflib v1.0.0
+	github.com/prometheus/client_golang v1.1.0
 	github.com/rootless-containers/rootlesskit v0.11.1
 	github.com/sirupsen/logrus v1.7.0
 	github.com/spf13/cobra v1.1.1
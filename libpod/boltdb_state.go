@@ -1173,9 +1173,70 @@ func (s *BoltState) GetAllNetworkAliases(ctr *Container) (map[string][]string, e
 	return aliases, nil
 }
 
+// GetNetworkOptions retrieves the options of the given container in the CNI
+// networks it was connected to with network connect.
+func (s *BoltState) GetNetworkOptions(ctr *Container) (map[string]ContainerNetworkOptions, error) {
+	if !s.valid {
+		return nil, define.ErrDBClosed
+	}
+
+	if !ctr.valid {
+		return nil, define.ErrCtrRemoved
+	}
+
+	if s.namespace != "" && s.namespace != ctr.config.Namespace {
+		return nil, errors.Wrapf(define.ErrNSMismatch, "container %s is in namespace %q, does not match our namespace %q", ctr.ID(), ctr.config.Namespace, s.namespace)
+	}
+
+	ctrID := []byte(ctr.ID())
+
+	db, err := s.getDBCon()
+	if err != nil {
+		return nil, err
+	}
+	defer s.deferredCloseDBCon(db)
+
+	options := make(map[string]ContainerNetworkOptions)
+
+	err = db.View(func(tx *bolt.Tx) error {
+		ctrBucket, err := getCtrBucket(tx)
+		if err != nil {
+			return err
+		}
+
+		dbCtr := ctrBucket.Bucket(ctrID)
+		if dbCtr == nil {
+			ctr.valid = false
+			return errors.Wrapf(define.ErrNoSuchCtr, "container %s does not exist in database", ctr.ID())
+		}
+
+		ctrNetworkBkt := dbCtr.Bucket(networksBkt)
+		if ctrNetworkBkt == nil {
+			// No networks joined, so no options
+			return nil
+		}
+
+		return ctrNetworkBkt.ForEach(func(network, v []byte) error {
+			netOptions, ok, err := decodeNetworkOptions(v)
+			if err != nil {
+				return errors.Wrapf(err, "error decoding container %s options for network %s", ctr.ID(), string(network))
+			}
+			if ok {
+				options[string(network)] = netOptions
+			}
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return options, nil
+}
+
 // NetworkConnect adds the given container to the given network. If aliases are
 // specified, those will be added to the given network.
-func (s *BoltState) NetworkConnect(ctr *Container, network string, aliases []string) error {
+func (s *BoltState) NetworkConnect(ctr *Container, network string, aliases []string, options ContainerNetworkOptions) error {
 	if !s.valid {
 		return define.ErrDBClosed
 	}
@@ -1239,8 +1300,12 @@ func (s *BoltState) NetworkConnect(ctr *Container, network string, aliases []str
 			return errors.Wrapf(define.ErrNetworkExists, "container %s is already connected to CNI network %q", ctr.ID(), network)
 		}
 
-		// Add the network
-		if err := ctrNetworksBkt.Put([]byte(network), ctrID); err != nil {
+		// Add the network, along with its options if there are any
+		netValue, err := encodeNetworkOptions(ctrID, options)
+		if err != nil {
+			return errors.Wrapf(err, "error encoding container %s options for network %s", ctr.ID(), network)
+		}
+		if err := ctrNetworksBkt.Put([]byte(network), netValue); err != nil {
 			return errors.Wrapf(err, "error adding container %s to network %s in DB", ctr.ID(), network)
 		}
 
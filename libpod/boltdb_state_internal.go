@@ -1061,3 +1061,27 @@ func (s *BoltState) lookupContainerID(idOrName string, ctrBucket, namesBucket, n
 	}
 	return id, nil
 }
+
+// encodeNetworkOptions returns the value stored for a network in a
+// container's networks bucket. Historically the value is the ID of the
+// container; networks joined with options store the JSON-encoded options
+// instead.
+func encodeNetworkOptions(ctrID []byte, options ContainerNetworkOptions) ([]byte, error) {
+	if options.StaticIP == nil && options.StaticMAC == nil {
+		return ctrID, nil
+	}
+	return json.Marshal(options)
+}
+
+// decodeNetworkOptions decodes a value of a container's networks bucket.
+// The returned bool is false if no options were stored for the network.
+func decodeNetworkOptions(value []byte) (ContainerNetworkOptions, bool, error) {
+	options := ContainerNetworkOptions{}
+	if !bytes.HasPrefix(value, []byte("{")) {
+		return options, false, nil
+	}
+	if err := json.Unmarshal(value, &options); err != nil {
+		return options, false, err
+	}
+	return options, true, nil
+}
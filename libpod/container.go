@@ -83,6 +83,20 @@ func (ns LinuxNS) String() string {
 	}
 }
 
+// Default exponential backoff of restart policies.  The backoff is only used
+// if at least one of its settings is given when creating a container.
+const (
+	// DefaultRestartDelay is the default delay before the first restart
+	// of a container by its restart policy.
+	DefaultRestartDelay = 100 * time.Millisecond
+	// DefaultRestartMaxDelay is the default maximum delay before a
+	// container is restarted by its restart policy.
+	DefaultRestartMaxDelay = time.Minute
+	// DefaultRestartResetAfter is the default time a container must run
+	// for the restart delay to be reset.
+	DefaultRestartResetAfter = 10 * time.Second
+)
+
 // Valid restart policy types.
 const (
 	// RestartPolicyNone indicates that no restart policy has been requested
@@ -194,7 +208,9 @@ type ContainerState struct {
 	// the path of the file on disk outside the container
 	BindMounts map[string]string `json:"bindMounts,omitempty"`
 	// StoppedByUser indicates whether the container was stopped by an
-	// explicit call to the Stop() API.
+	// explicit call to the Stop() API. It is retained across reboots, so
+	// that containers with the unless-stopped restart policy are not
+	// started at boot.
 	StoppedByUser bool `json:"stoppedByUser,omitempty"`
 	// RestartPolicyMatch indicates whether the conditions for restart
 	// policy have been met.
@@ -203,6 +219,24 @@ type ContainerState struct {
 	// restart policy. This is NOT incremented by normal container restarts
 	// (only by restart policy).
 	RestartCount uint `json:"restartCount,omitempty"`
+	// Restarting indicates that the container exited and is waiting for
+	// its restart delay to expire before being restarted by its restart
+	// policy.
+	Restarting bool `json:"restarting,omitempty"`
+	// RestartBackoff is the delay that was waited before the last restart
+	// by the restart policy. It grows exponentially while the container
+	// keeps exiting shortly after being restarted.
+	RestartBackoff time.Duration `json:"restartBackoff,omitempty"`
+	// UpdatedResources are the resource limits set by Update. If set,
+	// they replace the ones of the OCI spec in the configuration of the
+	// container, which is never changed after creation.
+	UpdatedResources *spec.LinuxResources `json:"updatedResources,omitempty"`
+	// UpdatedRestartPolicy is the restart policy set by Update. If set, it
+	// replaces the restart policy in the configuration of the container.
+	UpdatedRestartPolicy *string `json:"updatedRestartPolicy,omitempty"`
+	// UpdatedRestartRetries is the number of restart retries set by
+	// Update, along with UpdatedRestartPolicy.
+	UpdatedRestartRetries *uint `json:"updatedRestartRetries,omitempty"`
 
 	// ExtensionStageHooks holds hooks which will be executed by libpod
 	// and not delegated to the OCI runtime.
@@ -254,6 +288,18 @@ type ContainerImageVolume struct {
 // network and the ethN where N is an integer
 type ContainerNetworkDescriptions map[string]int
 
+// ContainerNetworkOptions are the options of a container in a CNI network it
+// was connected to with network connect.
+type ContainerNetworkOptions struct {
+	// StaticIP is a static IP to request for the container in the network.
+	// If not set, the container will be dynamically assigned an IP by CNI.
+	StaticIP net.IP `json:"staticIP,omitempty"`
+	// StaticMAC is a static MAC to request for the container in the
+	// network.
+	// If not set, the container will be dynamically assigned a MAC by CNI.
+	StaticMAC net.HardwareAddr `json:"staticMAC,omitempty"`
+}
+
 // Config accessors
 // Unlocked
 
@@ -304,6 +350,21 @@ func (c *Container) specFromState() (*spec.Spec, error) {
 		return nil, errors.Wrapf(err, "error opening container config")
 	}
 
+	// The resources may have been changed by Update since the container
+	// was started
+	if c.state.UpdatedResources != nil {
+		if returnSpec == c.config.Spec {
+			returnSpec = new(spec.Spec)
+			if err := JSONDeepCopy(c.config.Spec, returnSpec); err != nil {
+				return nil, errors.Wrapf(err, "error copying container config")
+			}
+		}
+		if returnSpec.Linux == nil {
+			returnSpec.Linux = new(spec.Linux)
+		}
+		returnSpec.Linux.Resources = c.state.UpdatedResources
+	}
+
 	return returnSpec, nil
 }
 
@@ -565,13 +626,29 @@ func (c *Container) LogTag() string {
 
 // RestartPolicy returns the container's restart policy.
 func (c *Container) RestartPolicy() string {
-	return c.config.RestartPolicy
+	if !c.batched {
+		c.lock.Lock()
+		defer c.lock.Unlock()
+
+		if err := c.syncContainer(); err != nil {
+			logrus.Debugf("Error syncing container %s to get its restart policy: %v", c.ID(), err)
+		}
+	}
+	return c.restartPolicy()
 }
 
 // RestartRetries returns the number of retries that will be attempted when
 // using the "on-failure" restart policy
 func (c *Container) RestartRetries() uint {
-	return c.config.RestartRetries
+	if !c.batched {
+		c.lock.Lock()
+		defer c.lock.Unlock()
+
+		if err := c.syncContainer(); err != nil {
+			logrus.Debugf("Error syncing container %s to get its restart retries: %v", c.ID(), err)
+		}
+	}
+	return c.restartRetries()
 }
 
 // LogDriver returns the log driver for this container
@@ -695,6 +772,32 @@ func (c *Container) ExitCode() (int32, bool, error) {
 	return c.state.ExitCode, c.state.Exited, nil
 }
 
+// Restarting returns whether the container is waiting to be restarted by its
+// restart policy, and the delay before the restart.
+func (c *Container) Restarting() (bool, time.Duration, error) {
+	if !c.batched {
+		c.lock.Lock()
+		defer c.lock.Unlock()
+		if err := c.syncContainer(); err != nil {
+			return false, 0, err
+		}
+	}
+	return c.state.Restarting, c.state.RestartBackoff, nil
+}
+
+// RestartCount returns how many times the container was restarted by its
+// restart policy since it was last started by the user.
+func (c *Container) RestartCount() (uint, error) {
+	if !c.batched {
+		c.lock.Lock()
+		defer c.lock.Unlock()
+		if err := c.syncContainer(); err != nil {
+			return 0, errors.Wrapf(err, "error updating container %s state", c.ID())
+		}
+	}
+	return c.state.RestartCount, nil
+}
+
 // OOMKilled returns whether the container was killed by an OOM condition
 func (c *Container) OOMKilled() (bool, error) {
 	if !c.batched {
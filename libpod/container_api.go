@@ -10,6 +10,7 @@ import (
 	"github.com/containers/podman/v2/libpod/define"
 	"github.com/containers/podman/v2/libpod/events"
 	"github.com/containers/podman/v2/pkg/signal"
+	"github.com/containers/podman/v2/pkg/util"
 	"github.com/opentracing/opentracing-go"
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
@@ -178,6 +179,18 @@ func (c *Container) Stop() error {
 // manually. If timeout is 0, SIGKILL will be used immediately to kill the
 // container.
 func (c *Container) StopWithTimeout(timeout uint) error {
+	return c.stopWithTimeout(timeout, true)
+}
+
+// StopForShutdown stops the container like StopWithTimeout, but does not
+// record the stop as an explicit stop by the user. It is used when the system
+// shuts down, so that containers with the unless-stopped restart policy are
+// started again at boot.
+func (c *Container) StopForShutdown(timeout uint) error {
+	return c.stopWithTimeout(timeout, false)
+}
+
+func (c *Container) stopWithTimeout(timeout uint, byUser bool) error {
 	if !c.batched {
 		c.lock.Lock()
 		defer c.lock.Unlock()
@@ -187,6 +200,15 @@ func (c *Container) StopWithTimeout(timeout uint) error {
 		}
 	}
 
+	// A container waiting to be restarted by its restart policy is already
+	// stopped, but must not be restarted anymore
+	if c.state.Restarting {
+		c.state.Restarting = false
+		c.state.StoppedByUser = byUser
+		c.state.RestartPolicyMatch = false
+		return c.save()
+	}
+
 	if c.ensureState(define.ContainerStateStopped, define.ContainerStateExited) {
 		return define.ErrCtrStopped
 	}
@@ -195,7 +217,14 @@ func (c *Container) StopWithTimeout(timeout uint) error {
 		return errors.Wrapf(define.ErrCtrStateInvalid, "can only stop created or running containers. %s is in state %s", c.ID(), c.state.State.String())
 	}
 
-	return c.stop(timeout)
+	if err := c.stop(timeout); err != nil {
+		return err
+	}
+	if !byUser {
+		c.state.StoppedByUser = false
+		return c.save()
+	}
+	return nil
 }
 
 // Kill sends a signal to a container
@@ -513,23 +542,144 @@ func (c *Container) WaitWithInterval(waitTimeout time.Duration) (int32, error) {
 }
 
 func (c *Container) WaitForConditionWithInterval(waitTimeout time.Duration, condition define.ContainerStatus) (int32, error) {
+	return c.WaitForConditions(context.Background(), waitTimeout, []string{condition.String()})
+}
+
+// WaitForConditions blocks until the container meets the first of the given
+// conditions. Conditions are container states, where stopped and exited are
+// met by any container that is not running, define.WaitConditionHealthy and
+// define.WaitConditionRemoved. If no condition is given, the container is
+// waited for to stop. The container's exit code is returned if the met
+// condition is stopped, exited or removed, and -1 otherwise.
+// The container is checked again whenever an event is written for it. If the
+// events backend cannot be read, the container is polled at the given
+// interval instead.
+func (c *Container) WaitForConditions(ctx context.Context, interval time.Duration, conditions []string) (int32, error) {
+	if len(conditions) == 0 {
+		conditions = []string{define.ContainerStateStopped.String()}
+	}
+	for _, condition := range conditions {
+		if err := define.ValidateWaitCondition(condition); err != nil {
+			return -1, err
+		}
+		if condition == define.WaitConditionHealthy && !c.HasHealthCheck() {
+			return -1, errors.Wrapf(define.ErrInvalidArg, "container %s has no defined healthcheck", c.ID())
+		}
+	}
 	if !c.valid {
+		if util.StringInSlice(define.WaitConditionRemoved, conditions) {
+			return -1, nil
+		}
 		return -1, define.ErrCtrRemoved
 	}
-	if condition == define.ContainerStateStopped || condition == define.ContainerStateExited {
-		return c.WaitWithInterval(waitTimeout)
+
+	var (
+		eventChannel chan *events.Event
+		readDone     chan error
+		poll         <-chan time.Time
+		exitCode     int32 = -1
+	)
+	if c.runtime.config.Engine.EventsLogger != events.Null.String() {
+		ctx, cancel := context.WithCancel(ctx)
+		ch := make(chan *events.Event)
+		eventChannel = ch
+		readDone = make(chan error, 1)
+		defer func() {
+			cancel()
+			// Unblock the reader until it noticed the cancellation.
+			go func() {
+				for range ch {
+				}
+			}()
+		}()
+
+		// Read the events written from now on before checking the
+		// container, so that no change between the check and the first
+		// event is missed.
+		readOptions := events.ReadOptions{
+			EventChannel: ch,
+			Filters:      []string{"container=" + c.ID()},
+			FromStart:    true,
+			Since:        time.Now().Format(time.RFC3339Nano),
+			Stream:       true,
+		}
+		go func() {
+			readDone <- c.runtime.Events(ctx, readOptions)
+		}()
 	}
+
 	for {
-		state, err := c.State()
+		condition, err := c.matchWaitCondition(conditions)
 		if err != nil {
 			return -1, err
 		}
-		if state == condition {
-			break
+		switch condition {
+		case "":
+		case define.ContainerStateStopped.String(), define.ContainerStateExited.String():
+			ec, _, err := c.ExitCode()
+			return ec, err
+		case define.WaitConditionRemoved:
+			return exitCode, nil
+		default:
+			return -1, nil
+		}
+
+		if eventChannel == nil || readDone == nil {
+			poll = time.After(interval)
+		}
+		select {
+		case <-ctx.Done():
+			return -1, ctx.Err()
+		case e, ok := <-eventChannel:
+			if !ok {
+				eventChannel = nil
+				continue
+			}
+			if e.Status == events.Exited {
+				exitCode = int32(e.ContainerExitCode)
+			}
+		case err := <-readDone:
+			logrus.Debugf("Unable to read events, polling container %s instead: %v", c.ID(), err)
+			readDone = nil
+		case <-poll:
+		}
+	}
+}
+
+// matchWaitCondition returns the first of the given wait conditions that the
+// container currently meets, or an empty string if it meets none of them.
+func (c *Container) matchWaitCondition(conditions []string) (string, error) {
+	state, err := c.State()
+	if err != nil {
+		cause := errors.Cause(err)
+		if (cause == define.ErrNoSuchCtr || cause == define.ErrCtrRemoved) && util.StringInSlice(define.WaitConditionRemoved, conditions) {
+			return define.WaitConditionRemoved, nil
+		}
+		return "", err
+	}
+
+	for _, condition := range conditions {
+		switch condition {
+		case define.WaitConditionRemoved:
+		case define.WaitConditionHealthy:
+			status, err := c.HealthCheckStatus()
+			if err != nil {
+				return "", err
+			}
+			if status == define.HealthCheckHealthy {
+				return condition, nil
+			}
+		case define.ContainerStateStopped.String(), define.ContainerStateExited.String():
+			if state != define.ContainerStateRunning && state != define.ContainerStatePaused && state != define.ContainerStateStopping {
+				return condition, nil
+			}
+		default:
+			if state.String() == condition {
+				return condition, nil
+			}
 		}
-		time.Sleep(waitTimeout)
 	}
-	return -1, nil
+	return "", nil
 }
 
 // Cleanup unmounts all mount points in container and cleans up container storage
@@ -719,13 +869,16 @@ type ContainerCheckpointOptions struct {
 	// ImportPrevious tells the API to restore container with two
 	// images. One is TargetFile, the other is ImportPrevious.
 	ImportPrevious string
+	// CreateImage tells the API to store the checkpoint as an OCI image
+	// with the given name, in addition to (or instead of) TargetFile.
+	CreateImage string
 }
 
 // Checkpoint checkpoints a container
 func (c *Container) Checkpoint(ctx context.Context, options ContainerCheckpointOptions) error {
 	logrus.Debugf("Trying to checkpoint container %s", c.ID())
 
-	if options.TargetFile != "" {
+	if options.TargetFile != "" || options.CreateImage != "" {
 		if err := c.prepareCheckpointExport(); err != nil {
 			return err
 		}
@@ -13,6 +13,8 @@ import (
 	"github.com/containers/podman/v2/libpod/events"
 	"github.com/containers/podman/v2/libpod/image"
 	libpodutil "github.com/containers/podman/v2/pkg/util"
+	"github.com/containers/storage"
+	"github.com/containers/storage/pkg/archive"
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
 )
@@ -28,6 +30,11 @@ type ContainerCommitOptions struct {
 	Author         string
 	Message        string
 	Changes        []string
+	// SquashBase is the name or ID of an image the container is based on.
+	// If set, the layers of the base image are kept and all layers on
+	// top of it are squashed with the changes of the container into a
+	// single layer.
+	SquashBase string
 }
 
 // Commit commits the changes between a container and its image, creating a new
@@ -40,6 +47,9 @@ func (c *Container) Commit(ctx context.Context, destImage string, options Contai
 	if c.config.Rootfs != "" {
 		return nil, errors.Errorf("cannot commit a container that uses an exploded rootfs")
 	}
+	if options.Squash && options.SquashBase != "" {
+		return nil, errors.Errorf("cannot squash all layers and only the layers on top of a base image at the same time")
+	}
 
 	if !c.batched {
 		c.lock.Lock()
@@ -71,11 +81,23 @@ func (c *Container) Commit(ctx context.Context, destImage string, options Contai
 		ReportWriter:          options.ReportWriter,
 		SystemContext:         sc,
 		PreferredManifestType: options.PreferredManifestType,
+		Squash:                options.Squash,
 	}
 	importBuilder, err := buildah.ImportBuilder(ctx, c.runtime.store, builderOptions)
 	if err != nil {
 		return nil, err
 	}
+	if options.SquashBase != "" {
+		squashID, err := c.squashOnBaseImage(ctx, importBuilder, options.SquashBase, options.SignaturePolicyPath)
+		if err != nil {
+			return nil, err
+		}
+		defer func() {
+			if err := c.runtime.store.DeleteContainer(squashID); err != nil {
+				logrus.Errorf("Error removing temporary storage container %s: %v", squashID, err)
+			}
+		}()
+	}
 	if options.Author != "" {
 		importBuilder.SetMaintainer(options.Author)
 	}
@@ -206,5 +228,78 @@ func (c *Container) Commit(ctx context.Context, destImage string, options Contai
 		return nil, err
 	}
 	defer c.newContainerEvent(events.Commit)
+	c.runtime.imageRuntime.AutoGarbageCollect(ctx, id)
 	return c.runtime.imageRuntime.NewFromLocal(id)
 }
+
+// squashOnBaseImage prepares the builder to commit the layers on top of the
+// base image along with the changes of the container as a single layer on
+// top of the layers of the base image.  The squashed layer is created in a
+// temporary storage container, whose ID is returned so that it can be removed
+// once the image is committed.
+func (c *Container) squashOnBaseImage(ctx context.Context, builder *buildah.Builder, base, signaturePolicyPath string) (string, error) {
+	baseImage, err := c.runtime.imageRuntime.NewFromLocal(base)
+	if err != nil {
+		return "", err
+	}
+	baseLayer := baseImage.TopLayer()
+	if baseLayer == "" {
+		return "", errors.Errorf("base image %s has no layers, squash all layers instead", base)
+	}
+	ctr, err := c.runtime.store.Container(c.ID())
+	if err != nil {
+		return "", err
+	}
+
+	// Make sure that the container is based on the image by looking for
+	// its top layer among the parents of the container's layer.
+	isBase := false
+	for layerID := ctr.LayerID; layerID != ""; {
+		if layerID == baseLayer {
+			isBase = true
+			break
+		}
+		layer, err := c.runtime.store.Layer(layerID)
+		if err != nil {
+			return "", err
+		}
+		layerID = layer.Parent
+	}
+	if !isBase {
+		return "", errors.Errorf("container %s is not based on image %s", c.ID(), base)
+	}
+
+	// Import the history of the base image, the history of the layers
+	// which get squashed would not match the layers of the new image.
+	baseBuilder, err := buildah.ImportBuilderFromImage(ctx, c.runtime.store, buildah.ImportFromImageOptions{
+		Image:               baseImage.ID(),
+		SignaturePolicyPath: signaturePolicyPath,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	uncompressed := archive.Uncompressed
+	diff, err := c.runtime.store.Diff(baseLayer, ctr.LayerID, &storage.DiffOptions{Compression: &uncompressed})
+	if err != nil {
+		return "", errors.Wrapf(err, "error computing the changes of container %s since image %s", c.ID(), base)
+	}
+	defer diff.Close()
+
+	squashCtr, err := c.runtime.store.CreateContainer("", nil, baseImage.ID(), "", "", nil)
+	if err != nil {
+		return "", err
+	}
+	if _, err := c.runtime.store.ApplyDiff(squashCtr.LayerID, diff); err != nil {
+		if err2 := c.runtime.store.DeleteContainer(squashCtr.ID); err2 != nil {
+			logrus.Errorf("Error removing temporary storage container %s: %v", squashCtr.ID, err2)
+		}
+		return "", errors.Wrapf(err, "error squashing the changes of container %s since image %s", c.ID(), base)
+	}
+
+	builder.ContainerID = squashCtr.ID
+	builder.FromImageID = baseImage.ID()
+	builder.OCIv1.History = baseBuilder.OCIv1.History
+	builder.Docker.History = baseBuilder.Docker.History
+	return squashCtr.ID, nil
+}
@@ -327,6 +327,18 @@ type ContainerMiscConfig struct {
 	// restart the container. Used only if RestartPolicy is set to
 	// "on-failure".
 	RestartRetries uint `json:"restart_retries,omitempty"`
+	// RestartDelay is the delay before the container is restarted by its
+	// restart policy. It doubles each time the container exits again
+	// before running for RestartResetAfter, up to RestartMaxDelay.
+	// If 0, the container is restarted immediately.
+	RestartDelay time.Duration `json:"restart_delay,omitempty"`
+	// RestartMaxDelay is the maximum delay before the container is
+	// restarted by its restart policy. If 0, the delay is not bounded.
+	RestartMaxDelay time.Duration `json:"restart_max_delay,omitempty"`
+	// RestartResetAfter is how long the container must run before exiting
+	// for the restart delay to be reset to RestartDelay. If 0, the delay
+	// is never reset.
+	RestartResetAfter time.Duration `json:"restart_reset_after,omitempty"`
 	// TODO log options for log drivers
 	// PostConfigureNetNS needed when a user namespace is created by an OCI runtime
 	// if the network namespace is created before the user namespace it will be
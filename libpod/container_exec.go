@@ -89,6 +89,14 @@ type ExecSession struct {
 	PID int `json:"pid,omitempty"`
 	// ExitCode is the exit code of the exec session, if it has exited.
 	ExitCode int `json:"exitCode,omitempty"`
+	// Attached is set while a client is attached to the exec session. The
+	// session is then left for the client to remove, as it still has to
+	// read the exit code.
+	Attached bool `json:"attached,omitempty"`
+	// RemoveOnInspect is set for exited exec sessions that an API client
+	// asked to remove after attaching to them. They are removed once they
+	// have been inspected, so the client can still retrieve the exit code.
+	RemoveOnInspect bool `json:"removeOnInspect,omitempty"`
 
 	// Config is the configuration of this exec session.
 	// Cannot be empty.
@@ -500,6 +508,174 @@ func (c *Container) ExecHTTPStartAndAttach(sessionID string, r *http.Request, w
 	return lastErr
 }
 
+// ExecAttach attaches to an exec session that is already running, usually one
+// that was started in detached mode. Output produced before attaching is not
+// replayed. If detachKeys is nil, the detach keys of the exec session are
+// used. Returns the exit code of the exec session once it exits, or
+// define.ErrDetach if the user detached from the session before it exited.
+func (c *Container) ExecAttach(sessionID string, streams *define.AttachStreams, detachKeys *string, resize <-chan remotecommand.TerminalSize) (int, error) {
+	session, err := c.runningExecSession(sessionID)
+	if err != nil {
+		return -1, err
+	}
+
+	if detachKeys == nil {
+		detachKeys = session.Config.DetachKeys
+	}
+
+	logrus.Infof("Going to attach to container %s exec session %s", c.ID(), session.ID())
+
+	if err := c.attachToRunningExec(streams, detachKeys, session.ID(), resize); err != nil {
+		c.releaseAttachedExecSession(session.ID())
+		return -1, err
+	}
+
+	return c.recordAttachedExecExit(session.ID())
+}
+
+// ExecHTTPAttach performs an HTTP attach to an exec session that is already
+// running. Output produced before attaching is not replayed.
+func (c *Container) ExecHTTPAttach(sessionID string, r *http.Request, w http.ResponseWriter, streams *HTTPAttachStreams, detachKeys *string, cancel <-chan bool, hijackDone chan<- bool) error {
+	// Ensure that we don't leak a goroutine here
+	defer func() {
+		close(hijackDone)
+	}()
+
+	session, err := c.runningExecSession(sessionID)
+	if err != nil {
+		return err
+	}
+
+	if detachKeys == nil {
+		detachKeys = session.Config.DetachKeys
+	}
+
+	logrus.Infof("Going to HTTP attach to container %s exec session %s", c.ID(), session.ID())
+
+	if err := c.ociRuntime.ExecHTTPAttach(c, session.ID(), session.Config.Terminal, r, w, streams, detachKeys, cancel, hijackDone); err != nil {
+		c.releaseAttachedExecSession(session.ID())
+		return err
+	}
+
+	if _, err := c.recordAttachedExecExit(session.ID()); err != nil {
+		logrus.Debugf("Unable to record exit code of container %s exec session %s: %v", c.ID(), session.ID(), err)
+	}
+	return nil
+}
+
+// recordAttachedExecExit records the exit code of an exec session that we were
+// attached to when it exited, so that it is available without waiting for the
+// session's exit command to run. The exit command may have run already, in
+// which case it recorded the exit code and removed the exit file.
+func (c *Container) recordAttachedExecExit(sessionID string) (int, error) {
+	if !c.batched {
+		c.lock.Lock()
+		defer c.lock.Unlock()
+
+		if err := c.syncContainer(); err != nil {
+			if errors.Cause(err) == define.ErrNoSuchCtr || errors.Cause(err) == define.ErrCtrRemoved {
+				// The container is gone, there is nothing to
+				// record the exit code in.
+				return c.readExecExitCode(sessionID)
+			}
+			return -1, err
+		}
+	}
+
+	session, ok := c.state.ExecSessions[sessionID]
+	if !ok {
+		return -1, errors.Wrapf(define.ErrNoSuchExecSession, "container %s has no exec session with ID %s", c.ID(), sessionID)
+	}
+	session.Attached = false
+
+	if session.State == define.ExecStateRunning {
+		exitCode, err := c.readExecExitCode(sessionID)
+		if err != nil {
+			if saveErr := c.save(); saveErr != nil {
+				logrus.Errorf("Error saving container %s exec session %s: %v", c.ID(), sessionID, saveErr)
+			}
+			return -1, err
+		}
+		session.State = define.ExecStateStopped
+		session.ExitCode = exitCode
+		session.PID = 0
+	}
+
+	logrus.Debugf("Container %s exec session %s completed with exit code %d", c.ID(), sessionID, session.ExitCode)
+
+	if err := c.save(); err != nil {
+		return -1, err
+	}
+	return session.ExitCode, nil
+}
+
+// releaseAttachedExecSession clears the attached flag of an exec session after
+// attaching to it failed or the client detached from it.
+func (c *Container) releaseAttachedExecSession(sessionID string) {
+	if !c.batched {
+		c.lock.Lock()
+		defer c.lock.Unlock()
+
+		if err := c.syncContainer(); err != nil {
+			logrus.Errorf("Error syncing container %s state to release exec session %s: %v", c.ID(), sessionID, err)
+			return
+		}
+	}
+
+	session, ok := c.state.ExecSessions[sessionID]
+	if !ok {
+		return
+	}
+	session.Attached = false
+	if err := c.save(); err != nil {
+		logrus.Errorf("Error saving container %s exec session %s: %v", c.ID(), sessionID, err)
+	}
+}
+
+// runningExecSession returns a copy of the given exec session after verifying
+// that it is running, and marks the session as attached. The container is not
+// left locked, so that attach sessions do not block other operations on the
+// container.
+func (c *Container) runningExecSession(sessionID string) (*ExecSession, error) {
+	if !c.batched {
+		c.lock.Lock()
+		defer c.lock.Unlock()
+
+		if err := c.syncContainer(); err != nil {
+			return nil, err
+		}
+	}
+
+	session, ok := c.state.ExecSessions[sessionID]
+	if !ok {
+		return nil, errors.Wrapf(define.ErrNoSuchExecSession, "container %s has no exec session with ID %s", c.ID(), sessionID)
+	}
+
+	if session.State == define.ExecStateRunning {
+		alive, err := c.ociRuntime.ExecUpdateStatus(c, session.ID())
+		if err != nil {
+			return nil, err
+		}
+		if !alive {
+			return nil, errors.Wrapf(define.ErrExecSessionStateInvalid, "container %s exec session %s has already exited", c.ID(), session.ID())
+		}
+	}
+	if session.State != define.ExecStateRunning {
+		return nil, errors.Wrapf(define.ErrExecSessionStateInvalid, "can only attach to running exec sessions, while container %s session %s state is %q", c.ID(), session.ID(), session.State.String())
+	}
+
+	session.Attached = true
+	if err := c.save(); err != nil {
+		return nil, err
+	}
+
+	returnSession := new(ExecSession)
+	if err := JSONDeepCopy(session, returnSession); err != nil {
+		return nil, errors.Wrapf(err, "error copying contents of container %s exec session %s", c.ID(), session.ID())
+	}
+	return returnSession, nil
+}
+
 // ExecStop stops an exec session in the container.
 // If a timeout is provided, it will be used; otherwise, the timeout will
 // default to the stop timeout of the container.
@@ -609,6 +785,32 @@ func (c *Container) ExecCleanup(sessionID string) error {
 	return c.cleanupExecBundle(session.ID())
 }
 
+// ExecRemoveOnInspect marks an exited exec session to be removed once it is
+// next inspected. API clients read the exit code of a session by inspecting it
+// after attaching, so it cannot be removed right away. Sessions that are still
+// running are left to their exit command.
+func (c *Container) ExecRemoveOnInspect(sessionID string) error {
+	if !c.batched {
+		c.lock.Lock()
+		defer c.lock.Unlock()
+
+		if err := c.syncContainer(); err != nil {
+			return err
+		}
+	}
+
+	session, ok := c.state.ExecSessions[sessionID]
+	if !ok {
+		return errors.Wrapf(define.ErrNoSuchExecSession, "container %s has no exec session with ID %s", c.ID(), sessionID)
+	}
+	if session.State != define.ExecStateStopped {
+		return nil
+	}
+
+	session.RemoveOnInspect = true
+	return c.save()
+}
+
 // ExecRemove removes an exec session in the container.
 // If force is given, the session will be stopped first if it is running.
 func (c *Container) ExecRemove(sessionID string, force bool) error {
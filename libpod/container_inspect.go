@@ -106,6 +106,7 @@ func (c *Container) getContainerInspectData(size bool, driverData *define.Driver
 			Status:     runtimeInfo.State.String(),
 			Running:    runtimeInfo.State == define.ContainerStateRunning,
 			Paused:     runtimeInfo.State == define.ContainerStatePaused,
+			Restarting: runtimeInfo.Restarting,
 			OOMKilled:  runtimeInfo.OOMKilled,
 			Dead:       runtimeInfo.State.String() == "bad state",
 			Pid:        runtimeInfo.PID,
@@ -146,6 +147,10 @@ func (c *Container) getContainerInspectData(size bool, driverData *define.Driver
 		data.OCIConfigPath = c.state.ConfigPath
 	}
 
+	if runtimeInfo.RestartBackoff > 0 {
+		data.State.RestartBackoff = runtimeInfo.RestartBackoff.String()
+	}
+
 	if c.config.HealthCheckConfig != nil {
 		// This container has a healthcheck defined in it; we need to add it's state
 		healthCheckState, err := c.GetHealthCheckLog()
@@ -365,8 +370,13 @@ func (c *Container) generateInspectContainerHostConfig(ctrSpec *spec.Spec, named
 	hostConfig.LogConfig = logConfig
 
 	restartPolicy := new(define.InspectRestartPolicy)
-	restartPolicy.Name = c.config.RestartPolicy
-	restartPolicy.MaximumRetryCount = c.config.RestartRetries
+	restartPolicy.Name = c.restartPolicy()
+	restartPolicy.MaximumRetryCount = c.restartRetries()
+	if c.config.RestartDelay > 0 {
+		restartPolicy.Delay = c.config.RestartDelay.String()
+		restartPolicy.MaxDelay = c.config.RestartMaxDelay.String()
+		restartPolicy.ResetAfter = c.config.RestartResetAfter.String()
+	}
 	hostConfig.RestartPolicy = restartPolicy
 	if c.config.NoCgroups {
 		hostConfig.Cgroups = "disabled"
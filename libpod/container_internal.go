@@ -212,25 +212,55 @@ func (c *Container) handleExitFile(exitFile string, fi os.FileInfo) error {
 	return nil
 }
 
+// restartPolicy returns the restart policy of the container, as set by Update
+// or else in its configuration.
+func (c *Container) restartPolicy() string {
+	if c.state.UpdatedRestartPolicy != nil {
+		return *c.state.UpdatedRestartPolicy
+	}
+	return c.config.RestartPolicy
+}
+
+// restartRetries returns the number of restart retries of the container, as
+// set by Update or else in its configuration.
+func (c *Container) restartRetries() uint {
+	if c.state.UpdatedRestartRetries != nil {
+		return *c.state.UpdatedRestartRetries
+	}
+	return c.config.RestartRetries
+}
+
+// resources returns the resource limits of the container, as set by Update or
+// else in the OCI spec of its configuration.
+func (c *Container) resources() *spec.LinuxResources {
+	if c.state.UpdatedResources != nil {
+		return c.state.UpdatedResources
+	}
+	if c.config.Spec.Linux == nil {
+		return nil
+	}
+	return c.config.Spec.Linux.Resources
+}
+
 func (c *Container) shouldRestart() bool {
 	// If we did not get a restart policy match, return false
 	// Do the same if we're not a policy that restarts.
 	if !c.state.RestartPolicyMatch ||
-		c.config.RestartPolicy == RestartPolicyNo ||
-		c.config.RestartPolicy == RestartPolicyNone {
+		c.restartPolicy() == RestartPolicyNo ||
+		c.restartPolicy() == RestartPolicyNone {
 		return false
 	}
 
 	// If we're RestartPolicyOnFailure, we need to check retries and exit
 	// code.
-	if c.config.RestartPolicy == RestartPolicyOnFailure {
+	if c.restartPolicy() == RestartPolicyOnFailure {
 		if c.state.ExitCode == 0 {
 			return false
 		}
 
 		// If we don't have a max retries set, continue
-		if c.config.RestartRetries > 0 {
-			if c.state.RestartCount >= c.config.RestartRetries {
+		if c.restartRetries() > 0 {
+			if c.state.RestartCount >= c.restartRetries() {
 				return false
 			}
 		}
@@ -245,7 +275,7 @@ func (c *Container) handleRestartPolicy(ctx context.Context) (_ bool, retErr err
 	if !c.shouldRestart() {
 		return false, nil
 	}
-	logrus.Debugf("Restarting container %s due to restart policy %s", c.ID(), c.config.RestartPolicy)
+	logrus.Debugf("Restarting container %s due to restart policy %s", c.ID(), c.restartPolicy())
 
 	// Need to check if dependencies are alive.
 	if err := c.checkDependenciesAndHandleError(); err != nil {
@@ -260,6 +290,18 @@ func (c *Container) handleRestartPolicy(ctx context.Context) (_ bool, retErr err
 		return false, errors.Wrapf(define.ErrInternal, "invalid container state encountered in restart attempt!")
 	}
 
+	if c.config.RestartDelay > 0 {
+		restart, err := c.restartBackoff(ctx)
+		if err != nil {
+			return false, err
+		}
+		if !restart {
+			// The container may have been started again in the
+			// meantime, in which case it must not be cleaned up.
+			return !c.ensureState(define.ContainerStateConfigured, define.ContainerStateStopped, define.ContainerStateExited), nil
+		}
+	}
+
 	c.newContainerEvent(events.Restart)
 
 	// Increment restart count
@@ -297,6 +339,63 @@ func (c *Container) handleRestartPolicy(ctx context.Context) (_ bool, retErr err
 	return true, nil
 }
 
+// nextRestartDelay returns the delay before the next restart of a container
+// that exited after running for uptime.
+func (c *Container) nextRestartDelay(uptime time.Duration) time.Duration {
+	if c.state.RestartBackoff == 0 || (c.config.RestartResetAfter > 0 && uptime >= c.config.RestartResetAfter) {
+		return c.config.RestartDelay
+	}
+	delay := c.state.RestartBackoff * 2
+	if c.config.RestartMaxDelay > 0 && delay > c.config.RestartMaxDelay {
+		delay = c.config.RestartMaxDelay
+	}
+	return delay
+}
+
+// restartBackoff waits before the container is restarted by its restart
+// policy. The delay starts at RestartDelay, and doubles each time the container
+// exits again before running for RestartResetAfter, up to RestartMaxDelay.
+// The container is unlocked while waiting, so that it can be inspected, stopped
+// or removed in the meantime. Returns false if the restart was cancelled.
+func (c *Container) restartBackoff(ctx context.Context) (bool, error) {
+	delay := c.nextRestartDelay(c.state.FinishedTime.Sub(c.state.StartedTime))
+	c.state.RestartBackoff = delay
+	c.state.Restarting = true
+	if err := c.save(); err != nil {
+		return false, err
+	}
+	logrus.Debugf("Restarting container %s in %s", c.ID(), delay)
+	c.newContainerRestartBackoffEvent(delay)
+
+	if !c.batched {
+		c.lock.Unlock()
+	}
+	select {
+	case <-ctx.Done():
+	case <-time.After(delay):
+	}
+	if !c.batched {
+		c.lock.Lock()
+		if err := c.syncContainer(); err != nil {
+			return false, err
+		}
+	}
+	if ctx.Err() != nil {
+		return false, ctx.Err()
+	}
+
+	// The container was stopped or started while we were waiting
+	if !c.state.Restarting {
+		logrus.Debugf("Restart of container %s was cancelled", c.ID())
+		return false, nil
+	}
+	c.state.Restarting = false
+	if err := c.save(); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
 // Ensure that the container is in a specific state or state.
 // Returns true if the container is in one of the given states,
 // or false otherwise.
@@ -329,7 +428,7 @@ func (c *Container) syncContainer() error {
 		// Only save back to DB if state changed
 		if c.state.State != oldState {
 			// Check for a restart policy match
-			if c.config.RestartPolicy != RestartPolicyNone && c.config.RestartPolicy != RestartPolicyNo &&
+			if c.restartPolicy() != RestartPolicyNone && c.restartPolicy() != RestartPolicyNo &&
 				(oldState == define.ContainerStateRunning || oldState == define.ContainerStatePaused) &&
 				(c.state.State == define.ContainerStateStopped || c.state.State == define.ContainerStateExited) &&
 				!c.state.StoppedByUser {
@@ -462,6 +561,20 @@ func (c *Container) setupStorage(ctx context.Context) error {
 	c.config.IDMappings.UIDMap = containerInfo.UIDMap
 	c.config.IDMappings.GIDMap = containerInfo.GIDMap
 
+	// Record when the image was last used so that image garbage
+	// collection evicts the least recently used images first.
+	if c.config.RootfsImageID != "" {
+		if img, err := c.runtime.imageRuntime.NewFromLocal(c.config.RootfsImageID); err != nil {
+			logrus.Debugf("Unable to look up image %s of container %s: %v", c.config.RootfsImageID, c.ID(), err)
+		} else if img.IsReadOnly() {
+			// Images in read-only stores are never evicted and
+			// their big data cannot be written.
+			logrus.Debugf("Not recording last-used time of image %s in a read-only store", c.config.RootfsImageID)
+		} else if err := img.SetLastUsed(time.Now()); err != nil {
+			logrus.Warnf("Unable to record last-used time of image %s: %v", c.config.RootfsImageID, err)
+		}
+	}
+
 	processLabel := containerInfo.ProcessLabel
 	switch {
 	case c.ociRuntime.SupportsKVM():
@@ -555,9 +668,12 @@ func resetState(state *ContainerState) {
 	state.ExecSessions = make(map[string]*ExecSession)
 	state.LegacyExecSessions = nil
 	state.BindMounts = make(map[string]string)
-	state.StoppedByUser = false
+	// StoppedByUser is deliberately kept, so an explicit stop survives
+	// reboots, as are the settings changed by Update.
 	state.RestartPolicyMatch = false
 	state.RestartCount = 0
+	state.Restarting = false
+	state.RestartBackoff = 0
 }
 
 // Refresh refreshes the container's state after a restart.
@@ -1085,9 +1201,11 @@ func (c *Container) init(ctx context.Context, retainRetries bool) error {
 	c.state.State = define.ContainerStateCreated
 	c.state.StoppedByUser = false
 	c.state.RestartPolicyMatch = false
+	c.state.Restarting = false
 
 	if !retainRetries {
 		c.state.RestartCount = 0
+		c.state.RestartBackoff = 0
 	}
 
 	if err := c.save(); err != nil {
@@ -21,12 +21,16 @@ import (
 
 	cnitypes "github.com/containernetworking/cni/pkg/types/current"
 	"github.com/containernetworking/plugins/pkg/ns"
+	"github.com/containers/buildah"
 	"github.com/containers/buildah/pkg/overlay"
+	buildahutil "github.com/containers/buildah/util"
 	"github.com/containers/common/pkg/apparmor"
 	"github.com/containers/common/pkg/config"
 	"github.com/containers/common/pkg/subscriptions"
+	is "github.com/containers/image/v5/storage"
 	"github.com/containers/podman/v2/libpod/define"
 	"github.com/containers/podman/v2/libpod/events"
+	"github.com/containers/podman/v2/libpod/image"
 	"github.com/containers/podman/v2/pkg/annotations"
 	"github.com/containers/podman/v2/pkg/cgroups"
 	"github.com/containers/podman/v2/pkg/criu"
@@ -87,11 +91,7 @@ func (c *Container) prepare() error {
 		// Set up network namespace if not already set up
 		noNetNS := c.state.NetNS == nil
 		if c.config.CreateNetNS && noNetNS && !c.config.PostConfigureNetNS {
-			if rootless.IsRootless() && len(c.config.Networks) > 0 {
-				netNS, networkStatus, createNetNSErr = AllocRootlessCNI(context.Background(), c)
-			} else {
-				netNS, networkStatus, createNetNSErr = c.runtime.createNetNS(c)
-			}
+			netNS, networkStatus, createNetNSErr = c.runtime.createNetNS(c)
 			if createNetNSErr != nil {
 				return
 			}
@@ -240,7 +240,12 @@ func (c *Container) reloadNetwork() error {
 
 	c.state.NetworkStatus = result
 
-	return c.save()
+	if err := c.save(); err != nil {
+		return err
+	}
+
+	// The DNS servers handed out by the networks may have changed
+	return c.updateResolvConf()
 }
 
 func (c *Container) getUserOverrides() *lookup.Overrides {
@@ -285,6 +290,18 @@ func (c *Container) generateSpec(ctx context.Context) (*spec.Spec, error) {
 
 	g := generate.Generator{Config: c.config.Spec}
 
+	// Apply the resource limits changed by Update
+	if c.state.UpdatedResources != nil {
+		resources := new(spec.LinuxResources)
+		if err := JSONDeepCopy(c.state.UpdatedResources, resources); err != nil {
+			return nil, err
+		}
+		if g.Config.Linux == nil {
+			g.Config.Linux = new(spec.Linux)
+		}
+		g.Config.Linux.Resources = resources
+	}
+
 	// If network namespace was requested, add it now
 	if c.config.CreateNetNS {
 		if c.config.PostConfigureNetNS {
@@ -967,6 +984,82 @@ func (c *Container) exportCheckpoint(options ContainerCheckpointOptions) error {
 	return nil
 }
 
+// createCheckpointImage exports the checkpoint of the container into a
+// temporary archive and stores that archive in a new single layer OCI image.
+// The image is annotated with information about the checkpointed container
+// so that it can be recognized and restored on another host.
+func (c *Container) createCheckpointImage(ctx context.Context, options ContainerCheckpointOptions) error {
+	logrus.Debugf("Creating checkpoint image %q of container %q", options.CreateImage, c.ID())
+
+	sc := image.GetSystemContext(c.runtime.config.Engine.SignaturePolicyPath, "", false)
+	candidates, _, _, err := buildahutil.ResolveName(options.CreateImage, "", sc, c.runtime.store)
+	if err != nil {
+		return errors.Wrapf(err, "error resolving name %q", options.CreateImage)
+	}
+	if len(candidates) == 0 {
+		return errors.Errorf("error parsing checkpoint image name %q", options.CreateImage)
+	}
+	imageRef, err := is.Transport.ParseStoreReference(c.runtime.store, candidates[0])
+	if err != nil {
+		return errors.Wrapf(err, "error parsing checkpoint image name %q", options.CreateImage)
+	}
+
+	tmpDir, err := ioutil.TempDir("", "checkpoint-image")
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := os.RemoveAll(tmpDir); err != nil {
+			logrus.Errorf("unable to remove %s: %v", tmpDir, err)
+		}
+	}()
+
+	exportOptions := options
+	exportOptions.TargetFile = filepath.Join(tmpDir, define.CheckpointImageArchive)
+	if err := c.exportCheckpoint(exportOptions); err != nil {
+		return err
+	}
+
+	builderOptions := buildah.BuilderOptions{
+		FromImage:           buildah.BaseImageFakeName,
+		SignaturePolicyPath: c.runtime.config.Engine.SignaturePolicyPath,
+		SystemContext:       sc,
+	}
+	importBuilder, err := buildah.NewBuilder(ctx, c.runtime.store, builderOptions)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := importBuilder.Delete(); err != nil {
+			logrus.Errorf("error removing checkpoint image working container: %v", err)
+		}
+	}()
+
+	// Store the archive as is, restore unpacks it like an exported
+	// checkpoint.
+	if err := importBuilder.Add("/", false, buildah.AddAndCopyOptions{}, exportOptions.TargetFile); err != nil {
+		return errors.Wrapf(err, "error adding checkpoint archive to image %q", options.CreateImage)
+	}
+
+	importBuilder.SetAnnotation(define.CheckpointAnnotationName, c.Name())
+	importBuilder.SetAnnotation(define.CheckpointAnnotationID, c.ID())
+	importBuilder.SetAnnotation(define.CheckpointAnnotationRootfsImageID, c.config.RootfsImageID)
+	importBuilder.SetAnnotation(define.CheckpointAnnotationRootfsImageName, c.config.RootfsImageName)
+	importBuilder.SetAnnotation(define.CheckpointAnnotationEngine, "podman")
+	importBuilder.SetAnnotation(define.CheckpointAnnotationEngineVersion, version.Version.String())
+	importBuilder.SetAnnotation(define.CheckpointAnnotationRuntimeName, c.ociRuntime.Name())
+
+	commitOptions := buildah.CommitOptions{
+		SignaturePolicyPath:   c.runtime.config.Engine.SignaturePolicyPath,
+		SystemContext:         sc,
+		PreferredManifestType: buildah.OCIv1ImageManifest,
+	}
+	if _, _, _, err := importBuilder.Commit(ctx, imageRef, commitOptions); err != nil {
+		return errors.Wrapf(err, "error committing checkpoint image %q", options.CreateImage)
+	}
+	return nil
+}
+
 func (c *Container) checkpointRestoreSupported() error {
 	if !criu.CheckForCriu() {
 		return errors.Errorf("checkpoint/restore requires at least CRIU %d", criu.MinCriuVersion)
@@ -1003,8 +1096,8 @@ func (c *Container) checkpoint(ctx context.Context, options ContainerCheckpointO
 		return errors.Wrapf(define.ErrCtrStateInvalid, "%q is not running, cannot checkpoint", c.state.State)
 	}
 
-	if c.AutoRemove() && options.TargetFile == "" {
-		return errors.Errorf("cannot checkpoint containers that have been started with '--rm' unless '--export' is used")
+	if c.AutoRemove() && options.TargetFile == "" && options.CreateImage == "" {
+		return errors.Errorf("cannot checkpoint containers that have been started with '--rm' unless '--export' or '--create-image' is used")
 	}
 
 	if err := c.checkpointRestoreLabelLog("dump.log"); err != nil {
@@ -1043,6 +1136,12 @@ func (c *Container) checkpoint(ctx context.Context, options ContainerCheckpointO
 		}
 	}
 
+	if options.CreateImage != "" {
+		if err = c.createCheckpointImage(ctx, options); err != nil {
+			return err
+		}
+	}
+
 	logrus.Debugf("Checkpointed container %s", c.ID())
 
 	if !options.KeepRunning && !options.PreCheckPoint {
@@ -1620,6 +1719,26 @@ rootless=%d
 
 // generateResolvConf generates a containers resolv.conf
 func (c *Container) generateResolvConf() (string, error) {
+	return c.writeResolvConf(true)
+}
+
+// updateResolvConf rewrites the resolv.conf generated for the container in
+// place, so that a running container sees the changes through its bind
+// mount.  Containers using another resolv.conf are left alone.
+func (c *Container) updateResolvConf() error {
+	if c.config.UseImageResolvConf || c.config.NetNsCtr != "" {
+		return nil
+	}
+	if c.state.BindMounts["/etc/resolv.conf"] != filepath.Join(c.state.RunDir, "resolv.conf") {
+		return nil
+	}
+	_, err := c.writeResolvConf(false)
+	return err
+}
+
+// writeResolvConf writes the containers resolv.conf.  If replace is set, an
+// existing file is removed first rather than overwritten.
+func (c *Container) writeResolvConf(replace bool) (string, error) {
 	var (
 		nameservers    []string
 		cniNameServers []string
@@ -1724,8 +1843,10 @@ func (c *Container) generateResolvConf() (string, error) {
 
 	destPath := filepath.Join(c.state.RunDir, "resolv.conf")
 
-	if err := os.Remove(destPath); err != nil && !os.IsNotExist(err) {
-		return "", errors.Wrapf(err, "container %s", c.ID())
+	if replace {
+		if err := os.Remove(destPath); err != nil && !os.IsNotExist(err) {
+			return "", errors.Wrapf(err, "container %s", c.ID())
+		}
 	}
 
 	// Build resolv.conf
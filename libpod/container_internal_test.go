@@ -9,6 +9,7 @@ import (
 	"runtime"
 	"strings"
 	"testing"
+	"time"
 
 	rspec "github.com/opencontainers/runtime-spec/specs-go"
 	"github.com/stretchr/testify/assert"
@@ -82,3 +83,43 @@ func init() {
 		panic("we need a reliable executable path on Windows")
 	}
 }
+
+func TestNextRestartDelay(t *testing.T) {
+	c := Container{
+		config: &ContainerConfig{
+			ContainerMiscConfig: ContainerMiscConfig{
+				RestartDelay:      100 * time.Millisecond,
+				RestartMaxDelay:   time.Second,
+				RestartResetAfter: 10 * time.Second,
+			},
+		},
+		state: &ContainerState{},
+	}
+
+	// The delay doubles on each restart after a short uptime, up to the
+	// maximum delay
+	expected := []time.Duration{
+		100 * time.Millisecond,
+		200 * time.Millisecond,
+		400 * time.Millisecond,
+		800 * time.Millisecond,
+		time.Second,
+		time.Second,
+	}
+	for _, want := range expected {
+		delay := c.nextRestartDelay(time.Second)
+		assert.Equal(t, want, delay)
+		c.state.RestartBackoff = delay
+	}
+
+	// The delay is reset once the container ran for RestartResetAfter
+	assert.Equal(t, 100*time.Millisecond, c.nextRestartDelay(10*time.Second))
+
+	// Without RestartResetAfter, the delay is never reset
+	c.config.RestartResetAfter = 0
+	assert.Equal(t, time.Second, c.nextRestartDelay(time.Hour))
+
+	// Without RestartMaxDelay, the delay is not bounded
+	c.config.RestartMaxDelay = 0
+	assert.Equal(t, 2*time.Second, c.nextRestartDelay(time.Second))
+}
@@ -0,0 +1,149 @@
+package libpod
+
+import (
+	"github.com/containers/podman/v2/libpod/define"
+	"github.com/containers/podman/v2/libpod/events"
+	spec "github.com/opencontainers/runtime-spec/specs-go"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+// Update changes the resource limits and the restart policy of the
+// container.  Only the CPU, cpuset, memory, pids and block IO weight limits
+// set in resources are changed.  The changes are persisted in the state of
+// the container, so they also apply when it is started again, before running
+// and paused containers are updated live through the OCI runtime; they are
+// rolled back if the OCI runtime fails.  A nil resources or restartPolicy
+// leaves the respective settings unchanged, restartRetries is only used with
+// the on-failure policy.
+func (c *Container) Update(resources *spec.LinuxResources, restartPolicy *string, restartRetries *uint) error {
+	if !c.batched {
+		c.lock.Lock()
+		defer c.lock.Unlock()
+
+		if err := c.syncContainer(); err != nil {
+			return err
+		}
+	}
+
+	if c.ensureState(define.ContainerStateRemoving) {
+		return errors.Wrapf(define.ErrCtrStateInvalid, "container %s is being removed", c.ID())
+	}
+
+	policy := c.restartPolicy()
+	retries := c.restartRetries()
+	if restartPolicy != nil {
+		switch *restartPolicy {
+		case RestartPolicyNone, RestartPolicyNo, RestartPolicyOnFailure, RestartPolicyAlways, RestartPolicyUnlessStopped:
+		default:
+			return errors.Wrapf(define.ErrInvalidArg, "%q is not a valid restart policy", *restartPolicy)
+		}
+		if c.AutoRemove() && *restartPolicy != RestartPolicyNone && *restartPolicy != RestartPolicyNo {
+			return errors.Wrapf(define.ErrInvalidArg, "the restart policy of container %s cannot be changed as it is removed when it exits", c.ID())
+		}
+		policy = *restartPolicy
+		retries = 0
+	}
+	if restartRetries != nil {
+		if policy != RestartPolicyOnFailure {
+			return errors.Wrapf(define.ErrInvalidArg, "restart retries can only be set with the %q restart policy", RestartPolicyOnFailure)
+		}
+		retries = *restartRetries
+	}
+
+	var merged *spec.LinuxResources
+	if resources != nil {
+		if c.config.NoCgroups {
+			return errors.Wrapf(define.ErrNoCgroups, "cannot update the resources of container %s without using cgroups", c.ID())
+		}
+		merged = mergeLinuxResources(c.resources(), resources)
+	}
+
+	oldResources := c.state.UpdatedResources
+	oldPolicy := c.state.UpdatedRestartPolicy
+	oldRetries := c.state.UpdatedRestartRetries
+	if merged != nil {
+		c.state.UpdatedResources = merged
+	}
+	c.state.UpdatedRestartPolicy = &policy
+	c.state.UpdatedRestartRetries = &retries
+	if err := c.save(); err != nil {
+		return errors.Wrapf(err, "error saving state of container %s", c.ID())
+	}
+
+	if merged != nil && c.ensureState(define.ContainerStateRunning, define.ContainerStatePaused) {
+		if err := c.ociRuntime.UpdateContainerResources(c, merged); err != nil {
+			c.state.UpdatedResources = oldResources
+			c.state.UpdatedRestartPolicy = oldPolicy
+			c.state.UpdatedRestartRetries = oldRetries
+			if err := c.save(); err != nil {
+				logrus.Errorf("Error restoring state of container %s after failed update: %v", c.ID(), err)
+			}
+			return errors.Wrapf(err, "error updating resources of container %s", c.ID())
+		}
+	}
+
+	logrus.Debugf("Updated container %s", c.ID())
+	c.newContainerEvent(events.Update)
+	return nil
+}
+
+// mergeLinuxResources returns a copy of current with the CPU, cpuset, memory,
+// pids and block IO weight limits set in update replaced
+func mergeLinuxResources(current, update *spec.LinuxResources) *spec.LinuxResources {
+	merged := new(spec.LinuxResources)
+	if current != nil {
+		*merged = *current
+	}
+
+	if update.CPU != nil {
+		cpu := new(spec.LinuxCPU)
+		if merged.CPU != nil {
+			*cpu = *merged.CPU
+		}
+		if update.CPU.Shares != nil {
+			cpu.Shares = update.CPU.Shares
+		}
+		if update.CPU.Quota != nil {
+			cpu.Quota = update.CPU.Quota
+		}
+		if update.CPU.Period != nil {
+			cpu.Period = update.CPU.Period
+		}
+		if update.CPU.Cpus != "" {
+			cpu.Cpus = update.CPU.Cpus
+		}
+		if update.CPU.Mems != "" {
+			cpu.Mems = update.CPU.Mems
+		}
+		merged.CPU = cpu
+	}
+
+	if update.Memory != nil {
+		memory := new(spec.LinuxMemory)
+		if merged.Memory != nil {
+			*memory = *merged.Memory
+		}
+		if update.Memory.Limit != nil {
+			memory.Limit = update.Memory.Limit
+		}
+		if update.Memory.Swap != nil {
+			memory.Swap = update.Memory.Swap
+		}
+		merged.Memory = memory
+	}
+
+	if update.Pids != nil {
+		merged.Pids = &spec.LinuxPids{Limit: update.Pids.Limit}
+	}
+
+	if update.BlockIO != nil && update.BlockIO.Weight != nil {
+		blockIO := new(spec.LinuxBlockIO)
+		if merged.BlockIO != nil {
+			*blockIO = *merged.BlockIO
+		}
+		blockIO.Weight = update.BlockIO.Weight
+		merged.BlockIO = blockIO
+	}
+	return merged
+}
@@ -66,3 +66,33 @@ const (
 	// annotation.
 	InspectResponseFalse = "FALSE"
 )
+
+// Annotations set on the manifest of checkpoint images created by
+// 'podman container checkpoint --create-image'.
+const (
+	// CheckpointAnnotationName is the name of the container the
+	// checkpoint image was created from.
+	CheckpointAnnotationName = "io.podman.annotations.checkpoint.name"
+	// CheckpointAnnotationID is the ID of the container the checkpoint
+	// image was created from.
+	CheckpointAnnotationID = "io.podman.annotations.checkpoint.id"
+	// CheckpointAnnotationRootfsImageID is the ID of the image the
+	// checkpointed container was based on.
+	CheckpointAnnotationRootfsImageID = "io.podman.annotations.checkpoint.rootfsImageID"
+	// CheckpointAnnotationRootfsImageName is the name of the image the
+	// checkpointed container was based on.
+	CheckpointAnnotationRootfsImageName = "io.podman.annotations.checkpoint.rootfsImageName"
+	// CheckpointAnnotationEngine is the container engine that created
+	// the checkpoint image.
+	CheckpointAnnotationEngine = "io.podman.annotations.checkpoint.engine"
+	// CheckpointAnnotationEngineVersion is the version of the container
+	// engine that created the checkpoint image.
+	CheckpointAnnotationEngineVersion = "io.podman.annotations.checkpoint.engineVersion"
+	// CheckpointAnnotationRuntimeName is the name of the OCI runtime used
+	// to checkpoint the container.
+	CheckpointAnnotationRuntimeName = "io.podman.annotations.checkpoint.runtimeName"
+
+	// CheckpointImageArchive is the path, relative to the root of a
+	// checkpoint image, of the checkpoint archive stored in it.
+	CheckpointImageArchive = "checkpoint.tar.gz"
+)
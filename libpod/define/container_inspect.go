@@ -78,6 +78,13 @@ type InspectRestartPolicy struct {
 	// "on-failure" restart policy is in use. Not used if "on-failure" is
 	// not set.
 	MaximumRetryCount uint `json:"MaximumRetryCount"`
+	// Delay is the initial delay before the container is restarted.
+	Delay string `json:"Delay,omitempty"`
+	// MaxDelay is the maximum delay before the container is restarted.
+	MaxDelay string `json:"MaxDelay,omitempty"`
+	// ResetAfter is how long the container must run for the delay to be
+	// reset.
+	ResetAfter string `json:"ResetAfter,omitempty"`
 }
 
 // InspectLogConfig holds information about a container's configured log driver
@@ -187,7 +194,7 @@ type InspectContainerState struct {
 	Status      string             `json:"Status"`
 	Running     bool               `json:"Running"`
 	Paused      bool               `json:"Paused"`
-	Restarting  bool               `json:"Restarting"` // TODO
+	Restarting  bool               `json:"Restarting"`
 	OOMKilled   bool               `json:"OOMKilled"`
 	Dead        bool               `json:"Dead"`
 	Pid         int                `json:"Pid"`
@@ -197,6 +204,10 @@ type InspectContainerState struct {
 	StartedAt   time.Time          `json:"StartedAt"`
 	FinishedAt  time.Time          `json:"FinishedAt"`
 	Healthcheck HealthCheckResults `json:"Healthcheck,omitempty"`
+	// RestartBackoff is the delay that was waited before the last restart
+	// by the restart policy, or that is being waited if Restarting is
+	// set. It grows exponentially while the container is crash-looping.
+	RestartBackoff string `json:"RestartBackoff,omitempty"`
 }
 
 // HealthCheckResults describes the results/logs from a healthcheck
@@ -130,9 +130,49 @@ type ContainerStats struct {
 	MemUsage      uint64
 	MemLimit      uint64
 	MemPerc       float64
+	MemCache      uint64
+	MemRSS        uint64
+	MemSwap       uint64
 	NetInput      uint64
 	NetOutput     uint64
 	BlockInput    uint64
 	BlockOutput   uint64
 	PIDs          uint64
+	// Network contains the usage of each network interface of the
+	// container, by interface name.
+	Network map[string]ContainerNetworkStats
+}
+
+// ContainerNetworkStats contains the usage of a single network interface of a
+// container
+type ContainerNetworkStats struct {
+	RxBytes   uint64
+	RxPackets uint64
+	RxErrors  uint64
+	RxDropped uint64
+	TxBytes   uint64
+	TxPackets uint64
+	TxErrors  uint64
+	TxDropped uint64
+}
+
+const (
+	// WaitConditionHealthy is met once the healthcheck of a container
+	// reports it as healthy.
+	WaitConditionHealthy = "healthy"
+	// WaitConditionRemoved is met once a container has been removed.
+	WaitConditionRemoved = "removed"
+)
+
+// ValidateWaitCondition returns an error if condition is neither a container
+// state nor one of the additional conditions containers can be waited for.
+func ValidateWaitCondition(condition string) error {
+	switch condition {
+	case WaitConditionHealthy, WaitConditionRemoved:
+		return nil
+	}
+	if _, err := StringToContainerStatus(condition); err != nil {
+		return errors.Wrapf(ErrInvalidArg, "unknown wait condition: %s", condition)
+	}
+	return nil
 }
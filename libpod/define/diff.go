@@ -0,0 +1,30 @@
+package define
+
+import "github.com/containers/storage/pkg/archive"
+
+// FileDetails describes a file of a layer, image or container.
+type FileDetails struct {
+	// Size of the file in bytes.
+	Size int64 `json:"size"`
+	// Mode of the file in the format of ls(1), e.g. "-rw-r--r--".
+	Mode string `json:"mode"`
+	// UID of the owner of the file.
+	UID uint32 `json:"uid"`
+	// GID of the owner of the file.
+	GID uint32 `json:"gid"`
+	// Digest of the content of regular files.
+	Digest string `json:"digest,omitempty"`
+	// LinkTarget is the target of symbolic links.
+	LinkTarget string `json:"linkTarget,omitempty"`
+}
+
+// ChangeDetails describes a change to a path between two layers, images or
+// containers, including the details of the file before and after the
+// change.  Before is nil for added paths, and After is nil for deleted
+// paths.
+type ChangeDetails struct {
+	Path   string             `json:"path"`
+	Kind   archive.ChangeType `json:"kind"`
+	Before *FileDetails       `json:"before,omitempty"`
+	After  *FileDetails       `json:"after,omitempty"`
+}
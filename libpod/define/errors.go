@@ -18,6 +18,14 @@ var (
 	// ErrMultipleImages found multiple name and tag matches
 	ErrMultipleImages = errors.New("found multiple name and tag matches")
 
+	// ErrNoSuchManifestList indicates the requested image does not exist
+	// or is not a manifest list or image index
+	ErrNoSuchManifestList = errors.New("no such manifest list")
+
+	// ErrNoSuchBlob indicates the requested blob is neither an image
+	// config nor a layer in local storage
+	ErrNoSuchBlob = errors.New("no such blob")
+
 	// ErrNoSuchTag indicates the requested image tag does not exist
 	ErrNoSuchTag = errors.New("no such tag")
 
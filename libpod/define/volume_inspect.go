@@ -48,4 +48,21 @@ type InspectVolumeData struct {
 	// volume for a specific container, and will be be removed when any
 	// container using it is removed.
 	Anonymous bool `json:"Anonymous,omitempty"`
+	// Quota contains the size and inodes limits of the volume and their
+	// current usage, if the volume was created with the size or inodes
+	// options.
+	Quota *InspectVolumeQuota `json:"Quota,omitempty"`
+}
+
+// InspectVolumeQuota contains the limits of a volume with a quota and their
+// current usage.
+type InspectVolumeQuota struct {
+	// Size is the maximum size of the volume in bytes, 0 if unlimited.
+	Size uint64 `json:"Size"`
+	// Inodes is the maximum number of inodes of the volume, 0 if unlimited.
+	Inodes uint64 `json:"Inodes"`
+	// SizeUsed is the number of bytes used by the volume.
+	SizeUsed uint64 `json:"SizeUsed"`
+	// InodesUsed is the number of inodes used by the volume.
+	InodesUsed uint64 `json:"InodesUsed"`
 }
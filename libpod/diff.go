@@ -2,10 +2,17 @@ package libpod
 
 import (
 	"io"
+	"os"
+	"path/filepath"
 
+	"github.com/containers/podman/v2/libpod/define"
 	"github.com/containers/podman/v2/libpod/layers"
 	"github.com/containers/storage/pkg/archive"
+	"github.com/containers/storage/pkg/system"
+	securejoin "github.com/cyphar/filepath-securejoin"
+	"github.com/opencontainers/go-digest"
 	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
 )
 
 var containerMounts = map[string]bool{
@@ -22,17 +29,86 @@ var containerMounts = map[string]bool{
 
 // GetDiff returns the differences between the two images, layers, or containers
 func (r *Runtime) GetDiff(from, to string) ([]archive.Change, error) {
-	toLayer, err := r.getLayerID(to)
+	fromLayer, toLayer, err := r.getDiffLayerIDs(from, to)
+	if err != nil {
+		return nil, err
+	}
+	return r.getLayerDiff(fromLayer, toLayer)
+}
+
+// GetDiffDetails returns the differences between the two images, layers, or
+// containers along with the size, mode, ownership and content digest of the
+// changed paths before and after the change.  If from is empty, to is
+// compared to its parent layer.
+func (r *Runtime) GetDiffDetails(from, to string) ([]define.ChangeDetails, error) {
+	fromLayer, toLayer, err := r.getDiffLayerIDs(from, to)
 	if err != nil {
 		return nil, err
 	}
+	changes, err := r.getLayerDiff(fromLayer, toLayer)
+	if err != nil {
+		return nil, err
+	}
+	if fromLayer == "" {
+		layer, err := r.store.Layer(toLayer)
+		if err != nil {
+			return nil, err
+		}
+		fromLayer = layer.Parent
+	}
+
+	toMount, err := r.store.Mount(toLayer, "")
+	if err != nil {
+		return nil, errors.Wrapf(err, "error mounting layer %s", toLayer)
+	}
+	defer r.unmountDiffLayer(toLayer)
+	fromMount := ""
+	if fromLayer != "" {
+		fromMount, err = r.store.Mount(fromLayer, "")
+		if err != nil {
+			return nil, errors.Wrapf(err, "error mounting layer %s", fromLayer)
+		}
+		defer r.unmountDiffLayer(fromLayer)
+	}
+
+	details := make([]define.ChangeDetails, 0, len(changes))
+	for _, c := range changes {
+		detail := define.ChangeDetails{Path: c.Path, Kind: c.Kind}
+		if c.Kind != archive.ChangeAdd && fromMount != "" {
+			if detail.Before, err = getFileDetails(fromMount, c.Path); err != nil {
+				return nil, err
+			}
+		}
+		if c.Kind != archive.ChangeDelete {
+			if detail.After, err = getFileDetails(toMount, c.Path); err != nil {
+				return nil, err
+			}
+		}
+		details = append(details, detail)
+	}
+	return details, nil
+}
+
+// getDiffLayerIDs returns the IDs of the layers of the two images, layers, or
+// containers to compare.  The ID of the from layer is empty if from is empty.
+func (r *Runtime) getDiffLayerIDs(from, to string) (string, string, error) {
+	toLayer, err := r.getLayerID(to)
+	if err != nil {
+		return "", "", err
+	}
 	fromLayer := ""
 	if from != "" {
 		fromLayer, err = r.getLayerID(from)
 		if err != nil {
-			return nil, err
+			return "", "", err
 		}
 	}
+	return fromLayer, toLayer, nil
+}
+
+// getLayerDiff returns the differences between the two layers, excluding the
+// paths podman mounts into containers
+func (r *Runtime) getLayerDiff(fromLayer, toLayer string) ([]archive.Change, error) {
 	var rchanges []archive.Change
 	changes, err := r.store.Changes(fromLayer, toLayer)
 	if err == nil {
@@ -46,6 +122,57 @@ func (r *Runtime) GetDiff(from, to string) ([]archive.Change, error) {
 	return rchanges, err
 }
 
+// unmountDiffLayer unmounts a layer mounted to compute the details of a diff
+func (r *Runtime) unmountDiffLayer(layerID string) {
+	if _, err := r.store.Unmount(layerID, false); err != nil {
+		logrus.Errorf("Error unmounting layer %s: %v", layerID, err)
+	}
+}
+
+// getFileDetails returns the details of the file at path in the mounted
+// layer.  The path is resolved within the mount, so symlinks in the layer
+// cannot point outside of it.
+func getFileDetails(mountPoint, path string) (*define.FileDetails, error) {
+	parent, err := securejoin.SecureJoin(mountPoint, filepath.Dir(path))
+	if err != nil {
+		return nil, err
+	}
+	fullPath := filepath.Join(parent, filepath.Base(path))
+
+	info, err := os.Lstat(fullPath)
+	if err != nil {
+		return nil, err
+	}
+	stat, err := system.Lstat(fullPath)
+	if err != nil {
+		return nil, err
+	}
+	details := define.FileDetails{
+		Size: info.Size(),
+		Mode: info.Mode().String(),
+		UID:  stat.UID(),
+		GID:  stat.GID(),
+	}
+	switch {
+	case info.Mode().IsRegular():
+		f, err := os.Open(fullPath)
+		if err != nil {
+			return nil, err
+		}
+		defer f.Close()
+		d, err := digest.Canonical.FromReader(f)
+		if err != nil {
+			return nil, errors.Wrapf(err, "error computing digest of %s", path)
+		}
+		details.Digest = d.String()
+	case info.Mode()&os.ModeSymlink != 0:
+		if details.LinkTarget, err = os.Readlink(fullPath); err != nil {
+			return nil, err
+		}
+	}
+	return &details, nil
+}
+
 // ApplyDiffTarStream applies the changes stored in 'diff' to the layer 'to'
 func (r *Runtime) ApplyDiffTarStream(to string, diff io.Reader) error {
 	toLayer, err := r.getLayerID(to)
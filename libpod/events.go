@@ -3,7 +3,9 @@ package libpod
 import (
 	"context"
 	"fmt"
+	"strconv"
 	"sync"
+	"time"
 
 	"github.com/containers/podman/v2/libpod/events"
 	"github.com/pkg/errors"
@@ -50,6 +52,49 @@ func (c *Container) newContainerExitedEvent(exitCode int32) {
 	}
 }
 
+// newContainerHealthStatusEvent creates a new event for a change of the
+// container's health status
+func (c *Container) newContainerHealthStatusEvent(healthStatus string) {
+	e := events.NewEvent(events.HealthStatus)
+	e.ID = c.ID()
+	e.Name = c.Name()
+	e.Image = c.config.RootfsImageName
+	e.Type = events.Container
+
+	attributes := c.Labels()
+	attributes["health_status"] = healthStatus
+	e.Details = events.Details{
+		ID:         e.ID,
+		Attributes: attributes,
+	}
+
+	if err := c.runtime.eventer.Write(e); err != nil {
+		logrus.Errorf("unable to write container event: %q", err)
+	}
+}
+
+// newContainerRestartBackoffEvent creates a new event for a container waiting
+// to be restarted by its restart policy
+func (c *Container) newContainerRestartBackoffEvent(delay time.Duration) {
+	e := events.NewEvent(events.RestartBackoff)
+	e.ID = c.ID()
+	e.Name = c.Name()
+	e.Image = c.config.RootfsImageName
+	e.Type = events.Container
+
+	attributes := c.Labels()
+	attributes["delay"] = delay.String()
+	attributes["restartCount"] = strconv.FormatUint(uint64(c.state.RestartCount), 10)
+	e.Details = events.Details{
+		ID:         e.ID,
+		Attributes: attributes,
+	}
+
+	if err := c.runtime.eventer.Write(e); err != nil {
+		logrus.Errorf("unable to write pod event: %q", err)
+	}
+}
+
 // netNetworkEvent creates a new event based on a network connect/disconnect
 func (c *Container) newNetworkEvent(status events.Status, netName string) {
 	e := events.NewEvent(status)
@@ -131,6 +131,9 @@ const (
 	Exited Status = "died"
 	// Export ...
 	Export Status = "export"
+	// HealthStatus indicates that the health status of a container
+	// changed
+	HealthStatus Status = "health_status"
 	// History ...
 	History Status = "history"
 	// Import ...
@@ -165,6 +168,9 @@ const (
 	Renumber Status = "renumber"
 	// Restart indicates the target was restarted via an API call.
 	Restart Status = "restart"
+	// RestartBackoff indicates that a container exited and will be
+	// restarted by its restart policy after a delay.
+	RestartBackoff Status = "restart-backoff"
 	// Restore ...
 	Restore Status = "restore"
 	// Save ...
@@ -183,6 +189,8 @@ const (
 	Unpause Status = "unpause"
 	// Untag ...
 	Untag Status = "untag"
+	// Update ...
+	Update Status = "update"
 )
 
 // EventFilter for filtering events
@@ -154,6 +154,8 @@ func StringToStatus(name string) (Status, error) {
 		return Exited, nil
 	case Export.String():
 		return Export, nil
+	case HealthStatus.String():
+		return HealthStatus, nil
 	case History.String():
 		return History, nil
 	case Import.String():
@@ -186,6 +188,8 @@ func StringToStatus(name string) (Status, error) {
 		return Renumber, nil
 	case Restart.String():
 		return Restart, nil
+	case RestartBackoff.String():
+		return RestartBackoff, nil
 	case Restore.String():
 		return Restore, nil
 	case Save.String():
@@ -204,6 +208,8 @@ func StringToStatus(name string) (Status, error) {
 		return Unpause, nil
 	case Untag.String():
 		return Untag, nil
+	case Update.String():
+		return Update, nil
 	}
 	return "", errors.Errorf("unknown event status %q", name)
 }
@@ -180,6 +180,7 @@ func (c *Container) updateHealthCheckLog(hcl define.HealthCheckLog, inStartPerio
 	if err != nil {
 		return err
 	}
+	oldStatus := healthCheck.Status
 	if hcl.ExitCode == 0 {
 		//	set status to healthy, reset failing state to 0
 		healthCheck.Status = define.HealthCheckHealthy
@@ -205,7 +206,13 @@ func (c *Container) updateHealthCheckLog(hcl define.HealthCheckLog, inStartPerio
 	if err != nil {
 		return errors.Wrapf(err, "unable to marshall healthchecks for writing")
 	}
-	return ioutil.WriteFile(c.healthCheckLogPath(), newResults, 0700)
+	if err := ioutil.WriteFile(c.healthCheckLogPath(), newResults, 0700); err != nil {
+		return err
+	}
+	if healthCheck.Status != oldStatus {
+		c.newContainerHealthStatusEvent(healthCheck.Status)
+	}
+	return nil
 }
 
 // HealthCheckLogPath returns the path for where the health check log is
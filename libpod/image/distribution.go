@@ -0,0 +1,254 @@
+package image
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"io/ioutil"
+	"sort"
+
+	"github.com/containers/image/v5/docker/reference"
+	"github.com/containers/image/v5/manifest"
+	"github.com/containers/podman/v2/libpod/define"
+	"github.com/containers/storage"
+	"github.com/containers/storage/pkg/archive"
+	"github.com/opencontainers/go-digest"
+	imgspecv1 "github.com/opencontainers/image-spec/specs-go/v1"
+	"github.com/pkg/errors"
+)
+
+// DistributionImage returns the local image which is served to registry
+// clients asking for reference (a tag or a manifest digest) in the given
+// repository.  The repository is either fully qualified, as requested by
+// clients using a mirror location with a namespace such as
+// "mirror.example.com/quay.io", or the path of the image within its registry
+// (e.g. "libpod/alpine").  Fully-qualified matches take precedence, followed
+// by the most recently created image whose path matches.
+func (ir *Runtime) DistributionImage(ctx context.Context, repository, ref string) (*Image, error) {
+	images, err := ir.GetImages()
+	if err != nil {
+		return nil, err
+	}
+	manifestDigest, digestErr := digest.Parse(ref)
+	byDigest := digestErr == nil
+
+	var exact, byPath []*Image
+	for _, img := range images {
+		var fullMatch, pathMatch bool
+		for _, name := range img.Names() {
+			named, err := reference.ParseNormalizedNamed(name)
+			if err != nil {
+				continue
+			}
+			if !byDigest {
+				tagged, ok := named.(reference.NamedTagged)
+				if !ok || tagged.Tag() != ref {
+					continue
+				}
+			}
+			switch repository {
+			case named.Name():
+				fullMatch = true
+			case reference.Path(named):
+				pathMatch = true
+			}
+		}
+		switch {
+		case fullMatch:
+			exact = append(exact, img)
+		case pathMatch:
+			byPath = append(byPath, img)
+		}
+	}
+	sort.SliceStable(byPath, func(i, j int) bool {
+		return byPath[i].Created().After(byPath[j].Created())
+	})
+
+	for _, img := range append(exact, byPath...) {
+		if !byDigest {
+			return img, nil
+		}
+		// Only the manifests generated from local storage can be
+		// served, so the digest must be the one of such a manifest.
+		manifestBytes, _, err := img.DistributionManifest(ctx)
+		if err != nil {
+			continue
+		}
+		if digest.FromBytes(manifestBytes) == manifestDigest {
+			return img, nil
+		}
+	}
+	return nil, errors.Wrapf(define.ErrNoSuchImage, "%s:%s", repository, ref)
+}
+
+// DistributionTags returns the tags of the local images in the given
+// repository, which is matched as described for DistributionImage.
+func (ir *Runtime) DistributionTags(repository string) ([]string, error) {
+	images, err := ir.GetImages()
+	if err != nil {
+		return nil, err
+	}
+	seen := make(map[string]bool)
+	tags := []string{}
+	for _, img := range images {
+		for _, name := range img.Names() {
+			named, err := reference.ParseNormalizedNamed(name)
+			if err != nil {
+				continue
+			}
+			tagged, ok := named.(reference.NamedTagged)
+			if !ok || (repository != named.Name() && repository != reference.Path(named)) {
+				continue
+			}
+			if !seen[tagged.Tag()] {
+				seen[tagged.Tag()] = true
+				tags = append(tags, tagged.Tag())
+			}
+		}
+	}
+	if len(tags) == 0 {
+		return nil, errors.Wrapf(define.ErrNoSuchImage, "%s", repository)
+	}
+	sort.Strings(tags)
+	return tags, nil
+}
+
+// DistributionManifest returns a manifest describing the image as it is
+// kept in local storage, along with its MIME type.  As local storage does not
+// keep the compressed layers an image was pulled with, the manifest
+// references the uncompressed layers instead, so that all of its blobs can be
+// served by DistributionBlob.  The original manifest can hence not be served
+// and the digest of the returned one differs from the digest of the image on
+// its registry, which is why images can only be served by tag or by the
+// digest of the returned manifest.  Docker images are described by a Docker
+// schema 2 manifest, all others by an OCI manifest.
+func (i *Image) DistributionManifest(ctx context.Context) ([]byte, string, error) {
+	isList, err := i.IsManifestList()
+	if err != nil {
+		return nil, "", err
+	}
+	if isList {
+		return nil, "", errors.Errorf("image %s is a manifest list and cannot be served", i.ID())
+	}
+	img, err := i.toImageRef(ctx)
+	if err != nil {
+		return nil, "", err
+	}
+	configInfo := img.ConfigInfo()
+	configBlob, err := i.imageruntime.store.ImageBigData(i.ID(), configInfo.Digest.String())
+	if err != nil {
+		return nil, "", errors.Wrapf(err, "config of image %s is not available in local storage", i.ID())
+	}
+	ociConfig, err := img.OCIConfig(ctx)
+	if err != nil {
+		return nil, "", err
+	}
+
+	var layers []*storage.Layer
+	for layerID := i.TopLayer(); layerID != ""; {
+		layer, err := i.imageruntime.store.Layer(layerID)
+		if err != nil {
+			return nil, "", err
+		}
+		layers = append([]*storage.Layer{layer}, layers...)
+		layerID = layer.Parent
+	}
+	if len(layers) != len(ociConfig.RootFS.DiffIDs) {
+		return nil, "", errors.Errorf("image %s has %d layers but its config lists %d", i.ID(), len(layers), len(ociConfig.RootFS.DiffIDs))
+	}
+	for n, layer := range layers {
+		if layer.UncompressedDigest != ociConfig.RootFS.DiffIDs[n] || layer.UncompressedSize < 0 {
+			return nil, "", errors.Errorf("layer %s of image %s does not match its config", layer.ID, i.ID())
+		}
+	}
+
+	_, originalType, err := i.Manifest(ctx)
+	if err != nil {
+		return nil, "", err
+	}
+	if originalType == manifest.DockerV2Schema2MediaType {
+		config := manifest.Schema2Descriptor{
+			MediaType: manifest.DockerV2Schema2ConfigMediaType,
+			Size:      int64(len(configBlob)),
+			Digest:    configInfo.Digest,
+		}
+		descriptors := make([]manifest.Schema2Descriptor, 0, len(layers))
+		for _, layer := range layers {
+			descriptors = append(descriptors, manifest.Schema2Descriptor{
+				MediaType: manifest.DockerV2SchemaLayerMediaTypeUncompressed,
+				Size:      layer.UncompressedSize,
+				Digest:    layer.UncompressedDigest,
+			})
+		}
+		b, err := manifest.Schema2FromComponents(config, descriptors).Serialize()
+		return b, manifest.DockerV2Schema2MediaType, err
+	}
+
+	config := imgspecv1.Descriptor{
+		MediaType: imgspecv1.MediaTypeImageConfig,
+		Size:      int64(len(configBlob)),
+		Digest:    configInfo.Digest,
+	}
+	descriptors := make([]imgspecv1.Descriptor, 0, len(layers))
+	for _, layer := range layers {
+		descriptors = append(descriptors, imgspecv1.Descriptor{
+			MediaType: imgspecv1.MediaTypeImageLayer,
+			Size:      layer.UncompressedSize,
+			Digest:    layer.UncompressedDigest,
+		})
+	}
+	b, err := manifest.OCI1FromComponents(config, descriptors).Serialize()
+	return b, imgspecv1.MediaTypeImageManifest, err
+}
+
+// DistributionBlob returns the content and size of the blob with the given
+// digest, which is either the config or an uncompressed layer of a local image
+// in the given repository.  The repository is matched as described for
+// DistributionTags, regardless of the tags of the images.  Blobs of images in
+// other repositories are not served.
+func (ir *Runtime) DistributionBlob(repository string, d digest.Digest) (io.ReadCloser, int64, error) {
+	images, err := ir.GetImages()
+	if err != nil {
+		return nil, 0, err
+	}
+	for _, img := range images {
+		if !img.inDistributionRepository(repository) {
+			continue
+		}
+		if data, err := ir.store.ImageBigData(img.ID(), d.String()); err == nil {
+			return ioutil.NopCloser(bytes.NewReader(data)), int64(len(data)), nil
+		}
+		for layerID := img.TopLayer(); layerID != ""; {
+			layer, err := ir.store.Layer(layerID)
+			if err != nil {
+				return nil, 0, err
+			}
+			if layer.UncompressedDigest == d {
+				uncompressed := archive.Uncompressed
+				rc, err := ir.store.Diff("", layer.ID, &storage.DiffOptions{Compression: &uncompressed})
+				if err != nil {
+					return nil, 0, err
+				}
+				return rc, layer.UncompressedSize, nil
+			}
+			layerID = layer.Parent
+		}
+	}
+	return nil, 0, errors.Wrapf(define.ErrNoSuchBlob, "%s@%s", repository, d)
+}
+
+// inDistributionRepository returns whether one of the names of the image is
+// in the given repository, either by its fully-qualified name or by its path
+// within its registry.
+func (i *Image) inDistributionRepository(repository string) bool {
+	for _, name := range i.Names() {
+		named, err := reference.ParseNormalizedNamed(name)
+		if err != nil {
+			continue
+		}
+		if repository == named.Name() || repository == reference.Path(named) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,256 @@
+package image
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/containers/podman/v2/libpod/events"
+	"github.com/containers/podman/v2/pkg/domain/entities/reports"
+	"github.com/containers/storage"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+// lastUsedKey is the name of the big-data item in which the time an image
+// was last used to create a container is recorded.
+const lastUsedKey = "podman-last-used"
+
+// GCPolicy describes when and how unused images are evicted from local
+// storage to relieve disk pressure on the graph root.
+type GCPolicy struct {
+	// HighThreshold is the percentage of the graph root's file system
+	// usage which, when exceeded, triggers garbage collection.  A value of
+	// 0 disables garbage collection.
+	HighThreshold uint
+	// LowThreshold is the percentage of the graph root's file system
+	// usage that garbage collection attempts to free space down to.
+	LowThreshold uint
+	// MinAge is the minimum time since an image was last used before it
+	// may be evicted.
+	MinAge time.Duration
+}
+
+// DefaultGCPolicy is the garbage collection policy of an image runtime unless
+// configured otherwise.
+var DefaultGCPolicy = GCPolicy{
+	HighThreshold: 85,
+	LowThreshold:  80,
+	MinAge:        2 * time.Minute,
+}
+
+// Validate checks that the thresholds of the policy are percentages and that
+// the low threshold is below the high threshold.
+func (p GCPolicy) Validate() error {
+	if p.HighThreshold == 0 {
+		return nil
+	}
+	if p.HighThreshold > 100 {
+		return errors.Errorf("image garbage collection high threshold must be a percentage between 0 and 100, got %d", p.HighThreshold)
+	}
+	if p.LowThreshold >= p.HighThreshold {
+		return errors.Errorf("image garbage collection low threshold (%d) must be lower than the high threshold (%d)", p.LowThreshold, p.HighThreshold)
+	}
+	return nil
+}
+
+// gcCandidate is an image that may be evicted along with the data required
+// to order and account for its eviction.
+type gcCandidate struct {
+	image    *Image
+	lastUsed time.Time
+	size     uint64
+}
+
+// SetLastUsed records t as the time the image was last used to create a
+// container.
+func (i *Image) SetLastUsed(t time.Time) error {
+	data, err := t.UTC().MarshalText()
+	if err != nil {
+		return err
+	}
+	return i.imageruntime.store.SetImageBigData(i.ID(), lastUsedKey, data, nil)
+}
+
+// LastUsed returns the time the image was last used to create a container.
+// Images that have never been used report the time they were written to
+// local storage.
+func (i *Image) LastUsed() time.Time {
+	data, err := i.imageruntime.store.ImageBigData(i.ID(), lastUsedKey)
+	if err == nil {
+		var t time.Time
+		if err := t.UnmarshalText(data); err == nil {
+			return t
+		}
+		logrus.Debugf("Ignoring invalid last-used time of image %s: %q", i.ID(), data)
+	}
+	return i.Created()
+}
+
+// GarbageCollect evicts unused images in least-recently-used order until the
+// usage of the graph root's file system drops below the policy's low
+// threshold.  Nothing is evicted unless the usage exceeds the high threshold.
+// If dryRun is set, the images that would be evicted are reported but left
+// in place.
+func (ir *Runtime) GarbageCollect(ctx context.Context, policy GCPolicy, dryRun bool) ([]*reports.PruneReport, error) {
+	return ir.garbageCollect(ctx, policy, dryRun, nil)
+}
+
+// AutoGarbageCollect runs garbage collection with the runtime's policy after
+// images were written to local storage, e.g., by a pull, commit or build.
+// Nothing is done unless the policy enables garbage collection.  The written
+// images, given by name or ID, are marked as used and are not evicted.
+// Failures are logged but not returned as they must not fail the operation
+// that wrote the images.
+func (ir *Runtime) AutoGarbageCollect(ctx context.Context, written ...string) {
+	if ir.GCPolicy.HighThreshold == 0 {
+		return
+	}
+
+	keep := make(map[string]bool, len(written))
+	now := time.Now()
+	for _, nameOrID := range written {
+		img, err := ir.NewFromLocal(nameOrID)
+		if err != nil {
+			logrus.Debugf("Unable to look up image %s: %v", nameOrID, err)
+			continue
+		}
+		keep[img.ID()] = true
+		if img.IsReadOnly() {
+			continue
+		}
+		if err := img.SetLastUsed(now); err != nil {
+			logrus.Warnf("Unable to record last-used time of image %s: %v", img.ID(), err)
+		}
+	}
+
+	preports, err := ir.garbageCollect(ctx, ir.GCPolicy, false, keep)
+	if err != nil {
+		logrus.Warnf("Image garbage collection failed: %v", err)
+		return
+	}
+	for _, r := range preports {
+		logrus.Infof("Image garbage collection evicted image %s", r.Id)
+	}
+}
+
+// garbageCollect implements GarbageCollect.  The images with the IDs in keep
+// are never evicted.
+func (ir *Runtime) garbageCollect(ctx context.Context, policy GCPolicy, dryRun bool, keep map[string]bool) ([]*reports.PruneReport, error) {
+	preports := make([]*reports.PruneReport, 0)
+	if err := policy.Validate(); err != nil {
+		return nil, err
+	}
+	if policy.HighThreshold == 0 {
+		return preports, nil
+	}
+
+	total, used, err := fsUsage(ir.store.GraphRoot())
+	if err != nil {
+		return nil, errors.Wrapf(err, "error getting file system usage of %s", ir.store.GraphRoot())
+	}
+	if total == 0 || used*100 <= total*uint64(policy.HighThreshold) {
+		return preports, nil
+	}
+	toFree := used - total*uint64(policy.LowThreshold)/100
+	logrus.Debugf("Image garbage collection: %d of %d bytes used, trying to free %d bytes", used, total, toFree)
+
+	// Evicting an image may make its parent eligible for eviction, so
+	// candidates are looked up again until enough space is freed or no
+	// more images can be evicted.  removed records the images evicted so
+	// far, which allows a dry run to simulate all rounds.
+	removed := make(map[string]bool)
+	var freed uint64
+	for freed < toFree {
+		candidates, err := ir.gcCandidates(ctx, policy.MinAge, removed, keep)
+		if err != nil {
+			return nil, err
+		}
+		evicted := 0
+		for _, c := range candidates {
+			if freed >= toFree {
+				break
+			}
+			nameOrID := c.image.ID()
+			if repotags, err := c.image.RepoTags(); err == nil && len(repotags) > 0 {
+				nameOrID = repotags[0]
+			}
+			if !dryRun {
+				if err := c.image.Remove(ctx, false); err != nil {
+					if errors.Cause(err) == storage.ErrImageUsedByContainer {
+						logrus.Warnf("Failed to evict image %s as it is in use: %v", c.image.ID(), err)
+						continue
+					}
+					return preports, errors.Wrapf(err, "failed to evict image %s", c.image.ID())
+				}
+				c.image.newImageEvent(events.Prune)
+			}
+			removed[c.image.ID()] = true
+			evicted++
+			freed += c.size
+			preports = append(preports, &reports.PruneReport{
+				Id:   nameOrID,
+				Size: c.size,
+			})
+		}
+		if evicted == 0 {
+			break
+		}
+	}
+	if freed < toFree {
+		logrus.Debugf("Image garbage collection freed %d of %d bytes: no more images are eligible for eviction", freed, toFree)
+	}
+	return preports, nil
+}
+
+// gcCandidates returns all images that are eligible for eviction sorted by
+// the time they were last used, oldest first.  Images in use by containers,
+// images that were used within minAge and images with children are not
+// eligible, and neither are the images with the IDs in keep.  The images with
+// the IDs in removed are treated as if they were already removed from the
+// local image store.
+func (ir *Runtime) gcCandidates(ctx context.Context, minAge time.Duration, removed, keep map[string]bool) ([]gcCandidate, error) {
+	allImages, err := ir.GetRWImages()
+	if err != nil {
+		return nil, err
+	}
+	tree, err := ir.layerTree()
+	if err != nil {
+		return nil, err
+	}
+	tree.removeImages(removed)
+
+	candidates := make([]gcCandidate, 0, len(allImages))
+	for _, img := range allImages {
+		if removed[img.ID()] || keep[img.ID()] {
+			continue
+		}
+		lastUsed := img.LastUsed()
+		if time.Since(lastUsed) < minAge {
+			continue
+		}
+		childIDs, err := tree.children(ctx, img, false)
+		if err != nil {
+			return nil, err
+		}
+		if len(childIDs) > 0 {
+			continue
+		}
+		stat, err := diskUsageForImage(ctx, img, tree)
+		if err != nil {
+			return nil, err
+		}
+		if stat.Containers > 0 {
+			continue
+		}
+		candidates = append(candidates, gcCandidate{
+			image:    img,
+			lastUsed: lastUsed,
+			size:     stat.UniqueSize,
+		})
+	}
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return candidates[i].lastUsed.Before(candidates[j].lastUsed)
+	})
+	return candidates, nil
+}
@@ -0,0 +1,17 @@
+package image
+
+import (
+	"golang.org/x/sys/unix"
+)
+
+// fsUsage returns the total and used bytes of the file system containing
+// path.
+func fsUsage(path string) (uint64, uint64, error) {
+	var st unix.Statfs_t
+	if err := unix.Statfs(path, &st); err != nil {
+		return 0, 0, err
+	}
+	total := st.Blocks * uint64(st.Bsize)
+	used := total - st.Bfree*uint64(st.Bsize)
+	return total, used, nil
+}
@@ -0,0 +1,13 @@
+// +build !linux
+
+package image
+
+import (
+	"github.com/containers/podman/v2/libpod/define"
+)
+
+// fsUsage returns the total and used bytes of the file system containing
+// path.
+func fsUsage(path string) (uint64, uint64, error) {
+	return 0, 0, define.ErrOSNotSupported
+}
@@ -65,6 +65,7 @@ type Runtime struct {
 	EventsLogFilePath   string
 	EventsLogger        string
 	Eventer             events.Eventer
+	GCPolicy            GCPolicy
 }
 
 // InfoImage keep information of Image along with all associated layers
@@ -93,7 +94,8 @@ var ErrImageIsBareList = stderrors.New("image contains a manifest list or image
 // NewImageRuntimeFromStore creates an ImageRuntime based on a provided store
 func NewImageRuntimeFromStore(store storage.Store) *Runtime {
 	return &Runtime{
-		store: store,
+		store:    store,
+		GCPolicy: DefaultGCPolicy,
 	}
 }
 
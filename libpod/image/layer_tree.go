@@ -92,6 +92,24 @@ func (ir *Runtime) layerTree() (*layerTree, error) {
 	return &tree, nil
 }
 
+// removeImages removes the images with the specified IDs from the tree, so
+// that relations are computed as if the images were not in the local
+// storage.
+func (t *layerTree) removeImages(ids map[string]bool) {
+	if len(ids) == 0 {
+		return
+	}
+	for _, node := range t.nodes {
+		images := node.images[:0]
+		for _, img := range node.images {
+			if !ids[img.ID()] {
+				images = append(images, img)
+			}
+		}
+		node.images = images
+	}
+}
+
 // children returns the image IDs of children . Child images are images
 // with either the same top layer as parent or parent being the true parent
 // layer.  Furthermore, the history of the parent and child images must match
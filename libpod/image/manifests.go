@@ -3,13 +3,18 @@ package image
 import (
 	"context"
 	"fmt"
+	"os"
 
 	"github.com/containers/buildah/manifests"
 	"github.com/containers/image/v5/docker"
 	"github.com/containers/image/v5/manifest"
 	"github.com/containers/image/v5/transports/alltransports"
 	"github.com/containers/image/v5/types"
+	"github.com/containers/podman/v2/libpod/define"
+	"github.com/containers/podman/v2/libpod/events"
+	"github.com/containers/storage"
 	"github.com/opencontainers/go-digest"
+	"github.com/pkg/errors"
 )
 
 // Options for adding a manifest
@@ -58,6 +63,35 @@ func (i *Image) RemoveManifest(d digest.Digest) (string, error) {
 	return list.SaveToImage(i.imageruntime.store, i.ID(), nil, "")
 }
 
+// IsManifestList returns whether the image is a manifest list or image index
+func (i *Image) IsManifestList() (bool, error) {
+	manifestBytes, err := i.imageruntime.store.ImageBigData(i.ID(), storage.ImageDigestManifestBigDataNamePrefix)
+	if err != nil {
+		if os.IsNotExist(errors.Cause(err)) {
+			return false, nil
+		}
+		return false, err
+	}
+	return manifest.MIMETypeIsMultiImage(manifest.GuessMIMEType(manifestBytes)), nil
+}
+
+// RemoveManifestList removes the manifest list from local storage.  The
+// images referenced by the list are not removed.
+func (i *Image) RemoveManifestList() error {
+	isList, err := i.IsManifestList()
+	if err != nil {
+		return err
+	}
+	if !isList {
+		return errors.Wrapf(define.ErrNoSuchManifestList, "image %s is not a manifest list", i.ID())
+	}
+	if _, err := i.imageruntime.store.DeleteImage(i.ID(), true); err != nil {
+		return err
+	}
+	i.newImageEvent(events.Remove)
+	return nil
+}
+
 // getManifestList is a helper to obtain a manifest list
 func (i *Image) getManifestList() (manifests.List, error) {
 	_, list, err := manifests.LoadFromImage(i.imageruntime.store, i.ID())
@@ -59,6 +59,13 @@ func generatePruneFilterFuncs(filter, filterValue string) (ImageFilter, error) {
 
 // GetPruneImages returns a slice of images that have no names/unused
 func (ir *Runtime) GetPruneImages(ctx context.Context, all bool, filterFuncs []ImageFilter) ([]*Image, error) {
+	return ir.getPruneImages(ctx, all, filterFuncs, nil)
+}
+
+// getPruneImages returns a slice of images that have no names/unused as if
+// the images with the IDs in removed were already removed from the local
+// image store.
+func (ir *Runtime) getPruneImages(ctx context.Context, all bool, filterFuncs []ImageFilter, removed map[string]bool) ([]*Image, error) {
 	var (
 		pruneImages []*Image
 	)
@@ -72,8 +79,12 @@ func (ir *Runtime) GetPruneImages(ctx context.Context, all bool, filterFuncs []I
 	if err != nil {
 		return nil, err
 	}
+	tree.removeImages(removed)
 
 	for _, i := range allImages {
+		if removed[i.ID()] {
+			continue
+		}
 		// filter the images based on this.
 		for _, filterFunc := range filterFuncs {
 			if !filterFunc(i) {
@@ -110,8 +121,11 @@ func (ir *Runtime) GetPruneImages(ctx context.Context, all bool, filterFuncs []I
 }
 
 // PruneImages prunes dangling and optionally all unused images from the local
-// image store
-func (ir *Runtime) PruneImages(ctx context.Context, all bool, filter []string) ([]*reports.PruneReport, error) {
+// image store.  If dryRun is set, the images that would be pruned are
+// reported but left in place.  A dry run reports the images of all rounds of
+// pruning, including those that only become prunable once their children
+// are removed.
+func (ir *Runtime) PruneImages(ctx context.Context, all, dryRun bool, filter []string) ([]*reports.PruneReport, error) {
 	preports := make([]*reports.PruneReport, 0)
 	filterFuncs := make([]ImageFilter, 0, len(filter))
 	for _, f := range filter {
@@ -127,9 +141,11 @@ func (ir *Runtime) PruneImages(ctx context.Context, all bool, filter []string) (
 		filterFuncs = append(filterFuncs, generatedFunc)
 	}
 
+	// removed records the images a dry run would have removed so far.
+	removed := make(map[string]bool)
 	prev := 0
 	for {
-		toPrune, err := ir.GetPruneImages(ctx, all, filterFuncs)
+		toPrune, err := ir.getPruneImages(ctx, all, filterFuncs, removed)
 		if err != nil {
 			return nil, errors.Wrap(err, "unable to get images to prune")
 		}
@@ -145,20 +161,33 @@ func (ir *Runtime) PruneImages(ctx context.Context, all bool, filter []string) (
 				return nil, err
 			}
 			nameOrID := img.ID()
+			var imgSize uint64
 			s, err := img.Size(ctx)
-			imgSize := *s
 			if err != nil {
 				logrus.Warnf("Failed to collect image size for: %s, %s", nameOrID, err)
-				imgSize = 0
+			} else {
+				imgSize = *s
 			}
-			if err := img.Remove(ctx, false); err != nil {
-				if errors.Cause(err) == storage.ErrImageUsedByContainer {
-					logrus.Warnf("Failed to prune image %s as it is in use: %v.\nA container associated with containers/storage (e.g., Buildah, CRI-O, etc.) maybe associated with this image.\nUsing the rmi command with the --force option will remove the container and image, but may cause failures for other dependent systems.", img.ID(), err)
+			if dryRun {
+				containers, err := img.Containers()
+				if err != nil {
+					return nil, err
+				}
+				if len(containers) > 0 {
+					logrus.Debugf("Image %s would not be pruned as it is in use by %d container(s)", img.ID(), len(containers))
 					continue
 				}
-				return nil, errors.Wrap(err, "failed to prune image")
+				removed[img.ID()] = true
+			} else {
+				if err := img.Remove(ctx, false); err != nil {
+					if errors.Cause(err) == storage.ErrImageUsedByContainer {
+						logrus.Warnf("Failed to prune image %s as it is in use: %v.\nA container associated with containers/storage (e.g., Buildah, CRI-O, etc.) maybe associated with this image.\nUsing the rmi command with the --force option will remove the container and image, but may cause failures for other dependent systems.", img.ID(), err)
+						continue
+					}
+					return nil, errors.Wrap(err, "failed to prune image")
+				}
+				defer img.newImageEvent(events.Prune)
 			}
-			defer img.newImageEvent(events.Prune)
 
 			if len(repotags) > 0 {
 				nameOrID = repotags[0]
@@ -167,10 +196,9 @@ func (ir *Runtime) PruneImages(ctx context.Context, all bool, filter []string) (
 			preports = append(preports, &reports.PruneReport{
 				Id:   nameOrID,
 				Err:  nil,
-				Size: uint64(imgSize),
+				Size: imgSize,
 			})
 		}
-
 	}
 	return preports, nil
 }
@@ -358,6 +358,7 @@ func (ir *Runtime) doPullImage(ctx context.Context, sc *types.SystemContext, goa
 			}
 			if !goal.pullAllPairs {
 				ir.newImageEvent(events.Pull, "")
+				ir.AutoGarbageCollect(ctx, imageInfo.image)
 				return []string{imageInfo.image}, nil
 			}
 			images = append(images, imageInfo.image)
@@ -373,6 +374,7 @@ func (ir *Runtime) doPullImage(ctx context.Context, sc *types.SystemContext, goa
 	}
 
 	ir.newImageEvent(events.Pull, images[0])
+	ir.AutoGarbageCollect(ctx, images...)
 	return images, nil
 }
 
@@ -0,0 +1,145 @@
+package image
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/containers/image/v5/docker"
+	"github.com/containers/image/v5/image"
+	"github.com/containers/image/v5/manifest"
+	"github.com/containers/image/v5/transports"
+	"github.com/containers/image/v5/transports/alltransports"
+	"github.com/containers/image/v5/types"
+	"github.com/containers/podman/v2/pkg/inspect"
+	"github.com/opencontainers/go-digest"
+	imgspecv1 "github.com/opencontainers/image-spec/specs-go/v1"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+// InspectRemote fetches the manifest, or the manifest list and the manifests
+// of all its instances, and the configurations of an image from a registry.
+// The layers of the image are not downloaded.  Names without a transport
+// refer to images on a registry.
+func InspectRemote(ctx context.Context, name, authfile string, dockeroptions *DockerRegistryOptions) (*inspect.RemoteImageData, error) {
+	return inspectRemote(ctx, name, authfile, dockeroptions, true)
+}
+
+// InspectRemotePlatforms fetches the manifest or manifest list of an image
+// from a registry and returns the descriptors of its instances.  Unlike
+// InspectRemote, the platforms of the instances of a manifest list are taken
+// from the list and their configurations are not fetched, so the returned
+// manifests carry no configuration and layers.
+func InspectRemotePlatforms(ctx context.Context, name, authfile string, dockeroptions *DockerRegistryOptions) (*inspect.RemoteImageData, error) {
+	return inspectRemote(ctx, name, authfile, dockeroptions, false)
+}
+
+func inspectRemote(ctx context.Context, name, authfile string, dockeroptions *DockerRegistryOptions, withConfigs bool) (*inspect.RemoteImageData, error) {
+	ref, err := alltransports.ParseImageName(name)
+	if err != nil {
+		ref, err = alltransports.ParseImageName(fmt.Sprintf("%s://%s", docker.Transport.Name(), name))
+		if err != nil {
+			return nil, errors.Wrapf(err, "error parsing image name %q", name)
+		}
+	}
+	sc := GetSystemContext("", authfile, false)
+	if dockeroptions != nil {
+		sc = dockeroptions.GetSystemContext(sc, nil)
+	}
+
+	src, err := ref.NewImageSource(ctx, sc)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error getting image source for %s", transports.ImageName(ref))
+	}
+	defer func() {
+		if err := src.Close(); err != nil {
+			logrus.Errorf("unable to close %s image source: %v", transports.ImageName(ref), err)
+		}
+	}()
+
+	rawManifest, manifestType, err := src.GetManifest(ctx, nil)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error getting manifest of %s", transports.ImageName(ref))
+	}
+	manifestDigest, err := manifest.Digest(rawManifest)
+	if err != nil {
+		return nil, err
+	}
+	data := inspect.RemoteImageData{
+		Name:      transports.ImageName(ref),
+		Digest:    manifestDigest,
+		MediaType: manifestType,
+		Size:      int64(len(rawManifest)),
+	}
+
+	if !manifest.MIMETypeIsMultiImage(manifestType) {
+		manifestData, err := inspectRemoteManifest(ctx, sc, src, nil)
+		if err != nil {
+			return nil, err
+		}
+		manifestData.Digest = manifestDigest
+		manifestData.MediaType = manifestType
+		manifestData.Size = data.Size
+		data.Manifests = append(data.Manifests, *manifestData)
+		return &data, nil
+	}
+
+	// Convert the list to an OCI index which carries the platforms of
+	// all kinds of lists.
+	list, err := manifest.ListFromBlob(rawManifest, manifestType)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error parsing manifest list of %s", transports.ImageName(ref))
+	}
+	list, err = list.ConvertToMIMEType(imgspecv1.MediaTypeImageIndex)
+	if err != nil {
+		return nil, err
+	}
+	index, ok := list.(*manifest.OCI1Index)
+	if !ok {
+		return nil, errors.Errorf("unexpected manifest list type %T", list)
+	}
+	for _, descriptor := range index.Manifests {
+		manifestData := &inspect.RemoteManifestData{}
+		if withConfigs {
+			instanceDigest := descriptor.Digest
+			manifestData, err = inspectRemoteManifest(ctx, sc, src, &instanceDigest)
+			if err != nil {
+				return nil, err
+			}
+		}
+		manifestData.Digest = descriptor.Digest
+		manifestData.MediaType = descriptor.MediaType
+		manifestData.Size = descriptor.Size
+		if descriptor.Platform != nil {
+			manifestData.Platform = *descriptor.Platform
+		}
+		data.Manifests = append(data.Manifests, *manifestData)
+	}
+	return &data, nil
+}
+
+// inspectRemoteManifest returns the configuration and layers of an image
+// for a single platform
+func inspectRemoteManifest(ctx context.Context, sc *types.SystemContext, src types.ImageSource, instanceDigest *digest.Digest) (*inspect.RemoteManifestData, error) {
+	img, err := image.FromUnparsedImage(ctx, sc, image.UnparsedInstance(src, instanceDigest))
+	if err != nil {
+		return nil, errors.Wrapf(err, "error parsing manifest")
+	}
+	config, err := img.OCIConfig(ctx)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error getting image configuration")
+	}
+	data := inspect.RemoteManifestData{
+		Platform: imgspecv1.Platform{
+			Architecture: config.Architecture,
+			OS:           config.OS,
+		},
+		Created: config.Created,
+		Config:  config.Config,
+		Labels:  config.Config.Labels,
+	}
+	for _, layer := range img.LayerInfos() {
+		data.Layers = append(data.Layers, layer.Digest)
+	}
+	return &data, nil
+}
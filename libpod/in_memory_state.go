@@ -34,6 +34,9 @@ type InMemoryState struct {
 	ctrNetworks   map[string][]string
 	// Maps container ID to network name to list of aliases.
 	ctrNetworkAliases map[string]map[string][]string
+	// Maps container ID to network name to the options it was connected
+	// to the network with.
+	ctrNetworkOptions map[string]map[string]ContainerNetworkOptions
 	// Global name registry - ensures name uniqueness and performs lookups.
 	nameIndex *registrar.Registrar
 	// Global ID registry - ensures ID uniqueness and performs lookups.
@@ -70,6 +73,7 @@ func NewInMemoryState() (State, error) {
 
 	state.ctrNetworks = make(map[string][]string)
 	state.ctrNetworkAliases = make(map[string]map[string][]string)
+	state.ctrNetworkOptions = make(map[string]map[string]ContainerNetworkOptions)
 
 	state.nameIndex = registrar.NewRegistrar()
 	state.idIndex = truncindex.NewTruncIndex([]string{})
@@ -438,6 +442,7 @@ func (s *InMemoryState) RemoveContainer(ctr *Container) error {
 
 	// Remove our network aliases
 	delete(s.ctrNetworkAliases, ctr.ID())
+	delete(s.ctrNetworkOptions, ctr.ID())
 	delete(s.ctrNetworks, ctr.ID())
 
 	return nil
@@ -595,8 +600,29 @@ func (s *InMemoryState) GetAllNetworkAliases(ctr *Container) (map[string][]strin
 	return ctrAliases, nil
 }
 
+// GetNetworkOptions gets the options the given container was connected to
+// its networks with.
+func (s *InMemoryState) GetNetworkOptions(ctr *Container) (map[string]ContainerNetworkOptions, error) {
+	if !ctr.valid {
+		return nil, define.ErrCtrRemoved
+	}
+
+	ctr, ok := s.containers[ctr.ID()]
+	if !ok {
+		ctr.valid = false
+		return nil, define.ErrNoSuchCtr
+	}
+
+	ctrOptions, ok := s.ctrNetworkOptions[ctr.ID()]
+	if !ok {
+		return map[string]ContainerNetworkOptions{}, nil
+	}
+
+	return ctrOptions, nil
+}
+
 // NetworkConnect connects to the given network
-func (s *InMemoryState) NetworkConnect(ctr *Container, network string, aliases []string) error {
+func (s *InMemoryState) NetworkConnect(ctr *Container, network string, aliases []string, options ContainerNetworkOptions) error {
 	if !ctr.valid {
 		return define.ErrCtrRemoved
 	}
@@ -633,6 +659,15 @@ func (s *InMemoryState) NetworkConnect(ctr *Container, network string, aliases [
 	}
 	ctrAliases[network] = aliases
 
+	if options.StaticIP != nil || options.StaticMAC != nil {
+		ctrOptions, ok := s.ctrNetworkOptions[ctr.ID()]
+		if !ok {
+			ctrOptions = make(map[string]ContainerNetworkOptions)
+			s.ctrNetworkOptions[ctr.ID()] = ctrOptions
+		}
+		ctrOptions[network] = options
+	}
+
 	return nil
 }
 
@@ -678,6 +713,10 @@ func (s *InMemoryState) NetworkDisconnect(ctr *Container, network string) error
 	}
 	delete(ctrAliases, network)
 
+	if ctrOptions, ok := s.ctrNetworkOptions[ctr.ID()]; ok {
+		delete(ctrOptions, network)
+	}
+
 	return nil
 }
 
@@ -1518,6 +1557,7 @@ func (s *InMemoryState) RemoveContainerFromPod(pod *Pod, ctr *Container) error {
 
 	// Remove our network aliases
 	delete(s.ctrNetworkAliases, ctr.ID())
+	delete(s.ctrNetworkOptions, ctr.ID())
 	delete(s.ctrNetworks, ctr.ID())
 
 	return nil
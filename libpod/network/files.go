@@ -4,13 +4,16 @@ import (
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
+	"net"
 	"sort"
+	"strconv"
 	"strings"
 
 	"github.com/containernetworking/cni/libcni"
 	"github.com/containernetworking/plugins/plugins/ipam/host-local/backend/allocator"
 	"github.com/containers/common/pkg/config"
 	"github.com/containers/podman/v2/libpod/define"
+	"github.com/containers/podman/v2/pkg/domain/entities"
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
 )
@@ -131,6 +134,112 @@ func GetNetworkLabels(list *libcni.NetworkConfigList) NcLabels {
 	return nil
 }
 
+// GetNetworkCreateOptions returns the options to create a network with the
+// settings of the specified CNI configuration.  Subnets allocated by podman
+// are returned as if they were specified by the user.
+func GetNetworkCreateOptions(list *libcni.NetworkConfigList) (*entities.NetworkCreateOptions, error) {
+	options := entities.NetworkCreateOptions{
+		Labels:     GetNetworkLabels(list),
+		DisableDNS: true,
+	}
+	for _, plugin := range list.Plugins {
+		switch plugin.Network.Type {
+		case "bridge":
+			var bridge HostLocalBridge
+			if err := json.Unmarshal(plugin.Bytes, &bridge); err != nil {
+				return nil, errors.Wrapf(err, "error parsing bridge configuration of network %s", list.Name)
+			}
+			options.Internal = !bridge.IsGW
+			if bridge.MTU > 0 || bridge.Vlan > 0 {
+				options.Options = make(map[string]string)
+				if bridge.MTU > 0 {
+					options.Options["mtu"] = strconv.Itoa(bridge.MTU)
+				}
+				if bridge.Vlan > 0 {
+					options.Options["vlan"] = strconv.Itoa(bridge.Vlan)
+				}
+			}
+			if err := setIPAMCreateOptions(&options, bridge.IPAM.Ranges); err != nil {
+				return nil, errors.Wrapf(err, "error parsing IPAM configuration of network %s", list.Name)
+			}
+		case "macvlan":
+			var macvlan MacVLANConfig
+			if err := json.Unmarshal(plugin.Bytes, &macvlan); err != nil {
+				return nil, errors.Wrapf(err, "error parsing macvlan configuration of network %s", list.Name)
+			}
+			options.MacVLAN = macvlan.Master
+		case "dnsname":
+			options.DisableDNS = false
+		}
+	}
+	return &options, nil
+}
+
+// setIPAMCreateOptions sets the subnet, gateway and range of the options from
+// the IPAM ranges of a bridge.  Dual-stack networks consist of the IPv6 range
+// specified by the user and an allocated IPv4 range.
+func setIPAMCreateOptions(options *entities.NetworkCreateOptions, ranges [][]IPAMLocalHostRangeConf) error {
+	if len(ranges) == 0 || len(ranges[0]) == 0 {
+		return nil
+	}
+	hostRange := ranges[0][0]
+	_, subnet, err := net.ParseCIDR(hostRange.Subnet)
+	if err != nil {
+		return err
+	}
+	options.Subnet = *subnet
+	options.IPv6 = len(ranges) > 1 && IsIPv6(subnet.IP)
+	if hostRange.Gateway != "" {
+		gateway := net.ParseIP(hostRange.Gateway)
+		if gateway == nil {
+			return errors.Errorf("invalid gateway %q", hostRange.Gateway)
+		}
+		if !gateway.Equal(CalcGatewayIP(subnet)) {
+			if gateway.To4() != nil {
+				gateway = gateway.To4()
+			}
+			options.Gateway = gateway
+		}
+	}
+	if hostRange.RangeStart != "" && hostRange.RangeEnd != "" {
+		ipRange, err := rangeToIPNet(net.ParseIP(hostRange.RangeStart), net.ParseIP(hostRange.RangeEnd))
+		if err != nil {
+			return err
+		}
+		options.Range = *ipRange
+	}
+	return nil
+}
+
+// rangeToIPNet returns the network of the range from first to last as
+// computed by FirstIPInSubnet and LastIPInSubnet.
+func rangeToIPNet(first, last net.IP) (*net.IPNet, error) {
+	if first == nil || last == nil {
+		return nil, errors.Errorf("invalid ip range")
+	}
+	bits := 8 * net.IPv6len
+	if first.To4() != nil {
+		first = first.To4()
+		bits = 8 * net.IPv4len
+	}
+	for ones := bits; ones >= 0; ones-- {
+		mask := net.CIDRMask(ones, bits)
+		ipNet := &net.IPNet{IP: first.Mask(mask), Mask: mask}
+		firstIP, err := FirstIPInSubnet(ipNet)
+		if err != nil {
+			return nil, err
+		}
+		lastIP, err := LastIPInSubnet(ipNet)
+		if err != nil {
+			return nil, err
+		}
+		if firstIP.Equal(first) && lastIP.Equal(last) {
+			return ipNet, nil
+		}
+	}
+	return nil, errors.Errorf("ip range %s-%s is not a network", first, last)
+}
+
 // GetNetworksFromFilesystem gets all the networks from the cni configuration
 // files
 func GetNetworksFromFilesystem(config *config.Config) ([]*allocator.Net, error) {
@@ -0,0 +1,81 @@
+package network
+
+import (
+	"net"
+	"reflect"
+	"testing"
+
+	"github.com/containernetworking/cni/libcni"
+	"github.com/containers/podman/v2/pkg/domain/entities"
+)
+
+func TestGetNetworkCreateOptions(t *testing.T) {
+	tests := []struct {
+		name     string
+		conflist string
+		want     entities.NetworkCreateOptions
+	}{
+		{
+			name: "bridge with allocated subnet",
+			conflist: `{"cniVersion": "0.4.0", "name": "net1", "plugins": [
+				{"type": "bridge", "bridge": "cni-podman1", "isGateway": true, "ipMasq": true,
+				 "ipam": {"type": "host-local", "ranges": [[{"subnet": "10.89.0.0/24", "gateway": "10.89.0.1"}]]}},
+				{"type": "portmap"}, {"type": "dnsname", "domainName": "dns.podman"}]}`,
+			want: entities.NetworkCreateOptions{
+				Subnet: net.IPNet{IP: net.IP{10, 89, 0, 0}, Mask: net.CIDRMask(24, 32)},
+			},
+		},
+		{
+			name: "internal bridge with gateway, range, options and labels",
+			conflist: `{"cniVersion": "0.4.0", "name": "net2", "args": {"podman_labels": {"app": "web"}}, "plugins": [
+				{"type": "bridge", "bridge": "cni-podman2", "isGateway": false, "mtu": 1500, "vlan": 5,
+				 "ipam": {"type": "host-local", "ranges": [[{"subnet": "192.168.0.0/24", "rangeStart": "192.168.0.129", "rangeEnd": "192.168.0.255", "gateway": "192.168.0.10"}]]}},
+				{"type": "portmap"}]}`,
+			want: entities.NetworkCreateOptions{
+				DisableDNS: true,
+				Gateway:    net.IP{192, 168, 0, 10},
+				Internal:   true,
+				Labels:     map[string]string{"app": "web"},
+				Options:    map[string]string{"mtu": "1500", "vlan": "5"},
+				Range:      net.IPNet{IP: net.IP{192, 168, 0, 128}, Mask: net.CIDRMask(25, 32)},
+				Subnet:     net.IPNet{IP: net.IP{192, 168, 0, 0}, Mask: net.CIDRMask(24, 32)},
+			},
+		},
+		{
+			name: "dual-stack bridge",
+			conflist: `{"cniVersion": "0.4.0", "name": "net3", "plugins": [
+				{"type": "bridge", "bridge": "cni-podman3", "isGateway": true, "ipMasq": true,
+				 "ipam": {"type": "host-local", "ranges": [[{"subnet": "fd00::/64", "gateway": "fd00::1"}], [{"subnet": "10.89.1.0/24", "gateway": "10.89.1.1"}]]}},
+				{"type": "dnsname", "domainName": "dns.podman"}]}`,
+			want: entities.NetworkCreateOptions{
+				IPv6:   true,
+				Subnet: net.IPNet{IP: net.ParseIP("fd00::"), Mask: net.CIDRMask(64, 128)},
+			},
+		},
+		{
+			name: "macvlan",
+			conflist: `{"cniVersion": "0.4.0", "name": "net4", "plugins": [
+				{"type": "macvlan", "master": "eth0", "ipam": {"type": "dhcp"}}]}`,
+			want: entities.NetworkCreateOptions{
+				DisableDNS: true,
+				MacVLAN:    "eth0",
+			},
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			list, err := libcni.ConfListFromBytes([]byte(tt.conflist))
+			if err != nil {
+				t.Fatalf("invalid test configuration: %v", err)
+			}
+			got, err := GetNetworkCreateOptions(list)
+			if err != nil {
+				t.Fatalf("no error expected: %v", err)
+			}
+			if !reflect.DeepEqual(*got, tt.want) {
+				t.Errorf("GetNetworkCreateOptions() = %+v, want %+v", *got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,197 @@
+package network
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net"
+	"path/filepath"
+
+	"github.com/containers/common/pkg/config"
+	"github.com/containers/podman/v2/pkg/domain/entities"
+	"github.com/containers/storage/pkg/ioutils"
+	"github.com/pkg/errors"
+)
+
+// Update changes the DNS servers and labels of an existing CNI network by
+// rewriting its configuration file.  Containers attached to the network
+// only use the new configuration once their network is set up again.
+func Update(name string, options entities.NetworkUpdateOptions, runtimeConfig *config.Config) error {
+	// Acquire a lock for CNI
+	l, err := acquireCNILock(filepath.Join(runtimeConfig.Engine.TmpDir, LockFileName))
+	if err != nil {
+		return err
+	}
+	defer l.releaseCNILock()
+
+	cniPath, err := GetCNIConfigPathByNameOrID(runtimeConfig, name)
+	if err != nil {
+		return err
+	}
+	b, err := ioutil.ReadFile(cniPath)
+	if err != nil {
+		return err
+	}
+	ncList := NcList{}
+	if err := json.Unmarshal(b, &ncList); err != nil {
+		return errors.Wrapf(err, "error parsing configuration of network %s", name)
+	}
+	if err := updateNcList(ncList, options); err != nil {
+		return errors.Wrapf(err, "error updating network %s", name)
+	}
+	b, err = json.MarshalIndent(ncList, "", "   ")
+	if err != nil {
+		return err
+	}
+	return ioutils.AtomicWriteFile(cniPath, b, 0644)
+}
+
+// updateNcList applies the DNS server and label changes to the raw network
+// configuration list.  Servers and labels are dropped before new ones are
+// added.
+func updateNcList(ncList NcList, options entities.NetworkUpdateOptions) error {
+	plugins, ok := ncList["plugins"].([]interface{})
+	if !ok || len(plugins) == 0 {
+		return errors.New("network configuration has no plugins")
+	}
+
+	if len(options.DNSAdd) > 0 || len(options.DNSDrop) > 0 {
+		// The DNS servers are configured on the plugin creating the
+		// interface, which passes them on in its result.
+		plugin, ok := plugins[0].(map[string]interface{})
+		if !ok {
+			return errors.New("invalid plugin configuration")
+		}
+		nameservers, err := updateNameservers(getNameservers(plugin), options.DNSAdd, options.DNSDrop)
+		if err != nil {
+			return err
+		}
+		dns, _ := plugin["dns"].(map[string]interface{})
+		if dns == nil {
+			dns = make(map[string]interface{})
+		}
+		if len(nameservers) > 0 {
+			dns["nameservers"] = nameservers
+		} else {
+			delete(dns, "nameservers")
+		}
+		if len(dns) > 0 {
+			plugin["dns"] = dns
+		} else {
+			delete(plugin, "dns")
+		}
+	}
+
+	// Regenerate the dnsname configuration so that it matches the one of
+	// newly created networks.
+	for i, p := range plugins {
+		plugin, ok := p.(map[string]interface{})
+		if !ok || plugin["type"] != "dnsname" {
+			continue
+		}
+		domainName, _ := plugin["domainName"].(string)
+		if domainName == "" {
+			domainName = DefaultPodmanDomainName
+		}
+		plugins[i] = NewDNSNamePlugin(domainName)
+	}
+
+	if len(options.LabelAdd) > 0 || len(options.LabelRm) > 0 {
+		labels := getNcListLabels(ncList)
+		for _, key := range options.LabelRm {
+			if _, ok := labels[key]; !ok {
+				return errors.Errorf("network has no label %q", key)
+			}
+			delete(labels, key)
+		}
+		for key, value := range options.LabelAdd {
+			labels[key] = value
+		}
+		args, _ := ncList["args"].(map[string]interface{})
+		if args == nil {
+			args = make(map[string]interface{})
+		}
+		if len(labels) > 0 {
+			args[PodmanLabelKey] = labels
+		} else {
+			delete(args, PodmanLabelKey)
+		}
+		if len(args) > 0 {
+			ncList["args"] = args
+		} else {
+			delete(ncList, "args")
+		}
+	}
+	return nil
+}
+
+// getNameservers returns the DNS servers configured on a plugin
+func getNameservers(plugin map[string]interface{}) []string {
+	var nameservers []string
+	dns, ok := plugin["dns"].(map[string]interface{})
+	if !ok {
+		return nameservers
+	}
+	servers, ok := dns["nameservers"].([]interface{})
+	if !ok {
+		return nameservers
+	}
+	for _, s := range servers {
+		if server, ok := s.(string); ok {
+			nameservers = append(nameservers, server)
+		}
+	}
+	return nameservers
+}
+
+// updateNameservers drops and adds DNS servers to the given list
+func updateNameservers(nameservers, add, drop []string) ([]string, error) {
+	for _, server := range drop {
+		found := false
+		for i, s := range nameservers {
+			if s == server {
+				nameservers = append(nameservers[:i], nameservers[i+1:]...)
+				found = true
+				break
+			}
+		}
+		if !found {
+			return nil, errors.Errorf("network has no DNS server %s", server)
+		}
+	}
+	for _, server := range add {
+		if net.ParseIP(server) == nil {
+			return nil, errors.Errorf("%s is not a valid IP address", server)
+		}
+		exists := false
+		for _, s := range nameservers {
+			if s == server {
+				exists = true
+				break
+			}
+		}
+		if !exists {
+			nameservers = append(nameservers, server)
+		}
+	}
+	return nameservers, nil
+}
+
+// getNcListLabels returns the podman labels of a raw network configuration
+// list
+func getNcListLabels(ncList NcList) NcLabels {
+	labels := make(NcLabels)
+	args, ok := ncList["args"].(map[string]interface{})
+	if !ok {
+		return labels
+	}
+	rawLabels, ok := args[PodmanLabelKey].(map[string]interface{})
+	if !ok {
+		return labels
+	}
+	for k, v := range rawLabels {
+		if value, ok := v.(string); ok {
+			labels[k] = value
+		}
+	}
+	return labels
+}
@@ -0,0 +1,132 @@
+package network
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/containers/podman/v2/pkg/domain/entities"
+)
+
+const updateTestConfList = `{
+   "cniVersion": "0.4.0",
+   "name": "podman1",
+   "args": {
+      "podman_labels": {
+         "env": "dev",
+         "team": "web"
+      }
+   },
+   "plugins": [
+      {
+         "type": "bridge",
+         "bridge": "cni-podman1",
+         "dns": {
+            "nameservers": ["10.89.0.254"]
+         }
+      },
+      {
+         "type": "dnsname",
+         "domainName": "example.com",
+         "capabilities": {}
+      }
+   ]
+}`
+
+func Test_updateNcList(t *testing.T) {
+	tests := []struct {
+		name            string
+		options         entities.NetworkUpdateOptions
+		wantNameservers []string
+		wantLabels      NcLabels
+		wantErr         bool
+	}{
+		{
+			name:            "add dns server",
+			options:         entities.NetworkUpdateOptions{DNSAdd: []string{"1.1.1.1"}},
+			wantNameservers: []string{"10.89.0.254", "1.1.1.1"},
+			wantLabels:      NcLabels{"env": "dev", "team": "web"},
+		},
+		{
+			name:            "add existing dns server",
+			options:         entities.NetworkUpdateOptions{DNSAdd: []string{"10.89.0.254"}},
+			wantNameservers: []string{"10.89.0.254"},
+			wantLabels:      NcLabels{"env": "dev", "team": "web"},
+		},
+		{
+			name:            "replace dns server",
+			options:         entities.NetworkUpdateOptions{DNSAdd: []string{"8.8.8.8"}, DNSDrop: []string{"10.89.0.254"}},
+			wantNameservers: []string{"8.8.8.8"},
+			wantLabels:      NcLabels{"env": "dev", "team": "web"},
+		},
+		{
+			name:       "drop last dns server",
+			options:    entities.NetworkUpdateOptions{DNSDrop: []string{"10.89.0.254"}},
+			wantLabels: NcLabels{"env": "dev", "team": "web"},
+		},
+		{
+			name:    "drop unknown dns server",
+			options: entities.NetworkUpdateOptions{DNSDrop: []string{"1.1.1.1"}},
+			wantErr: true,
+		},
+		{
+			name:    "add invalid dns server",
+			options: entities.NetworkUpdateOptions{DNSAdd: []string{"foo"}},
+			wantErr: true,
+		},
+		{
+			name:            "add and remove labels",
+			options:         entities.NetworkUpdateOptions{LabelAdd: map[string]string{"env": "prod", "tier": "1"}, LabelRm: []string{"team"}},
+			wantNameservers: []string{"10.89.0.254"},
+			wantLabels:      NcLabels{"env": "prod", "tier": "1"},
+		},
+		{
+			name:            "remove all labels",
+			options:         entities.NetworkUpdateOptions{LabelRm: []string{"env", "team"}},
+			wantNameservers: []string{"10.89.0.254"},
+			wantLabels:      NcLabels{},
+		},
+		{
+			name:    "remove unknown label",
+			options: entities.NetworkUpdateOptions{LabelRm: []string{"foo"}},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		test := tt
+		t.Run(test.name, func(t *testing.T) {
+			ncList := NcList{}
+			if err := json.Unmarshal([]byte(updateTestConfList), &ncList); err != nil {
+				t.Fatal(err)
+			}
+			err := updateNcList(ncList, test.options)
+			if (err != nil) != test.wantErr {
+				t.Fatalf("updateNcList() error = %v, wantErr %v", err, test.wantErr)
+			}
+			if test.wantErr {
+				return
+			}
+
+			// Round trip the configuration as it is written to disk
+			b, err := json.Marshal(ncList)
+			if err != nil {
+				t.Fatal(err)
+			}
+			updated := NcList{}
+			if err := json.Unmarshal(b, &updated); err != nil {
+				t.Fatal(err)
+			}
+			plugins := updated["plugins"].([]interface{})
+			nameservers := getNameservers(plugins[0].(map[string]interface{}))
+			if !reflect.DeepEqual(nameservers, test.wantNameservers) {
+				t.Errorf("nameservers = %v, want %v", nameservers, test.wantNameservers)
+			}
+			if labels := getNcListLabels(updated); !reflect.DeepEqual(labels, test.wantLabels) {
+				t.Errorf("labels = %v, want %v", labels, test.wantLabels)
+			}
+			if dnsname := plugins[1].(map[string]interface{}); dnsname["domainName"] != "example.com" {
+				t.Errorf("dnsname domain = %v, want example.com", dnsname["domainName"])
+			}
+		})
+	}
+}
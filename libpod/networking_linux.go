@@ -4,7 +4,6 @@ package libpod
 
 import (
 	"bytes"
-	"context"
 	"crypto/rand"
 	"fmt"
 	"io"
@@ -89,6 +88,27 @@ func (r *Runtime) getPodNetwork(id, name, nsPath string, networks []string, port
 	return ctrNetwork
 }
 
+// setPodNetworkOptions requests the static addresses the container was
+// connected to its additional networks with.
+func setPodNetworkOptions(podNetwork *ocicni.PodNetwork, options map[string]ContainerNetworkOptions) {
+	for netName, opts := range options {
+		if opts.StaticIP == nil && opts.StaticMAC == nil {
+			continue
+		}
+		if podNetwork.RuntimeConfig == nil {
+			podNetwork.RuntimeConfig = make(map[string]ocicni.RuntimeConfig)
+		}
+		rt := podNetwork.RuntimeConfig[netName]
+		if opts.StaticIP != nil {
+			rt.IP = opts.StaticIP.String()
+		}
+		if opts.StaticMAC != nil {
+			rt.MAC = opts.StaticMAC.String()
+		}
+		podNetwork.RuntimeConfig[netName] = rt
+	}
+}
+
 // Create and configure a new network namespace for a container
 func (r *Runtime) configureNetNS(ctr *Container, ctrNS ns.NetNS) ([]*cnitypes.Result, error) {
 	var requestedIP net.IP
@@ -126,6 +146,11 @@ func (r *Runtime) configureNetNS(ctr *Container, ctrNS ns.NetNS) ([]*cnitypes.Re
 		return nil, err
 	}
 	podNetwork := r.getPodNetwork(ctr.ID(), podName, ctrNS.Path(), networks, ctr.config.PortMappings, requestedIP, requestedMAC, ctr.state.NetInterfaceDescriptions)
+	netOptions, err := ctr.runtime.state.GetNetworkOptions(ctr)
+	if err != nil {
+		return nil, err
+	}
+	setPodNetworkOptions(&podNetwork, netOptions)
 	aliases, err := ctr.runtime.state.GetAllNetworkAliases(ctr)
 	if err != nil {
 		return nil, err
@@ -134,13 +159,13 @@ func (r *Runtime) configureNetNS(ctr *Container, ctrNS ns.NetNS) ([]*cnitypes.Re
 		podNetwork.Aliases = aliases
 	}
 
-	results, err := r.netPlugin.SetUpPod(podNetwork)
+	results, err := r.setUpPod(podNetwork)
 	if err != nil {
 		return nil, errors.Wrapf(err, "error configuring network namespace for container %s", ctr.ID())
 	}
 	defer func() {
 		if err != nil {
-			if err2 := r.netPlugin.TearDownPod(podNetwork); err2 != nil {
+			if err2 := r.tearDownPod(podNetwork); err2 != nil {
 				logrus.Errorf("Error tearing down partially created network namespace for container %s: %v", ctr.ID(), err2)
 			}
 		}
@@ -159,6 +184,40 @@ func (r *Runtime) configureNetNS(ctr *Container, ctrNS ns.NetNS) ([]*cnitypes.Re
 	return networkStatus, nil
 }
 
+// setUpPod runs the CNI plugins to attach a network namespace to its networks.
+// Rootless, they run in the rootless CNI network namespace, which is created if
+// needed.
+func (r *Runtime) setUpPod(podNetwork ocicni.PodNetwork) ([]ocicni.NetResult, error) {
+	if !rootless.IsRootless() {
+		return r.netPlugin.SetUpPod(podNetwork)
+	}
+	var results []ocicni.NetResult
+	err := r.withRootlessCNI(true, func(rootlessCNINS *rootlessCNI) error {
+		if err := rootlessCNINS.Do(func() error {
+			var err error
+			results, err = r.netPlugin.SetUpPod(podNetwork)
+			return err
+		}); err != nil {
+			return err
+		}
+		return rootlessCNINS.addUser(podNetwork.ID)
+	})
+	return results, err
+}
+
+// tearDownPod runs the CNI plugins to detach a network namespace from its
+// networks.
+func (r *Runtime) tearDownPod(podNetwork ocicni.PodNetwork) error {
+	if !rootless.IsRootless() {
+		return r.netPlugin.TearDownPod(podNetwork)
+	}
+	return r.withRootlessCNI(false, func(rootlessCNINS *rootlessCNI) error {
+		return rootlessCNINS.Do(func() error {
+			return r.netPlugin.TearDownPod(podNetwork)
+		})
+	})
+}
+
 // Create and configure a new network namespace for a container
 func (r *Runtime) createNetNS(ctr *Container) (n ns.NetNS, q []*cnitypes.Result, retErr error) {
 	ctrNS, err := netns.NewNS()
@@ -179,7 +238,7 @@ func (r *Runtime) createNetNS(ctr *Container) (n ns.NetNS, q []*cnitypes.Result,
 	logrus.Debugf("Made network namespace at %s for container %s", ctrNS.Path(), ctr.ID())
 
 	networkStatus := []*cnitypes.Result{}
-	if !rootless.IsRootless() && !ctr.config.NetMode.IsSlirp4netns() {
+	if !ctr.config.NetMode.IsSlirp4netns() {
 		networkStatus, err = r.configureNetNS(ctr, ctrNS)
 	}
 	return ctrNS, networkStatus, err
@@ -225,173 +284,203 @@ func checkSlirpFlags(path string) (*slirpFeatures, error) {
 	}, nil
 }
 
-// Configure the network namespace for a rootless container
-func (r *Runtime) setupRootlessNetNS(ctr *Container) error {
-	if ctr.config.NetMode.IsSlirp4netns() {
-		return r.setupSlirp4netns(ctr)
-	}
-	networks, _, err := ctr.networks()
-	if err != nil {
-		return err
-	}
-	if len(networks) > 0 {
-		// set up port forwarder for CNI-in-slirp4netns
-		netnsPath := ctr.state.NetNS.Path()
-		// TODO: support slirp4netns port forwarder as well
-		return r.setupRootlessPortMappingViaRLK(ctr, netnsPath)
-	}
-	return nil
+// slirp4netnsNetworkOptions are the options given to slirp4netns, either in
+// containers.conf or with --network slirp4netns:OPTIONS.
+type slirp4netnsNetworkOptions struct {
+	cidr                string
+	disableHostLoopback bool
+	enableIPv6          bool
+	isSlirpHostForward  bool
+	noPivotRoot         bool
+	outboundAddr        string
+	outboundAddr6       string
 }
 
-// setupSlirp4netns can be called in rootful as well as in rootless
-func (r *Runtime) setupSlirp4netns(ctr *Container) error {
-	path := r.config.Engine.NetworkCmdPath
-	slirpOptions := r.config.Engine.NetworkCmdOptions
-	noPivotRoot := r.config.Engine.NoPivotRoot
-	if path == "" {
-		var err error
-		path, err = exec.LookPath("slirp4netns")
-		if err != nil {
-			logrus.Errorf("could not find slirp4netns, the network namespace won't be configured: %v", err)
-			return nil
-		}
+// parseSlirp4netnsNetworkOptions parses the slirp4netns options of
+// containers.conf, followed by extraOptions.
+func parseSlirp4netnsNetworkOptions(r *Runtime, extraOptions []string) (*slirp4netnsNetworkOptions, error) {
+	slirpOptions := make([]string, 0, len(r.config.Engine.NetworkCmdOptions)+len(extraOptions))
+	slirpOptions = append(slirpOptions, r.config.Engine.NetworkCmdOptions...)
+	slirpOptions = append(slirpOptions, extraOptions...)
+	slirp4netnsOpts := &slirp4netnsNetworkOptions{
+		// overwrite defaults
+		disableHostLoopback: true,
+		noPivotRoot:         r.config.Engine.NoPivotRoot,
 	}
-
-	syncR, syncW, err := os.Pipe()
-	if err != nil {
-		return errors.Wrapf(err, "failed to open pipe")
-	}
-	defer errorhandling.CloseQuiet(syncR)
-	defer errorhandling.CloseQuiet(syncW)
-
-	havePortMapping := len(ctr.Config().PortMappings) > 0
-	logPath := filepath.Join(ctr.runtime.config.Engine.TmpDir, fmt.Sprintf("slirp4netns-%s.log", ctr.config.ID))
-
-	cidr := ""
-	isSlirpHostForward := false
-	disableHostLoopback := true
-	enableIPv6 := false
-	outboundAddr := ""
-	outboundAddr6 := ""
-
-	if ctr.config.NetworkOptions != nil {
-		slirpOptions = append(slirpOptions, ctr.config.NetworkOptions["slirp4netns"]...)
-	}
-
 	for _, o := range slirpOptions {
 		parts := strings.SplitN(o, "=", 2)
 		if len(parts) < 2 {
-			return errors.Errorf("unknown option for slirp4netns: %q", o)
+			return nil, errors.Errorf("unknown option for slirp4netns: %q", o)
 		}
 		option, value := parts[0], parts[1]
 		switch option {
 		case "cidr":
 			ipv4, _, err := net.ParseCIDR(value)
 			if err != nil || ipv4.To4() == nil {
-				return errors.Errorf("invalid cidr %q", value)
+				return nil, errors.Errorf("invalid cidr %q", value)
 			}
-			cidr = value
+			slirp4netnsOpts.cidr = value
 		case "port_handler":
 			switch value {
 			case "slirp4netns":
-				isSlirpHostForward = true
+				slirp4netnsOpts.isSlirpHostForward = true
 			case "rootlesskit":
-				isSlirpHostForward = false
+				slirp4netnsOpts.isSlirpHostForward = false
 			default:
-				return errors.Errorf("unknown port_handler for slirp4netns: %q", value)
+				return nil, errors.Errorf("unknown port_handler for slirp4netns: %q", value)
 			}
 		case "allow_host_loopback":
 			switch value {
 			case "true":
-				disableHostLoopback = false
+				slirp4netnsOpts.disableHostLoopback = false
 			case "false":
-				disableHostLoopback = true
+				slirp4netnsOpts.disableHostLoopback = true
 			default:
-				return errors.Errorf("invalid value of allow_host_loopback for slirp4netns: %q", value)
+				return nil, errors.Errorf("invalid value of allow_host_loopback for slirp4netns: %q", value)
 			}
 		case "enable_ipv6":
 			switch value {
 			case "true":
-				enableIPv6 = true
+				slirp4netnsOpts.enableIPv6 = true
 			case "false":
-				enableIPv6 = false
+				slirp4netnsOpts.enableIPv6 = false
 			default:
-				return errors.Errorf("invalid value of enable_ipv6 for slirp4netns: %q", value)
+				return nil, errors.Errorf("invalid value of enable_ipv6 for slirp4netns: %q", value)
 			}
 		case "outbound_addr":
 			ipv4 := net.ParseIP(value)
 			if ipv4 == nil || ipv4.To4() == nil {
 				_, err := net.InterfaceByName(value)
 				if err != nil {
-					return errors.Errorf("invalid outbound_addr %q", value)
+					return nil, errors.Errorf("invalid outbound_addr %q", value)
 				}
 			}
-			outboundAddr = value
+			slirp4netnsOpts.outboundAddr = value
 		case "outbound_addr6":
 			ipv6 := net.ParseIP(value)
 			if ipv6 == nil || ipv6.To4() != nil {
 				_, err := net.InterfaceByName(value)
 				if err != nil {
-					return errors.Errorf("invalid outbound_addr6: %q", value)
+					return nil, errors.Errorf("invalid outbound_addr6: %q", value)
 				}
 			}
-			outboundAddr6 = value
+			slirp4netnsOpts.outboundAddr6 = value
 		default:
-			return errors.Errorf("unknown option for slirp4netns: %q", o)
+			return nil, errors.Errorf("unknown option for slirp4netns: %q", o)
 		}
 	}
+	return slirp4netnsOpts, nil
+}
 
+// createBasicSlirp4netnsCmdArgs returns the slirp4netns arguments for the
+// given options, supported by the installed slirp4netns.
+func createBasicSlirp4netnsCmdArgs(options *slirp4netnsNetworkOptions, features *slirpFeatures) ([]string, error) {
 	cmdArgs := []string{}
-	slirpFeatures, err := checkSlirpFlags(path)
-	if err != nil {
-		return errors.Wrapf(err, "error checking slirp4netns binary %s: %q", path, err)
-	}
-	if disableHostLoopback && slirpFeatures.HasDisableHostLoopback {
+	if options.disableHostLoopback && features.HasDisableHostLoopback {
 		cmdArgs = append(cmdArgs, "--disable-host-loopback")
 	}
-	if slirpFeatures.HasMTU {
+	if features.HasMTU {
 		cmdArgs = append(cmdArgs, "--mtu", "65520")
 	}
-	if !noPivotRoot && slirpFeatures.HasEnableSandbox {
+	if !options.noPivotRoot && features.HasEnableSandbox {
 		cmdArgs = append(cmdArgs, "--enable-sandbox")
 	}
-	if slirpFeatures.HasEnableSeccomp {
+	if features.HasEnableSeccomp {
 		cmdArgs = append(cmdArgs, "--enable-seccomp")
 	}
 
-	if cidr != "" {
-		if !slirpFeatures.HasCIDR {
-			return errors.Errorf("cidr not supported")
+	if options.cidr != "" {
+		if !features.HasCIDR {
+			return nil, errors.Errorf("cidr not supported")
 		}
-		cmdArgs = append(cmdArgs, fmt.Sprintf("--cidr=%s", cidr))
+		cmdArgs = append(cmdArgs, fmt.Sprintf("--cidr=%s", options.cidr))
 	}
 
-	if enableIPv6 {
-		if !slirpFeatures.HasIPv6 {
-			return errors.Errorf("enable_ipv6 not supported")
+	if options.enableIPv6 {
+		if !features.HasIPv6 {
+			return nil, errors.Errorf("enable_ipv6 not supported")
 		}
 		cmdArgs = append(cmdArgs, "--enable-ipv6")
 	}
 
-	if outboundAddr != "" {
-		if !slirpFeatures.HasOutboundAddr {
-			return errors.Errorf("outbound_addr not supported")
+	if options.outboundAddr != "" {
+		if !features.HasOutboundAddr {
+			return nil, errors.Errorf("outbound_addr not supported")
 		}
-		cmdArgs = append(cmdArgs, fmt.Sprintf("--outbound-addr=%s", outboundAddr))
+		cmdArgs = append(cmdArgs, fmt.Sprintf("--outbound-addr=%s", options.outboundAddr))
 	}
 
-	if outboundAddr6 != "" {
-		if !slirpFeatures.HasOutboundAddr || !slirpFeatures.HasIPv6 {
-			return errors.Errorf("outbound_addr6 not supported")
+	if options.outboundAddr6 != "" {
+		if !features.HasOutboundAddr || !features.HasIPv6 {
+			return nil, errors.Errorf("outbound_addr6 not supported")
 		}
-		if !enableIPv6 {
-			return errors.Errorf("enable_ipv6=true is required for outbound_addr6")
+		if !options.enableIPv6 {
+			return nil, errors.Errorf("enable_ipv6=true is required for outbound_addr6")
 		}
-		cmdArgs = append(cmdArgs, fmt.Sprintf("--outbound-addr6=%s", outboundAddr6))
+		cmdArgs = append(cmdArgs, fmt.Sprintf("--outbound-addr6=%s", options.outboundAddr6))
+	}
+	return cmdArgs, nil
+}
+
+// Configure the network namespace for a rootless container
+func (r *Runtime) setupRootlessNetNS(ctr *Container) error {
+	if ctr.config.NetMode.IsSlirp4netns() {
+		return r.setupSlirp4netns(ctr)
+	}
+	networks, _, err := ctr.networks()
+	if err != nil {
+		return err
+	}
+	if len(networks) > 0 {
+		// set up port forwarder for CNI-in-slirp4netns
+		netnsPath := ctr.state.NetNS.Path()
+		// TODO: support slirp4netns port forwarder as well
+		return r.setupRootlessPortMappingViaRLK(ctr, netnsPath)
+	}
+	return nil
+}
+
+// setupSlirp4netns can be called in rootful as well as in rootless
+func (r *Runtime) setupSlirp4netns(ctr *Container) error {
+	path := r.config.Engine.NetworkCmdPath
+	if path == "" {
+		var err error
+		path, err = exec.LookPath("slirp4netns")
+		if err != nil {
+			logrus.Errorf("could not find slirp4netns, the network namespace won't be configured: %v", err)
+			return nil
+		}
+	}
+
+	syncR, syncW, err := os.Pipe()
+	if err != nil {
+		return errors.Wrapf(err, "failed to open pipe")
+	}
+	defer errorhandling.CloseQuiet(syncR)
+	defer errorhandling.CloseQuiet(syncW)
+
+	havePortMapping := len(ctr.Config().PortMappings) > 0
+	logPath := filepath.Join(ctr.runtime.config.Engine.TmpDir, fmt.Sprintf("slirp4netns-%s.log", ctr.config.ID))
+
+	ctrNetworkSlipOpts := []string{}
+	if ctr.config.NetworkOptions != nil {
+		ctrNetworkSlipOpts = append(ctrNetworkSlipOpts, ctr.config.NetworkOptions["slirp4netns"]...)
+	}
+	netOptions, err := parseSlirp4netnsNetworkOptions(r, ctrNetworkSlipOpts)
+	if err != nil {
+		return err
+	}
+	slirpFeatures, err := checkSlirpFlags(path)
+	if err != nil {
+		return errors.Wrapf(err, "error checking slirp4netns binary %s: %q", path, err)
+	}
+	cmdArgs, err := createBasicSlirp4netnsCmdArgs(netOptions, slirpFeatures)
+	if err != nil {
+		return err
 	}
 
 	var apiSocket string
-	if havePortMapping && isSlirpHostForward {
+	if havePortMapping && netOptions.isSlirpHostForward {
 		apiSocket = filepath.Join(ctr.runtime.config.Engine.TmpDir, fmt.Sprintf("%s.net", ctr.config.ID))
 		cmdArgs = append(cmdArgs, "--api-socket", apiSocket)
 	}
@@ -425,7 +514,7 @@ func (r *Runtime) setupSlirp4netns(ctr *Container) error {
 	}
 
 	// workaround for https://github.com/rootless-containers/slirp4netns/pull/153
-	if !noPivotRoot && slirpFeatures.HasEnableSandbox {
+	if !netOptions.noPivotRoot && slirpFeatures.HasEnableSandbox {
 		cmd.SysProcAttr.Cloneflags = syscall.CLONE_NEWNS
 		cmd.SysProcAttr.Unshareflags = syscall.CLONE_NEWNS
 	}
@@ -459,7 +548,7 @@ func (r *Runtime) setupSlirp4netns(ctr *Container) error {
 	}
 
 	if havePortMapping {
-		if isSlirpHostForward {
+		if netOptions.isSlirpHostForward {
 			return r.setupRootlessPortMappingViaSlirp(ctr, cmd, apiSocket)
 		} else {
 			return r.setupRootlessPortMappingViaRLK(ctr, netnsPath)
@@ -758,8 +847,7 @@ func (r *Runtime) teardownCNI(ctr *Container) error {
 		return err
 	}
 
-	// rootless containers do not use the CNI plugin directly
-	if !rootless.IsRootless() && !ctr.config.NetMode.IsSlirp4netns() && len(networks) > 0 {
+	if !ctr.config.NetMode.IsSlirp4netns() && len(networks) > 0 {
 		var requestedIP net.IP
 		if ctr.requestedIP != nil {
 			requestedIP = ctr.requestedIP
@@ -780,7 +868,7 @@ func (r *Runtime) teardownCNI(ctr *Container) error {
 
 		podNetwork := r.getPodNetwork(ctr.ID(), ctr.Name(), ctr.state.NetNS.Path(), networks, ctr.config.PortMappings, requestedIP, requestedMAC, ContainerNetworkDescriptions{})
 
-		if err := r.netPlugin.TearDownPod(podNetwork); err != nil {
+		if err := r.tearDownPod(podNetwork); err != nil {
 			return errors.Wrapf(err, "error tearing down CNI namespace configuration for container %s", ctr.ID())
 		}
 	}
@@ -798,13 +886,6 @@ func (r *Runtime) teardownNetNS(ctr *Container) error {
 		return err
 	}
 
-	// CNI-in-slirp4netns
-	if rootless.IsRootless() && len(networks) != 0 {
-		if err := DeallocRootlessCNI(context.Background(), ctr); err != nil {
-			return errors.Wrapf(err, "error tearing down CNI-in-slirp4netns for container %s", ctr.ID())
-		}
-	}
-
 	// First unmount the namespace
 	if err := netns.UnmountNS(ctr.state.NetNS); err != nil {
 		return errors.Wrapf(err, "error unmounting network namespace for container %s", ctr.ID())
@@ -817,6 +898,14 @@ func (r *Runtime) teardownNetNS(ctr *Container) error {
 
 	ctr.state.NetNS = nil
 
+	// Remove the rootless CNI network namespace when the last container
+	// using it is gone
+	if rootless.IsRootless() && !ctr.config.NetMode.IsSlirp4netns() && len(networks) > 0 {
+		if err := r.cleanupRootlessCNI(ctr); err != nil {
+			return errors.Wrapf(err, "error cleaning up rootless CNI network namespace")
+		}
+	}
+
 	return nil
 }
 
@@ -899,12 +988,14 @@ func (r *Runtime) reloadContainerNetwork(ctr *Container) ([]*cnitypes.Result, er
 	return r.configureNetNS(ctr, ctr.state.NetNS)
 }
 
-func getContainerNetIO(ctr *Container) (*netlink.LinkStatistics, error) {
-	var netStats *netlink.LinkStatistics
+// getContainerNetIO returns the statistics of the network interfaces of the
+// container, except for the loopback interface, by interface name
+func getContainerNetIO(ctr *Container) (map[string]*netlink.LinkStatistics, error) {
+	netStats := make(map[string]*netlink.LinkStatistics)
 	// With slirp4netns, we can't collect statistics at present.
 	// For now, we allow stats to at least run by returning nil
 	if rootless.IsRootless() || ctr.config.NetMode.IsSlirp4netns() {
-		return netStats, nil
+		return nil, nil
 	}
 	netNSPath, netPathErr := getContainerNetNS(ctr)
 	if netPathErr != nil {
@@ -916,11 +1007,20 @@ func getContainerNetIO(ctr *Container) (*netlink.LinkStatistics, error) {
 		return nil, nil
 	}
 	err := ns.WithNetNSPath(netNSPath, func(_ ns.NetNS) error {
-		link, err := netlink.LinkByName(ocicni.DefaultInterfaceName)
+		links, err := netlink.LinkList()
 		if err != nil {
 			return err
 		}
-		netStats = link.Attrs().Statistics
+		for _, link := range links {
+			attrs := link.Attrs()
+			if attrs.Flags&net.FlagLoopback != 0 || attrs.Statistics == nil {
+				continue
+			}
+			netStats[attrs.Name] = attrs.Statistics
+		}
+		if _, ok := netStats[ocicni.DefaultInterfaceName]; !ok {
+			return errors.Errorf("no such network interface %s", ocicni.DefaultInterfaceName)
+		}
 		return nil
 	})
 	return netStats, err
@@ -1139,7 +1239,7 @@ func (c *Container) NetworkDisconnect(nameOrID, netName string, force bool) erro
 	}
 
 	podConfig := c.runtime.getPodNetwork(c.ID(), c.Name(), c.state.NetNS.Path(), []string{netName}, c.config.PortMappings, nil, nil, c.state.NetInterfaceDescriptions)
-	if err := c.runtime.netPlugin.TearDownPod(podConfig); err != nil {
+	if err := c.runtime.tearDownPod(podConfig); err != nil {
 		return err
 	}
 
@@ -1157,7 +1257,7 @@ func (c *Container) NetworkDisconnect(nameOrID, netName string, force bool) erro
 }
 
 // ConnectNetwork connects a container to a given network
-func (c *Container) NetworkConnect(nameOrID, netName string, aliases []string) error {
+func (c *Container) NetworkConnect(nameOrID, netName string, aliases []string, options ContainerNetworkOptions) error {
 	networks, err := c.networksByNameIndex()
 	if err != nil {
 		return err
@@ -1177,7 +1277,7 @@ func (c *Container) NetworkConnect(nameOrID, netName string, aliases []string) e
 		return err
 	}
 
-	if err := c.runtime.state.NetworkConnect(c, netName, aliases); err != nil {
+	if err := c.runtime.state.NetworkConnect(c, netName, aliases, options); err != nil {
 		return err
 	}
 	c.newNetworkEvent(events.NetworkConnect, netName)
@@ -1197,9 +1297,10 @@ func (c *Container) NetworkConnect(nameOrID, netName string, aliases []string) e
 		return err
 	}
 	podConfig := c.runtime.getPodNetwork(c.ID(), c.Name(), c.state.NetNS.Path(), []string{netName}, c.config.PortMappings, nil, nil, c.state.NetInterfaceDescriptions)
+	setPodNetworkOptions(&podConfig, map[string]ContainerNetworkOptions{netName: options})
 	podConfig.Aliases = make(map[string][]string, 1)
 	podConfig.Aliases[netName] = aliases
-	results, err := c.runtime.netPlugin.SetUpPod(podConfig)
+	results, err := c.runtime.setUpPod(podConfig)
 	if err != nil {
 		return err
 	}
@@ -1256,8 +1357,9 @@ func (r *Runtime) DisconnectContainerFromNetwork(nameOrID, netName string, force
 	return ctr.NetworkDisconnect(nameOrID, netName, force)
 }
 
-// ConnectContainerToNetwork connects a container to a CNI network
-func (r *Runtime) ConnectContainerToNetwork(nameOrID, netName string, aliases []string) error {
+// ConnectContainerToNetwork connects a container to a CNI network.  The
+// options may request a static IP or MAC address in the network.
+func (r *Runtime) ConnectContainerToNetwork(nameOrID, netName string, aliases []string, options ContainerNetworkOptions) error {
 	if rootless.IsRootless() {
 		return errors.New("network disconnect is not enabled for rootless containers")
 	}
@@ -1265,5 +1367,5 @@ func (r *Runtime) ConnectContainerToNetwork(nameOrID, netName string, aliases []
 	if err != nil {
 		return err
 	}
-	return ctr.NetworkConnect(nameOrID, netName, aliases)
+	return ctr.NetworkConnect(nameOrID, netName, aliases, options)
 }
@@ -4,6 +4,7 @@ import (
 	"net/http"
 
 	"github.com/containers/podman/v2/libpod/define"
+	spec "github.com/opencontainers/runtime-spec/specs-go"
 	"k8s.io/client-go/tools/remotecommand"
 )
 
@@ -49,6 +50,9 @@ type OCIRuntime interface {
 	PauseContainer(ctr *Container) error
 	// UnpauseContainer unpauses the given container.
 	UnpauseContainer(ctr *Container) error
+	// UpdateContainerResources updates the resource limits of the given
+	// container while it is running or paused.
+	UpdateContainerResources(ctr *Container, resources *spec.LinuxResources) error
 
 	// HTTPAttach performs an attach intended to be transported over HTTP.
 	// For terminal attach, the container's output will be directly streamed
@@ -84,6 +88,12 @@ type OCIRuntime interface {
 	// does not attach to it. Returns the PID of the exec session and an
 	// error (if starting the exec session failed)
 	ExecContainerDetached(ctr *Container, sessionID string, options *ExecOptions, stdin bool) (int, error)
+	// ExecHTTPAttach attaches the standard streams of an exec session that
+	// is already running to a provided hijacked HTTP session. Output
+	// produced before the attach is not replayed. Returns when the exec
+	// session exits, the client detaches, or cancel is triggered.
+	// isTerminal must be set if the exec session was created with a TTY.
+	ExecHTTPAttach(ctr *Container, sessionID string, isTerminal bool, r *http.Request, w http.ResponseWriter, streams *HTTPAttachStreams, detachKeys *string, cancel <-chan bool, hijackDone chan<- bool) error
 	// ExecAttachResize resizes the terminal of a running exec session. Only
 	// allowed with sessions that were created with a TTY.
 	ExecAttachResize(ctr *Container, sessionID string, newSize remotecommand.TerminalSize) error
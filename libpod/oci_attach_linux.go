@@ -160,6 +160,47 @@ func (c *Container) attachToExec(streams *define.AttachStreams, keys *string, se
 	return readStdio(streams, receiveStdoutError, stdinDone)
 }
 
+// Attach to an exec session that has already been started, usually in
+// detached mode. Conmon keeps the session's attach socket open for as long as
+// the exec process runs, so we can connect to it at any time; output produced
+// before we attached is not replayed.
+func (c *Container) attachToRunningExec(streams *define.AttachStreams, keys *string, sessionID string, resize <-chan remotecommand.TerminalSize) error {
+	if !streams.AttachOutput && !streams.AttachError && !streams.AttachInput {
+		return errors.Wrapf(define.ErrInvalidArg, "must provide at least one stream to attach to")
+	}
+
+	detachString := config.DefaultDetachKeys
+	if keys != nil {
+		detachString = *keys
+	}
+	detachKeys, err := processDetachKeys(detachString)
+	if err != nil {
+		return err
+	}
+
+	logrus.Debugf("Attaching to container %s running exec session %s", c.ID(), sessionID)
+
+	registerResizeFunc(resize, c.execBundlePath(sessionID))
+
+	sockPath, err := c.execAttachSocketPath(sessionID)
+	if err != nil {
+		return err
+	}
+
+	conn, err := openUnixSocket(sockPath)
+	if err != nil {
+		return errors.Wrapf(err, "failed to connect to exec session's attach socket: %v", sockPath)
+	}
+	defer func() {
+		if err := conn.Close(); err != nil {
+			logrus.Errorf("unable to close socket: %q", err)
+		}
+	}()
+
+	receiveStdoutError, stdinDone := setupStdioChannels(streams, conn, detachKeys)
+	return readStdio(streams, receiveStdoutError, stdinDone)
+}
+
 func processDetachKeys(keys string) ([]byte, error) {
 	// Check the validity of the provided keys first
 	if len(keys) == 0 {
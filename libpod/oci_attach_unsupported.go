@@ -13,6 +13,10 @@ func (c *Container) attach(streams *define.AttachStreams, keys string, resize <-
 	return define.ErrNotImplemented
 }
 
+func (c *Container) attachToRunningExec(streams *define.AttachStreams, keys *string, sessionID string, resize <-chan remotecommand.TerminalSize) error {
+	return define.ErrNotImplemented
+}
+
 func (c *Container) attachToExec(streams *define.AttachStreams, keys string, resize <-chan remotecommand.TerminalSize, sessionID string, startFd *os.File, attachFd *os.File) error {
 	return define.ErrNotImplemented
 }
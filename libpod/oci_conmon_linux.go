@@ -505,6 +505,22 @@ func (r *ConmonOCIRuntime) UnpauseContainer(ctr *Container) error {
 	return utils.ExecCmdWithStdStreams(os.Stdin, os.Stdout, os.Stderr, env, r.path, append(r.runtimeFlags, "resume", ctr.ID())...)
 }
 
+// UpdateContainerResources updates the resource limits of the given
+// container.  The resources are passed to the OCI runtime on its standard
+// input.
+func (r *ConmonOCIRuntime) UpdateContainerResources(ctr *Container, resources *spec.LinuxResources) error {
+	b, err := json.Marshal(resources)
+	if err != nil {
+		return errors.Wrapf(err, "error encoding resources of container %s", ctr.ID())
+	}
+	runtimeDir, err := util.GetRuntimeDir()
+	if err != nil {
+		return err
+	}
+	env := []string{fmt.Sprintf("XDG_RUNTIME_DIR=%s", runtimeDir)}
+	return utils.ExecCmdWithStdStreams(bytes.NewReader(b), os.Stdout, os.Stderr, env, r.path, append(r.runtimeFlags, "update", "--resources", "-", ctr.ID())...)
+}
+
 // HTTPAttach performs an attach for the HTTP API.
 // The caller must handle closing the HTTP connection after this returns.
 // The cancel channel is not closed; it is up to the caller to do so after
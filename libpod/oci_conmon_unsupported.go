@@ -6,6 +6,7 @@ import (
 	"github.com/containers/common/pkg/config"
 
 	"github.com/containers/podman/v2/libpod/define"
+	spec "github.com/opencontainers/runtime-spec/specs-go"
 )
 
 const (
@@ -71,6 +72,11 @@ func (r *ConmonOCIRuntime) UnpauseContainer(ctr *Container) error {
 	return define.ErrNotImplemented
 }
 
+// UpdateContainerResources is not supported on this OS.
+func (r *ConmonOCIRuntime) UpdateContainerResources(ctr *Container, resources *spec.LinuxResources) error {
+	return define.ErrNotImplemented
+}
+
 // ExecContainer is not supported on this OS.
 func (r *ConmonOCIRuntime) ExecContainer(ctr *Container, sessionID string, options *ExecOptions) (int, chan error, error) {
 	return -1, nil, define.ErrNotImplemented
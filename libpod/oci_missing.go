@@ -7,6 +7,7 @@ import (
 	"sync"
 
 	"github.com/containers/podman/v2/libpod/define"
+	spec "github.com/opencontainers/runtime-spec/specs-go"
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
 	"k8s.io/client-go/tools/remotecommand"
@@ -109,6 +110,11 @@ func (r *MissingRuntime) UnpauseContainer(ctr *Container) error {
 	return r.printError()
 }
 
+// UpdateContainerResources is not available as the runtime is missing
+func (r *MissingRuntime) UpdateContainerResources(ctr *Container, resources *spec.LinuxResources) error {
+	return r.printError()
+}
+
 // HTTPAttach is not available as the runtime is missing
 func (r *MissingRuntime) HTTPAttach(ctr *Container, req *http.Request, w http.ResponseWriter, streams *HTTPAttachStreams, detachKeys *string, cancel <-chan bool, hijackDone chan<- bool, streamAttach, streamLogs bool) error {
 	return r.printError()
@@ -134,6 +140,11 @@ func (r *MissingRuntime) ExecContainerDetached(ctr *Container, sessionID string,
 	return -1, r.printError()
 }
 
+// ExecHTTPAttach is not available as the runtime is missing
+func (r *MissingRuntime) ExecHTTPAttach(ctr *Container, sessionID string, isTerminal bool, req *http.Request, w http.ResponseWriter, streams *HTTPAttachStreams, detachKeys *string, cancel <-chan bool, hijackDone chan<- bool) error {
+	return r.printError()
+}
+
 // ExecAttachResize is not available as the runtime is missing.
 func (r *MissingRuntime) ExecAttachResize(ctr *Container, sessionID string, newSize remotecommand.TerminalSize) error {
 	return r.printError()
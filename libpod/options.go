@@ -6,6 +6,7 @@ import (
 	"path/filepath"
 	"strings"
 	"syscall"
+	"time"
 
 	"github.com/containers/common/pkg/config"
 	"github.com/containers/image/v5/manifest"
@@ -1383,6 +1384,30 @@ func WithRestartRetries(tries uint) CtrCreateOption {
 	}
 }
 
+// WithRestartBackoff sets the delay before the container is restarted by its
+// restart policy. The delay doubles each time the container exits again before
+// running for resetAfter, up to maxDelay. A delay of 0 restarts the container
+// immediately.
+func WithRestartBackoff(delay, maxDelay, resetAfter time.Duration) CtrCreateOption {
+	return func(ctr *Container) error {
+		if ctr.valid {
+			return define.ErrCtrFinalized
+		}
+
+		if delay < 0 || maxDelay < 0 || resetAfter < 0 {
+			return errors.Wrapf(define.ErrInvalidArg, "restart delays must not be negative")
+		}
+		if maxDelay > 0 && maxDelay < delay {
+			return errors.Wrapf(define.ErrInvalidArg, "maximum restart delay %s must not be less than the restart delay %s", maxDelay, delay)
+		}
+		ctr.config.RestartDelay = delay
+		ctr.config.RestartMaxDelay = maxDelay
+		ctr.config.RestartResetAfter = resetAfter
+
+		return nil
+	}
+}
+
 // withIsInfra sets the container to be an infra container. This means the container will be sometimes hidden
 // and expected to be the first container in the pod.
 func withIsInfra() CtrCreateOption {
@@ -1572,7 +1597,8 @@ func WithVolumeLabels(labels map[string]string) VolumeCreateOption {
 
 // WithVolumeOptions sets the options of the volume.
 // If the "local" driver has been selected, options will be validated. There are
-// currently 3 valid options for the "local" driver - o, type, and device.
+// currently 5 valid options for the "local" driver - o, type, device, size and
+// inodes.
 func WithVolumeOptions(options map[string]string) VolumeCreateOption {
 	return func(volume *Volume) error {
 		if volume.valid {
@@ -1582,7 +1608,7 @@ func WithVolumeOptions(options map[string]string) VolumeCreateOption {
 		volume.config.Options = make(map[string]string)
 		for key, value := range options {
 			switch key {
-			case "type", "device", "o", "UID", "GID":
+			case "type", "device", "o", "UID", "GID", "size", "inodes":
 				volume.config.Options[key] = value
 			default:
 				return errors.Wrapf(define.ErrInvalidArg, "unrecognized volume option %q is not supported with local driver", key)
@@ -1621,6 +1647,35 @@ func WithVolumeGID(gid int) VolumeCreateOption {
 	}
 }
 
+// WithVolumeSize sets the maximum size of the volume in bytes. It is enforced
+// with an XFS project quota, and is only supported by the local driver.
+func WithVolumeSize(size uint64) VolumeCreateOption {
+	return func(volume *Volume) error {
+		if volume.valid {
+			return define.ErrVolumeFinalized
+		}
+
+		volume.config.Size = size
+
+		return nil
+	}
+}
+
+// WithVolumeInodes sets the maximum number of inodes of the volume. It is
+// enforced with an XFS project quota, and is only supported by the local
+// driver.
+func WithVolumeInodes(inodes uint64) VolumeCreateOption {
+	return func(volume *Volume) error {
+		if volume.valid {
+			return define.ErrVolumeFinalized
+		}
+
+		volume.config.Inodes = inodes
+
+		return nil
+	}
+}
+
 // WithVolumeNeedsChown sets the NeedsChown flag for the volume.
 func WithVolumeNeedsChown() VolumeCreateOption {
 	return func(volume *Volume) error {
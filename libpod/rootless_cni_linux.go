@@ -3,346 +3,618 @@
 package libpod
 
 import (
-	"bytes"
-	"context"
-	"io"
+	"io/ioutil"
+	"net"
+	"os"
+	"os/exec"
 	"path/filepath"
 	"runtime"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
 
-	cnitypes "github.com/containernetworking/cni/pkg/types/current"
 	"github.com/containernetworking/plugins/pkg/ns"
-	"github.com/containers/podman/v2/libpod/define"
-	"github.com/containers/podman/v2/libpod/image"
-	"github.com/containers/podman/v2/pkg/env"
+	"github.com/containers/podman/v2/pkg/errorhandling"
+	"github.com/containers/podman/v2/pkg/netns"
+	"github.com/containers/podman/v2/pkg/resolvconf"
 	"github.com/containers/podman/v2/pkg/util"
 	"github.com/containers/storage/pkg/lockfile"
-	"github.com/hashicorp/go-multierror"
-	spec "github.com/opencontainers/runtime-spec/specs-go"
-	"github.com/opencontainers/runtime-tools/generate"
+	"github.com/opencontainers/selinux/go-selinux/label"
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
+	"github.com/vishvananda/netlink"
+	"golang.org/x/sys/unix"
 )
 
-// Built from ../contrib/rootless-cni-infra.
-var rootlessCNIInfraImage = map[string]string{
-	"amd64": "quay.io/libpod/rootless-cni-infra@sha256:304742d5d221211df4ec672807a5842ff11e3729c50bc424ea0cea858f69d7b7", // 3-amd64
-}
-
 const (
-	rootlessCNIInfraContainerNamespace = "podman-system"
-	rootlessCNIInfraContainerName      = "rootless-cni-infra"
+	// rootlessCNINSName is the name of the network namespace, in the
+	// rootless netns directory, in which the CNI plugins run.
+	rootlessCNINSName = "rootless-cni-ns"
+	// rootlessCNIDirName is the name of the directory, in the rootless
+	// runtime directory, holding the files of the rootless CNI netns.
+	rootlessCNIDirName = "rootless-cni"
+	// rootlessCNISlirpPIDFile is the file storing the PID of the
+	// slirp4netns process connecting the rootless CNI netns to the host.
+	rootlessCNISlirpPIDFile = "rootless-cni-slirp4netns.pid"
+	// rootlessCNIUsersDirName is the name of the directory, in the
+	// directory of the rootless CNI netns, holding a file named after each
+	// container attached to the networks of the netns.
+	rootlessCNIUsersDirName = "users"
+	// persistentCNIDir is where the CNI plugins store their state, such as
+	// the addresses allocated by the host-local IPAM plugin.
+	persistentCNIDir = "/var/lib/cni"
 )
 
-// AllocRootlessCNI allocates a CNI netns inside the rootless CNI infra container.
-// Locks "rootless-cni-infra.lck".
-//
-// When the infra container is not running, it is created.
-//
-// AllocRootlessCNI does not lock c. c should be already locked.
-func AllocRootlessCNI(ctx context.Context, c *Container) (ns.NetNS, []*cnitypes.Result, error) {
-	networks, _, err := c.networks()
-	if err != nil {
-		return nil, nil, err
-	}
-	if len(networks) == 0 {
-		return nil, nil, errors.New("rootless CNI networking requires that the container has joined at least one CNI network")
-	}
-	l, err := getRootlessCNIInfraLock(c.runtime)
-	if err != nil {
-		return nil, nil, err
-	}
-	l.Lock()
-	defer l.Unlock()
-	infra, err := ensureRootlessCNIInfraContainerRunning(ctx, c.runtime)
-	if err != nil {
-		return nil, nil, err
-	}
-	k8sPodName := getCNIPodName(c) // passed to CNI as K8S_POD_NAME
-	cniResults := make([]*cnitypes.Result, len(networks))
-	for i, nw := range networks {
-		cniRes, err := rootlessCNIInfraCallAlloc(infra, c.ID(), nw, k8sPodName)
-		if err != nil {
-			return nil, nil, err
-		}
-		cniResults[i] = cniRes
-	}
-	nsObj, err := rootlessCNIInfraGetNS(infra, c.ID())
+// rootlessCNI is the network namespace shared by all rootless containers that
+// joined CNI networks. The CNI plugins run in it: the bridges of the networks
+// live there, and it is connected to the host with slirp4netns. Each
+// container still has its own network namespace, connected to the bridges of
+// its networks.
+type rootlessCNI struct {
+	ns  ns.NetNS
+	dir string
+}
+
+// getRootlessCNILock returns the lock protecting the rootless CNI netns. It
+// must be taken after the lock of the container being configured, if any.
+func (r *Runtime) getRootlessCNILock() (lockfile.Locker, error) {
+	return lockfile.GetLockfile(filepath.Join(r.config.Engine.TmpDir, "rootless-cni.lck"))
+}
+
+// getRootlessCNIDir returns the directory holding the files of the rootless
+// CNI netns.
+func getRootlessCNIDir() (string, error) {
+	runDir, err := util.GetRuntimeDir()
 	if err != nil {
-		return nil, nil, err
+		return "", err
 	}
-	logrus.Debugf("rootless CNI: container %q will join %q", c.ID(), nsObj.Path())
-	return nsObj, cniResults, nil
+	return filepath.Join(runDir, rootlessCNIDirName), nil
 }
 
-// DeallocRootlessCNI deallocates a CNI netns inside the rootless CNI infra container.
-// Locks "rootless-cni-infra.lck".
-//
-// When the infra container is no longer needed, it is removed.
-//
-// DeallocRootlessCNI does not lock c. c should be already locked.
-func DeallocRootlessCNI(ctx context.Context, c *Container) error {
-	networks, _, err := c.networks()
+// withRootlessCNI calls toRun with the rootless CNI netns, with the rootless
+// CNI lock held. If create is set, the netns is created when it does not exist
+// yet; otherwise toRun is not called when there is no netns.
+func (r *Runtime) withRootlessCNI(create bool, toRun func(*rootlessCNI) error) error {
+	lock, err := r.getRootlessCNILock()
 	if err != nil {
 		return err
 	}
-	if len(networks) == 0 {
-		return errors.New("rootless CNI networking requires that the container has joined at least one CNI network")
-	}
-	l, err := getRootlessCNIInfraLock(c.runtime)
+	lock.Lock()
+	defer lock.Unlock()
+
+	rootlessCNINS, err := r.getRootlessCNI(create)
 	if err != nil {
 		return err
 	}
-	l.Lock()
-	defer l.Unlock()
-	infra, _ := getRootlessCNIInfraContainer(c.runtime)
-	if infra == nil {
+	if rootlessCNINS == nil {
 		return nil
 	}
-	var errs *multierror.Error
-	for _, nw := range networks {
-		err := rootlessCNIInfraCallDealloc(infra, c.ID(), nw)
-		if err != nil {
-			errs = multierror.Append(errs, err)
-		}
-	}
-	if isIdle, err := rootlessCNIInfraIsIdle(infra); isIdle || err != nil {
-		if err != nil {
-			logrus.Warn(err)
-		}
-		logrus.Debugf("rootless CNI: removing infra container %q", infra.ID())
-		infra.lock.Lock()
-		defer infra.lock.Unlock()
-		if err := c.runtime.removeContainer(ctx, infra, true, false, true); err != nil {
-			return err
+	defer func() {
+		if err := rootlessCNINS.ns.Close(); err != nil {
+			logrus.Errorf("Error closing rootless CNI network namespace: %v", err)
 		}
-		logrus.Debugf("rootless CNI: removed infra container %q", infra.ID())
+	}()
+	return toRun(rootlessCNINS)
+}
+
+// addUser records that the container with the given ID is attached to the
+// networks of the netns, until its network namespace is torn down. The
+// rootless CNI lock must be held.
+func (r *rootlessCNI) addUser(ctrID string) error {
+	usersDir := filepath.Join(r.dir, rootlessCNIUsersDirName)
+	if err := os.MkdirAll(usersDir, 0700); err != nil {
+		return errors.Wrapf(err, "error creating %s", usersDir)
+	}
+	userFile := filepath.Join(usersDir, ctrID)
+	if err := ioutil.WriteFile(userFile, []byte{}, 0600); err != nil {
+		return errors.Wrapf(err, "error creating %s", userFile)
 	}
-	return errs.ErrorOrNil()
+	return nil
 }
 
-func getRootlessCNIInfraLock(r *Runtime) (lockfile.Locker, error) {
-	fname := filepath.Join(r.config.Engine.TmpDir, "rootless-cni-infra.lck")
-	return lockfile.GetLockfile(fname)
+// removeUser records that the container with the given ID is not attached to
+// the networks of the netns anymore. The rootless CNI lock must be held.
+func (r *rootlessCNI) removeUser(ctrID string) error {
+	userFile := filepath.Join(r.dir, rootlessCNIUsersDirName, ctrID)
+	if err := os.Remove(userFile); err != nil && !os.IsNotExist(err) {
+		return errors.Wrapf(err, "error removing %s", userFile)
+	}
+	return nil
 }
 
-// getCNIPodName return the pod name (hostname) used by CNI and the dnsname plugin.
-// If we are in the pod network namespace use the pod name otherwise the container name
-func getCNIPodName(c *Container) string {
-	if c.config.NetMode.IsPod() || c.IsInfra() {
-		pod, err := c.runtime.GetPod(c.PodID())
-		if err == nil {
-			return pod.Name()
+// hasUsers returns whether containers are attached to the networks of the
+// netns. The rootless CNI lock must be held.
+func (r *rootlessCNI) hasUsers() (bool, error) {
+	users, err := ioutil.ReadDir(filepath.Join(r.dir, rootlessCNIUsersDirName))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return false, nil
 		}
+		return false, err
 	}
-	return c.Name()
+	return len(users) > 0, nil
 }
 
-func rootlessCNIInfraCallAlloc(infra *Container, id, nw, k8sPodName string) (*cnitypes.Result, error) {
-	logrus.Debugf("rootless CNI: alloc %q, %q, %q", id, nw, k8sPodName)
-	var err error
-
-	_, err = rootlessCNIInfraExec(infra, "alloc", id, nw, k8sPodName)
+// getRootlessCNI returns the rootless CNI netns, creating it if it does not
+// exist and create is set. Returns nil if it does not exist and create is not
+// set. The rootless CNI lock must be held.
+func (r *Runtime) getRootlessCNI(create bool) (*rootlessCNI, error) {
+	dir, err := getRootlessCNIDir()
 	if err != nil {
 		return nil, err
 	}
-	cniResStr, err := rootlessCNIInfraExec(infra, "print-cni-result", id, nw)
+	nsDir, err := netns.GetNSRunDir()
 	if err != nil {
 		return nil, err
 	}
-	var cniRes cnitypes.Result
-	if err := json.Unmarshal([]byte(cniResStr), &cniRes); err != nil {
-		return nil, errors.Wrapf(err, "unmarshaling as cnitypes.Result: %q", cniResStr)
-	}
-	return &cniRes, nil
-}
+	path := filepath.Join(nsDir, rootlessCNINSName)
 
-func rootlessCNIInfraCallDealloc(infra *Container, id, nw string) error {
-	logrus.Debugf("rootless CNI: dealloc %q, %q", id, nw)
-	_, err := rootlessCNIInfraExec(infra, "dealloc", id, nw)
-	return err
-}
+	nsHandle, err := ns.GetNS(path)
+	if err == nil {
+		rootlessCNINS := &rootlessCNI{ns: nsHandle, dir: dir}
+		if !create {
+			return rootlessCNINS, nil
+		}
+		// slirp4netns may have been killed, in which case the netns
+		// lost its connection to the host
+		if err := r.ensureRootlessCNISlirp4netns(rootlessCNINS); err != nil {
+			if err := nsHandle.Close(); err != nil {
+				logrus.Errorf("Error closing rootless CNI network namespace: %v", err)
+			}
+			return nil, err
+		}
+		return rootlessCNINS, nil
+	}
+	if _, ok := err.(ns.NSPathNotExistErr); !ok {
+		if _, ok := err.(ns.NSPathNotNSErr); !ok {
+			return nil, errors.Wrapf(err, "error opening rootless CNI network namespace %s", path)
+		}
+	}
+	if !create {
+		return nil, nil
+	}
 
-func rootlessCNIInfraIsIdle(infra *Container) (bool, error) {
-	type isIdle struct {
-		Idle bool `json:"idle"`
+	// Start from a clean directory, left over files such as the IPAM
+	// state of a previous netns would be stale.
+	if err := os.RemoveAll(dir); err != nil {
+		return nil, errors.Wrapf(err, "error removing %s", dir)
 	}
-	resStr, err := rootlessCNIInfraExec(infra, "is-idle")
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return nil, errors.Wrapf(err, "error creating %s", dir)
+	}
+
+	nsHandle, err = netns.NewNSWithName(rootlessCNINSName)
 	if err != nil {
-		return false, err
+		return nil, errors.Wrap(err, "error creating rootless CNI network namespace")
 	}
-	var res isIdle
-	if err := json.Unmarshal([]byte(resStr), &res); err != nil {
-		return false, errors.Wrapf(err, "unmarshaling as isIdle: %q", resStr)
+	logrus.Debugf("Created rootless CNI network namespace at %s", nsHandle.Path())
+	rootlessCNINS := &rootlessCNI{ns: nsHandle, dir: dir}
+
+	if err := r.setupRootlessCNI(rootlessCNINS); err != nil {
+		if err := rootlessCNINS.remove(); err != nil {
+			logrus.Errorf("Error removing partially created rootless CNI network namespace: %v", err)
+		}
+		return nil, err
 	}
-	return res.Idle, nil
+	return rootlessCNINS, nil
 }
 
-func rootlessCNIInfraGetNS(infra *Container, id string) (ns.NetNS, error) {
-	type printNetnsPath struct {
-		Path string `json:"path"`
-	}
-	resStr, err := rootlessCNIInfraExec(infra, "print-netns-path", id)
+// setupRootlessCNI connects a new rootless CNI netns to the host, and prepares
+// the files the CNI plugins need in it.
+func (r *Runtime) setupRootlessCNI(rootlessCNINS *rootlessCNI) error {
+	netOptions, err := parseSlirp4netnsNetworkOptions(r, nil)
 	if err != nil {
-		return nil, err
+		return err
 	}
-	var res printNetnsPath
-	if err := json.Unmarshal([]byte(resStr), &res); err != nil {
-		return nil, errors.Wrapf(err, "unmarshaling as printNetnsPath: %q", resStr)
+	if err := r.startRootlessCNISlirp4netns(rootlessCNINS, netOptions); err != nil {
+		return err
 	}
-	nsObj, err := ns.GetNS(res.Path)
+
+	// The CNI plugins, notably dnsmasq started by the dnsname plugin,
+	// must use the DNS server of slirp4netns as the nameservers of the
+	// host may not be reachable from the netns, e.g. 127.0.0.53.
+	dnsServer, err := getSlirp4netnsDNS(netOptions.cidr)
 	if err != nil {
-		return nil, err
+		return err
+	}
+	var search, options []string
+	if resolvConf, err := resolvconf.Get(); err == nil {
+		search = resolvconf.GetSearchDomains(resolvConf.Content)
+		options = resolvconf.GetOptions(resolvConf.Content)
+	}
+	if _, err := resolvconf.Build(filepath.Join(rootlessCNINS.dir, "resolv.conf"), []string{dnsServer.String()}, search, options); err != nil {
+		return errors.Wrap(err, "error creating resolv.conf for the rootless CNI network namespace")
 	}
-	return nsObj, nil
-}
 
-func getRootlessCNIInfraContainer(r *Runtime) (*Container, error) {
-	containers, err := r.GetContainersWithoutLock(func(c *Container) bool {
-		return c.Namespace() == rootlessCNIInfraContainerNamespace &&
-			c.Name() == rootlessCNIInfraContainerName
+	for _, dir := range []string{filepath.Join(rootlessCNINS.dir, "run"), filepath.Join(rootlessCNINS.dir, "var")} {
+		if err := os.MkdirAll(dir, 0700); err != nil {
+			return errors.Wrapf(err, "error creating %s", dir)
+		}
+	}
+	// iptables needs to create its lock in /run
+	if err := label.Relabel(filepath.Join(rootlessCNINS.dir, "run"), "system_u:object_r:iptables_var_run_t:s0", false); err != nil {
+		return errors.Wrap(err, "error relabeling the run directory of the rootless CNI network namespace")
+	}
+
+	// Bring up the loopback interface, which is needed for the DNS server
+	// of the dnsname plugin
+	return rootlessCNINS.Do(func() error {
+		lo, err := netlink.LinkByName("lo")
+		if err != nil {
+			return errors.Wrap(err, "failed to get the loopback interface")
+		}
+		if err := netlink.LinkSetUp(lo); err != nil {
+			return errors.Wrap(err, "failed to set the loopback interface up")
+		}
+		return nil
 	})
-	if err != nil {
-		return nil, err
+}
+
+// getSlirp4netnsDNS returns the address of the DNS server of slirp4netns,
+// which is the third address of its network.
+func getSlirp4netnsDNS(cidr string) (net.IP, error) {
+	if cidr == "" {
+		cidr = "10.0.2.0/24"
 	}
-	if len(containers) == 0 {
-		return nil, nil
+	_, network, err := net.ParseCIDR(cidr)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid slirp4netns cidr %q", cidr)
 	}
-	return containers[0], nil
+	ip := make(net.IP, len(network.IP.To4()))
+	copy(ip, network.IP.To4())
+	ip[len(ip)-1] += 3
+	return ip, nil
 }
 
-func ensureRootlessCNIInfraContainerRunning(ctx context.Context, r *Runtime) (*Container, error) {
-	c, err := getRootlessCNIInfraContainer(r)
+// startRootlessCNISlirp4netns connects the rootless CNI netns to the host with
+// slirp4netns. The process runs until the netns is removed.
+func (r *Runtime) startRootlessCNISlirp4netns(rootlessCNINS *rootlessCNI, netOptions *slirp4netnsNetworkOptions) error {
+	path := r.config.Engine.NetworkCmdPath
+	if path == "" {
+		var err error
+		path, err = exec.LookPath("slirp4netns")
+		if err != nil {
+			return errors.Wrap(err, "could not find slirp4netns, the rootless CNI network namespace cannot be configured")
+		}
+	}
+	slirpFeatures, err := checkSlirpFlags(path)
 	if err != nil {
-		return nil, err
+		return errors.Wrapf(err, "error checking slirp4netns binary %s: %q", path, err)
 	}
-	if c == nil {
-		return startRootlessCNIInfraContainer(ctx, r)
+	cmdArgs, err := createBasicSlirp4netnsCmdArgs(netOptions, slirpFeatures)
+	if err != nil {
+		return err
 	}
-	st, err := c.ContainerState()
+	// -c, --configure Brings up the tap interface
+	// -r, --ready-fd=FD specify the FD to write to when the initialization steps are finished
+	cmdArgs = append(cmdArgs, "-c", "-r", "3", "--netns-type=path", rootlessCNINS.ns.Path(), "tap0")
+
+	syncR, syncW, err := os.Pipe()
 	if err != nil {
-		return nil, err
+		return errors.Wrapf(err, "failed to open pipe")
 	}
-	if st.State == define.ContainerStateRunning {
-		logrus.Debugf("rootless CNI: infra container %q is already running", c.ID())
-		return c, nil
+	defer errorhandling.CloseQuiet(syncR)
+	defer errorhandling.CloseQuiet(syncW)
+
+	cmd := exec.Command(path, cmdArgs...)
+	logrus.Debugf("slirp4netns command: %s", strings.Join(cmd.Args, " "))
+	cmd.SysProcAttr = &syscall.SysProcAttr{
+		Setpgid: true,
 	}
-	logrus.Debugf("rootless CNI: infra container %q is %q, being started", c.ID(), st.State)
-	if err := c.initAndStart(ctx); err != nil {
-		return nil, err
+	// workaround for https://github.com/rootless-containers/slirp4netns/pull/153
+	if !netOptions.noPivotRoot && slirpFeatures.HasEnableSandbox {
+		cmd.SysProcAttr.Cloneflags = syscall.CLONE_NEWNS
+		cmd.SysProcAttr.Unshareflags = syscall.CLONE_NEWNS
 	}
-	logrus.Debugf("rootless CNI: infra container %q is running", c.ID())
-	return c, nil
+	cmd.ExtraFiles = append(cmd.ExtraFiles, syncW)
+
+	logPath := filepath.Join(rootlessCNINS.dir, "slirp4netns.log")
+	logFile, err := os.Create(logPath)
+	if err != nil {
+		return errors.Wrapf(err, "failed to open slirp4netns log file %s", logPath)
+	}
+	defer logFile.Close()
+	cmd.Stdout = logFile
+	cmd.Stderr = logFile
+	if err := cmd.Start(); err != nil {
+		return errors.Wrapf(err, "failed to start slirp4netns process")
+	}
+	// Write the PID file first, so that the process is killed if the
+	// netns is removed because the setup failed.
+	pidFile := filepath.Join(rootlessCNINS.dir, rootlessCNISlirpPIDFile)
+	if err := ioutil.WriteFile(pidFile, []byte(strconv.Itoa(cmd.Process.Pid)), 0600); err != nil {
+		return errors.Wrapf(err, "unable to write slirp4netns PID file %s", pidFile)
+	}
+	defer func() {
+		if err := cmd.Process.Release(); err != nil {
+			logrus.Errorf("unable to release command process: %q", err)
+		}
+	}()
+
+	return waitForSync(syncR, cmd, logFile, 1*time.Second)
 }
 
-func startRootlessCNIInfraContainer(ctx context.Context, r *Runtime) (*Container, error) {
-	imageName, ok := rootlessCNIInfraImage[runtime.GOARCH]
-	if !ok {
-		return nil, errors.Errorf("cannot find rootless-podman-network-sandbox image for %s", runtime.GOARCH)
+// ensureRootlessCNISlirp4netns restarts slirp4netns if it is not running
+// anymore for an existing rootless CNI netns.
+func (r *Runtime) ensureRootlessCNISlirp4netns(rootlessCNINS *rootlessCNI) error {
+	if pid, err := rootlessCNINS.slirp4netnsPID(); err == nil {
+		if err := unix.Kill(pid, 0); err == nil {
+			return nil
+		}
 	}
-	logrus.Debugf("rootless CNI: ensuring image %q to exist", imageName)
-	newImage, err := r.ImageRuntime().New(ctx, imageName, "", "", nil, nil,
-		image.SigningOptions{}, nil, util.PullImageMissing)
+	logrus.Debugf("slirp4netns is not running for the rootless CNI network namespace, restarting it")
+	netOptions, err := parseSlirp4netnsNetworkOptions(r, nil)
 	if err != nil {
-		return nil, err
+		return err
 	}
-	logrus.Debugf("rootless CNI: image %q is ready", imageName)
+	return r.startRootlessCNISlirp4netns(rootlessCNINS, netOptions)
+}
 
-	g, err := generate.New("linux")
+// slirp4netnsPID returns the PID of the slirp4netns process of the netns.
+func (r *rootlessCNI) slirp4netnsPID() (int, error) {
+	b, err := ioutil.ReadFile(filepath.Join(r.dir, rootlessCNISlirpPIDFile))
 	if err != nil {
-		return nil, err
+		return 0, err
 	}
-	g.SetupPrivileged(true)
-	// Set --pid=host for ease of propagating "/proc/PID/ns/net" string
-	if err := g.RemoveLinuxNamespace(string(spec.PIDNamespace)); err != nil {
-		return nil, err
+	return strconv.Atoi(strings.TrimSpace(string(b)))
+}
+
+// Do runs toRun in the rootless CNI netns, with the mounts the CNI plugins
+// need: as they write to /run and /var/lib/cni, which are not writable by the
+// rootless user, both are replaced by directories of the netns in a private
+// mount namespace, and /etc/resolv.conf is replaced by the one of the netns.
+// Processes started by toRun, such as the CNI plugins, inherit the namespaces.
+func (r *rootlessCNI) Do(toRun func() error) error {
+	errChan := make(chan error)
+	go func() {
+		// The mount namespace of the thread is changed, it must not be
+		// reused by other goroutines. Go terminates the thread when
+		// the goroutine exits without unlocking it.
+		runtime.LockOSThread()
+		errChan <- r.do(toRun)
+	}()
+	return <-errChan
+}
+
+func (r *rootlessCNI) do(toRun func() error) error {
+	if err := r.ns.Set(); err != nil {
+		return errors.Wrapf(err, "error joining rootless CNI network namespace %s", r.ns.Path())
 	}
-	g.RemoveMount("/proc")
-	procMount := spec.Mount{
-		Destination: "/proc",
-		Type:        "bind",
-		Source:      "/proc",
-		Options:     []string{"rbind", "nosuid", "noexec", "nodev"},
+	if err := unix.Unshare(unix.CLONE_NEWNS); err != nil {
+		return errors.Wrap(err, "cannot create a new mount namespace")
 	}
-	g.AddMount(procMount)
-	// Mount CNI networks
-	etcCNINetD := spec.Mount{
-		Destination: "/etc/cni/net.d",
-		Type:        "bind",
-		Source:      r.config.Network.NetworkConfigDir,
-		Options:     []string{"ro", "bind"},
+	// Do not propagate the mounts below to the mount namespace of podman
+	if err := unix.Mount("", "/", "", unix.MS_SLAVE|unix.MS_REC, ""); err != nil {
+		return errors.Wrap(err, "cannot make / a slave mount")
 	}
-	g.AddMount(etcCNINetD)
 
-	inspectData, err := newImage.Inspect(ctx)
+	runDir := filepath.Join(r.dir, "run")
+	// The CNI plugins need access to the network namespaces of the
+	// containers, which are in the rootless runtime directory, usually
+	// below /run.
+	nsDir, err := netns.GetNSRunDir()
 	if err != nil {
-		return nil, err
+		return err
+	}
+	if strings.HasPrefix(nsDir, "/run/") {
+		newNSDir := filepath.Join(r.dir, nsDir)
+		if err := os.MkdirAll(newNSDir, 0700); err != nil {
+			return errors.Wrapf(err, "error creating %s", newNSDir)
+		}
+		if err := unix.Mount(nsDir, newNSDir, "none", unix.MS_BIND|unix.MS_REC, ""); err != nil {
+			return errors.Wrapf(err, "failed to mount %s on %s", nsDir, newNSDir)
+		}
 	}
-	imageEnv, err := env.ParseSlice(inspectData.Config.Env)
+	// Many files, e.g. /dev/log, are symlinks to /run/systemd
+	if _, err := os.Stat("/run/systemd"); err == nil {
+		newRunSystemd := filepath.Join(runDir, "systemd")
+		if err := os.MkdirAll(newRunSystemd, 0700); err != nil {
+			return errors.Wrapf(err, "error creating %s", newRunSystemd)
+		}
+		if err := unix.Mount("/run/systemd", newRunSystemd, "none", unix.MS_BIND|unix.MS_REC, ""); err != nil {
+			return errors.Wrapf(err, "failed to mount /run/systemd on %s", newRunSystemd)
+		}
+	}
+
+	// /etc/resolv.conf may be a symlink into /run, e.g. to
+	// /run/systemd/resolve/stub-resolv.conf, in which case the file must be
+	// mounted on its target in the new /run.
+	resolvPath, err := filepath.EvalSymlinks("/etc/resolv.conf")
 	if err != nil {
-		return nil, err
+		return errors.Wrap(err, "failed to resolve /etc/resolv.conf")
 	}
-	for k, v := range imageEnv {
-		g.AddProcessEnv(k, v)
+	if strings.HasPrefix(resolvPath, "/run/") {
+		resolvPath = filepath.Join(r.dir, resolvPath)
+		if err := os.MkdirAll(filepath.Dir(resolvPath), 0700); err != nil {
+			return errors.Wrapf(err, "error creating %s", filepath.Dir(resolvPath))
+		}
+		f, err := os.Create(resolvPath)
+		if err != nil {
+			return errors.Wrapf(err, "error creating %s", resolvPath)
+		}
+		f.Close()
 	}
-	if len(inspectData.Config.Cmd) == 0 {
-		return nil, errors.Errorf("rootless CNI infra image %q has no command specified", imageName)
+	if err := unix.Mount(filepath.Join(r.dir, "resolv.conf"), resolvPath, "none", unix.MS_BIND, ""); err != nil {
+		return errors.Wrapf(err, "failed to mount resolv.conf on %s", resolvPath)
 	}
-	g.SetProcessArgs(inspectData.Config.Cmd)
 
-	var options []CtrCreateOption
-	options = append(options, WithRootFSFromImage(newImage.ID(), imageName, imageName))
-	options = append(options, WithCtrNamespace(rootlessCNIInfraContainerNamespace))
-	options = append(options, WithName(rootlessCNIInfraContainerName))
-	options = append(options, WithPrivileged(true))
-	options = append(options, WithSecLabels([]string{"disable"}))
-	options = append(options, WithRestartPolicy("always"))
-	options = append(options, WithNetNS(nil, false, "slirp4netns", nil))
-	c, err := r.NewContainer(ctx, g.Config, options...)
-	if err != nil {
-		return nil, err
+	if err := r.mountPersistentCNIDir(); err != nil {
+		return err
 	}
-	logrus.Debugf("rootless CNI infra container %q is created, now being started", c.ID())
-	if err := c.initAndStart(ctx); err != nil {
-		return nil, err
+
+	// Last, as it hides the sources of the mounts above
+	if err := unix.Mount(runDir, "/run", "none", unix.MS_BIND|unix.MS_REC, ""); err != nil {
+		return errors.Wrapf(err, "failed to mount %s on /run", runDir)
 	}
-	logrus.Debugf("rootless CNI: infra container %q is running", c.ID())
 
-	return c, nil
+	return toRun()
 }
 
-func rootlessCNIInfraExec(c *Container, args ...string) (string, error) {
-	cmd := "rootless-cni-infra"
-	var (
-		outB    bytes.Buffer
-		errB    bytes.Buffer
-		streams define.AttachStreams
-		config  ExecConfig
-	)
-	streams.OutputStream = &nopWriteCloser{Writer: &outB}
-	streams.ErrorStream = &nopWriteCloser{Writer: &errB}
-	streams.AttachOutput = true
-	streams.AttachError = true
-	config.Command = append([]string{cmd}, args...)
-	config.Privileged = true
-	logrus.Debugf("rootlessCNIInfraExec: c.ID()=%s, config=%+v, streams=%v, begin",
-		c.ID(), config, streams)
-	code, err := c.Exec(&config, &streams, nil)
-	logrus.Debugf("rootlessCNIInfraExec: c.ID()=%s, config=%+v, streams=%v, end (code=%d, err=%v)",
-		c.ID(), config, streams, code, err)
+// mountPersistentCNIDir mounts a directory private to the user on
+// persistentCNIDir.  Mounts can only be done on existing directories, and
+// the rootless user cannot create /var/lib/cni if it does not exist.  In this
+// case, the nearest existing parent, e.g. /var/lib, is replaced by a
+// directory in the rootless CNI state dir in which all its entries are
+// recreated, so that the missing directories can be created in it.  Must be
+// called in a private mount namespace.
+func (r *rootlessCNI) mountPersistentCNIDir() error {
+	target := persistentCNIDir
+	for {
+		if _, err := os.Stat(target); err == nil {
+			break
+		}
+		target = filepath.Dir(target)
+	}
+	if target == "/" {
+		return errors.Errorf("no parent directory of %s exists", persistentCNIDir)
+	}
+	if target == persistentCNIDir {
+		varDir := filepath.Join(r.dir, persistentCNIDir)
+		if err := os.MkdirAll(varDir, 0700); err != nil {
+			return errors.Wrapf(err, "error creating %s", varDir)
+		}
+		if err := unix.Mount(varDir, persistentCNIDir, "none", unix.MS_BIND, ""); err != nil {
+			return errors.Wrapf(err, "failed to mount %s on %s", varDir, persistentCNIDir)
+		}
+		return nil
+	}
+	logrus.Debugf("%s does not exist, replacing %s in the rootless CNI network namespace", persistentCNIDir, target)
+
+	// Keep the original contents of target reachable while it is
+	// replaced.
+	origDir := filepath.Join(r.dir, "orig")
+	if err := os.MkdirAll(origDir, 0700); err != nil {
+		return errors.Wrapf(err, "error creating %s", origDir)
+	}
+	if err := unix.Mount(target, origDir, "none", unix.MS_BIND|unix.MS_REC, ""); err != nil {
+		return errors.Wrapf(err, "failed to mount %s on %s", target, origDir)
+	}
+	defer func() {
+		if err := unix.Unmount(origDir, unix.MNT_DETACH); err != nil {
+			logrus.Errorf("Error unmounting %s: %v", origDir, err)
+		}
+	}()
+	entries, err := ioutil.ReadDir(origDir)
 	if err != nil {
-		return "", err
+		return errors.Wrapf(err, "error reading %s", target)
 	}
-	if code != 0 {
-		return "", errors.Errorf("command %s %v in container %s failed with status %d, stdout=%q, stderr=%q",
-			cmd, args, c.ID(), code, outB.String(), errB.String())
+
+	varDir := filepath.Join(r.dir, target)
+	if err := os.MkdirAll(varDir, 0700); err != nil {
+		return errors.Wrapf(err, "error creating %s", varDir)
+	}
+	if err := unix.Mount(varDir, target, "none", unix.MS_BIND, ""); err != nil {
+		return errors.Wrapf(err, "failed to mount %s on %s", varDir, target)
+	}
+	for _, entry := range entries {
+		src := filepath.Join(origDir, entry.Name())
+		dest := filepath.Join(target, entry.Name())
+		if entry.Mode()&os.ModeSymlink != 0 {
+			link, err := os.Readlink(src)
+			if err != nil {
+				return errors.Wrapf(err, "error reading symlink %s", src)
+			}
+			if err := os.Symlink(link, dest); err != nil && !os.IsExist(err) {
+				return errors.Wrapf(err, "error recreating symlink %s", dest)
+			}
+			continue
+		}
+		if entry.IsDir() {
+			if err := os.MkdirAll(dest, 0700); err != nil {
+				return errors.Wrapf(err, "error creating %s", dest)
+			}
+		} else {
+			f, err := os.OpenFile(dest, os.O_CREATE|os.O_RDONLY, 0600)
+			if err != nil {
+				return errors.Wrapf(err, "error creating %s", dest)
+			}
+			f.Close()
+		}
+		if err := unix.Mount(src, dest, "none", unix.MS_BIND|unix.MS_REC, ""); err != nil {
+			return errors.Wrapf(err, "failed to mount %s on %s", src, dest)
+		}
 	}
-	return outB.String(), nil
+
+	// The replaced target is owned by the user, so the missing directories
+	// can be created now.
+	if err := os.MkdirAll(persistentCNIDir, 0700); err != nil {
+		return errors.Wrapf(err, "error creating %s", persistentCNIDir)
+	}
+	return nil
 }
 
-type nopWriteCloser struct {
-	io.Writer
+// remove stops the processes running in the rootless CNI netns, and removes
+// the netns and its files.
+func (r *rootlessCNI) remove() error {
+	if pid, err := r.slirp4netnsPID(); err == nil {
+		if err := unix.Kill(pid, unix.SIGTERM); err != nil && err != unix.ESRCH {
+			logrus.Errorf("Error killing slirp4netns process %d: %v", pid, err)
+		}
+	}
+	// The dnsname plugin leaves a dnsmasq process running per network,
+	// which would keep the netns alive.
+	pidFiles, _ := filepath.Glob(filepath.Join(r.dir, "run", "containers", "cni", "dnsname", "*", "pidfile"))
+	for _, pidFile := range pidFiles {
+		b, err := ioutil.ReadFile(pidFile)
+		if err != nil {
+			continue
+		}
+		pid, err := strconv.Atoi(strings.TrimSpace(string(b)))
+		if err != nil {
+			continue
+		}
+		if err := unix.Kill(pid, unix.SIGTERM); err != nil && err != unix.ESRCH {
+			logrus.Errorf("Error killing dnsmasq process %d: %v", pid, err)
+		}
+	}
+
+	if err := netns.UnmountNS(r.ns); err != nil {
+		return errors.Wrap(err, "error unmounting rootless CNI network namespace")
+	}
+	if err := r.ns.Close(); err != nil {
+		return errors.Wrap(err, "error closing rootless CNI network namespace")
+	}
+	return os.RemoveAll(r.dir)
 }
 
-func (nwc *nopWriteCloser) Close() error {
-	return nil
+// cleanupRootlessCNI records that ctr, whose network namespace was torn down,
+// is not attached to the networks of the rootless CNI netns anymore, and
+// removes the netns if no other container is.
+func (r *Runtime) cleanupRootlessCNI(ctr *Container) error {
+	lock, err := r.getRootlessCNILock()
+	if err != nil {
+		return err
+	}
+	lock.Lock()
+	defer lock.Unlock()
+
+	rootlessCNINS, err := r.getRootlessCNI(false)
+	if err != nil || rootlessCNINS == nil {
+		return err
+	}
+	if err := rootlessCNINS.removeUser(ctr.ID()); err != nil {
+		logrus.Errorf("Error removing container %s from the users of the rootless CNI network namespace: %v", ctr.ID(), err)
+	}
+	inUse, err := rootlessCNINS.hasUsers()
+	if err != nil || inUse {
+		if err := rootlessCNINS.ns.Close(); err != nil {
+			logrus.Errorf("Error closing rootless CNI network namespace: %v", err)
+		}
+		return err
+	}
+	logrus.Debugf("Removing rootless CNI network namespace %s", rootlessCNINS.ns.Path())
+	return rootlessCNINS.remove()
+}
+
+// getCNIPodName return the pod name (hostname) used by CNI and the dnsname plugin.
+// If we are in the pod network namespace use the pod name otherwise the container name
+func getCNIPodName(c *Container) string {
+	if c.config.NetMode.IsPod() || c.IsInfra() {
+		pod, err := c.runtime.GetPod(c.PodID())
+		if err == nil {
+			return pod.Name()
+		}
+	}
+	return c.Name()
 }
@@ -20,6 +20,7 @@ import (
 	"github.com/containers/podman/v2/libpod/plugin"
 	"github.com/containers/podman/v2/libpod/shutdown"
 	"github.com/containers/podman/v2/pkg/cgroups"
+	"github.com/containers/podman/v2/pkg/quota"
 	"github.com/containers/podman/v2/pkg/registries"
 	"github.com/containers/podman/v2/pkg/rootless"
 	"github.com/containers/podman/v2/pkg/util"
@@ -85,6 +86,16 @@ type Runtime struct {
 
 	// noStore indicates whether we need to interact with a store or not
 	noStore bool
+
+	// volumeQuota sets and reads the quotas of local volumes.  It is
+	// created on first use by getVolumeQuota.
+	volumeQuota     *quota.Control
+	volumeQuotaLock sync.Mutex
+
+	// imageGCPolicy is the policy by which image garbage collection
+	// evicts unused images after images were written to local storage.
+	// It is loaded from containers.conf.
+	imageGCPolicy image.GCPolicy
 }
 
 // SetXdgDirs ensures the XDG_RUNTIME_DIR env and XDG_CONFIG_HOME variables are set.
@@ -167,6 +178,12 @@ func newRuntimeFromConfig(ctx context.Context, conf *config.Config, options ...R
 		return nil, err
 	}
 
+	imageGCPolicy, err := loadImageGCPolicy()
+	if err != nil {
+		return nil, err
+	}
+	runtime.imageGCPolicy = imageGCPolicy
+
 	storeOpts, err := storage.DefaultStoreOptions(rootless.IsRootless(), rootless.GetRootlessUID())
 	if err != nil {
 		return nil, err
@@ -725,6 +742,7 @@ func (r *Runtime) configureStore() error {
 	ir.SignaturePolicyPath = r.config.Engine.SignaturePolicyPath
 	ir.EventsLogFilePath = r.config.Engine.EventsLogFilePath
 	ir.EventsLogger = r.config.Engine.EventsLogger
+	ir.GCPolicy = r.imageGCPolicy
 
 	r.imageRuntime = ir
 
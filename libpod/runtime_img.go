@@ -169,6 +169,9 @@ func (r *Runtime) Build(ctx context.Context, options imagebuildah.BuildOptions,
 	id, ref, err := imagebuildah.BuildDockerfiles(ctx, r.store, options, dockerfiles...)
 	// Write event for build completion
 	r.newImageBuildCompleteEvent(id)
+	if err == nil {
+		r.imageRuntime.AutoGarbageCollect(ctx, id)
+	}
 	return id, ref, err
 }
 
@@ -0,0 +1,75 @@
+package libpod
+
+import (
+	"os"
+	"time"
+
+	"github.com/BurntSushi/toml"
+	"github.com/containers/common/pkg/config"
+	"github.com/containers/podman/v2/libpod/image"
+	"github.com/containers/podman/v2/pkg/rootless"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+// imageGCConfig holds the image garbage collection settings in the [engine]
+// table of containers.conf.  They are not part of the containers/common
+// configuration, so libpod decodes them itself.  Unset fields keep their
+// previous values.
+type imageGCConfig struct {
+	Engine struct {
+		ImageGCHighThreshold *uint   `toml:"image_gc_high_threshold"`
+		ImageGCLowThreshold  *uint   `toml:"image_gc_low_threshold"`
+		ImageGCMinAge        *string `toml:"image_gc_min_age"`
+	} `toml:"engine"`
+}
+
+// imageGCConfigFiles returns the containers.conf files in the order in which
+// they are merged.  It follows the lookup of containers/common.
+func imageGCConfigFiles() []string {
+	if path := os.Getenv("CONTAINERS_CONF"); path != "" {
+		return []string{path}
+	}
+	paths := []string{config.DefaultContainersConfig, config.OverrideContainersConfig}
+	if rootless.IsRootless() {
+		paths = append(paths, config.Path())
+	}
+	return paths
+}
+
+// loadImageGCPolicy returns the image garbage collection policy configured in
+// containers.conf.  Automatic garbage collection is disabled unless
+// image_gc_high_threshold is set.
+func loadImageGCPolicy() (image.GCPolicy, error) {
+	policy := image.DefaultGCPolicy
+	policy.HighThreshold = 0
+
+	for _, path := range imageGCConfigFiles() {
+		if _, err := os.Stat(path); err != nil {
+			continue
+		}
+		var conf imageGCConfig
+		if _, err := toml.DecodeFile(path, &conf); err != nil {
+			return policy, errors.Wrapf(err, "unable to decode configuration %v", path)
+		}
+		if conf.Engine.ImageGCHighThreshold != nil {
+			policy.HighThreshold = *conf.Engine.ImageGCHighThreshold
+		}
+		if conf.Engine.ImageGCLowThreshold != nil {
+			policy.LowThreshold = *conf.Engine.ImageGCLowThreshold
+		}
+		if conf.Engine.ImageGCMinAge != nil {
+			minAge, err := time.ParseDuration(*conf.Engine.ImageGCMinAge)
+			if err != nil {
+				return policy, errors.Wrapf(err, "invalid image_gc_min_age %q in %v", *conf.Engine.ImageGCMinAge, path)
+			}
+			policy.MinAge = minAge
+		}
+	}
+
+	if err := policy.Validate(); err != nil {
+		return policy, errors.Wrapf(err, "invalid image garbage collection policy in containers.conf")
+	}
+	logrus.Debugf("Using image garbage collection policy %+v", policy)
+	return policy, nil
+}
@@ -0,0 +1,56 @@
+package libpod
+
+import (
+	"os"
+	"testing"
+	"time"
+
+	"github.com/containers/podman/v2/libpod/image"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLoadImageGCPolicy(t *testing.T) {
+	for _, tc := range []struct {
+		name     string
+		conf     string
+		expected image.GCPolicy
+		fails    bool
+	}{
+		{
+			name:     "disabled by default",
+			conf:     "[engine]\n",
+			expected: image.GCPolicy{HighThreshold: 0, LowThreshold: 80, MinAge: 2 * time.Minute},
+		},
+		{
+			name:     "configured",
+			conf:     "[engine]\nimage_gc_high_threshold = 90\nimage_gc_low_threshold = 50\nimage_gc_min_age = \"1h\"\n",
+			expected: image.GCPolicy{HighThreshold: 90, LowThreshold: 50, MinAge: time.Hour},
+		},
+		{
+			name:  "low threshold above high threshold",
+			conf:  "[engine]\nimage_gc_high_threshold = 50\nimage_gc_low_threshold = 60\n",
+			fails: true,
+		},
+		{
+			name:  "invalid min age",
+			conf:  "[engine]\nimage_gc_min_age = \"soon\"\n",
+			fails: true,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			path, err := createTmpFile([]byte(tc.conf))
+			assert.NoError(t, err)
+			defer os.Remove(path)
+			os.Setenv("CONTAINERS_CONF", path)
+			defer os.Unsetenv("CONTAINERS_CONF")
+
+			policy, err := loadImageGCPolicy()
+			if tc.fails {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expected, policy)
+		})
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"github.com/containers/podman/v2/libpod/define"
 	"github.com/containers/podman/v2/libpod/events"
 	"github.com/containers/podman/v2/pkg/domain/entities/reports"
+	"github.com/containers/podman/v2/pkg/quota"
 	"github.com/pkg/errors"
 )
 
@@ -163,3 +164,19 @@ func (r *Runtime) PruneVolumes(ctx context.Context, filterFuncs []VolumeFilter)
 	}
 	return preports, nil
 }
+
+// getVolumeQuota returns the quota control of the volume path, creating it on
+// first use.
+func (r *Runtime) getVolumeQuota() (*quota.Control, error) {
+	r.volumeQuotaLock.Lock()
+	defer r.volumeQuotaLock.Unlock()
+
+	if r.volumeQuota == nil {
+		q, err := quota.NewControl(r.config.Engine.VolumePath)
+		if err != nil {
+			return nil, err
+		}
+		r.volumeQuota = q
+	}
+	return r.volumeQuota, nil
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/containers/podman/v2/libpod/define"
 	"github.com/containers/podman/v2/libpod/events"
 	volplugin "github.com/containers/podman/v2/libpod/plugin"
+	"github.com/containers/podman/v2/pkg/quota"
 	"github.com/containers/storage/pkg/stringid"
 	pluginapi "github.com/docker/go-plugins-helpers/volume"
 	"github.com/pkg/errors"
@@ -68,12 +69,17 @@ func (r *Runtime) newVolume(ctx context.Context, options ...VolumeCreateOption)
 		// Validate options
 		for key := range volume.config.Options {
 			switch key {
-			case "device", "o", "type", "UID", "GID":
+			case "device", "o", "type", "UID", "GID", "size", "inodes":
 				// Do nothing, valid keys
 			default:
 				return nil, errors.Wrapf(define.ErrInvalidArg, "invalid mount option %s for driver 'local'", key)
 			}
 		}
+		if volume.hasQuota() && volume.needsMount() {
+			return nil, errors.Wrapf(define.ErrInvalidArg, "size and inodes options cannot be used with the device and type options")
+		}
+	} else if volume.hasQuota() {
+		return nil, errors.Wrapf(define.ErrInvalidArg, "size and inodes options are only supported by the local driver")
 	}
 
 	// Now we get conditional: we either need to make the volume in the
@@ -96,6 +102,17 @@ func (r *Runtime) newVolume(ctx context.Context, options ...VolumeCreateOption)
 		if err := os.Chown(volPathRoot, volume.config.UID, volume.config.GID); err != nil {
 			return nil, errors.Wrapf(err, "error chowning volume directory %q to %d:%d", volPathRoot, volume.config.UID, volume.config.GID)
 		}
+		// Set the quota on the volume directory before creating the data
+		// directory, so that it inherits the project ID.
+		if volume.hasQuota() {
+			q, err := r.getVolumeQuota()
+			if err != nil {
+				return nil, errors.Wrapf(err, "cannot set the size or inodes of volume %s", volume.config.Name)
+			}
+			if err := q.SetQuota(volPathRoot, quota.Quota{Size: volume.config.Size, Inodes: volume.config.Inodes}); err != nil {
+				return nil, errors.Wrapf(err, "error setting quota of volume %s", volume.config.Name)
+			}
+		}
 		fullVolPath := filepath.Join(volPathRoot, "_data")
 		if err := os.MkdirAll(fullVolPath, 0755); err != nil {
 			return nil, errors.Wrapf(err, "error creating volume directory %q", fullVolPath)
@@ -104,9 +104,12 @@ type State interface {
 	GetNetworkAliases(ctr *Container, network string) ([]string, error)
 	// Get all network aliases for the given container.
 	GetAllNetworkAliases(ctr *Container) (map[string][]string, error)
+	// Get the options of the given container in the networks it was
+	// connected to with network connect, by network name.
+	GetNetworkOptions(ctr *Container) (map[string]ContainerNetworkOptions, error)
 	// Add the container to the given network, adding the given aliases
-	// (if present).
-	NetworkConnect(ctr *Container, network string, aliases []string) error
+	// (if present) and options.
+	NetworkConnect(ctr *Container, network string, aliases []string, options ContainerNetworkOptions) error
 	// Remove the container from the given network, removing all aliases for
 	// the container in that network in the process.
 	NetworkDisconnect(ctr *Container, network string) error
@@ -9,6 +9,7 @@ import (
 
 	"github.com/containers/podman/v2/libpod/define"
 	"github.com/containers/podman/v2/pkg/cgroups"
+	"github.com/cri-o/ocicni/pkg/ocicni"
 	"github.com/pkg/errors"
 )
 
@@ -69,10 +70,27 @@ func (c *Container) GetContainerStats(previousStats *define.ContainerStats) (*de
 	stats.CPUSystemNano = cgroupStats.CPU.Usage.Kernel
 	stats.SystemNano = now
 	stats.PerCPU = cgroupStats.CPU.Usage.PerCPU
+	stats.MemCache = cgroupStats.Memory.Cache
+	stats.MemRSS = cgroupStats.Memory.RSS
+	stats.MemSwap = cgroupStats.Memory.Swap
 	// Handle case where the container is not in a network namespace
 	if netStats != nil {
-		stats.NetInput = netStats.TxBytes
-		stats.NetOutput = netStats.RxBytes
+		defaultStats := netStats[ocicni.DefaultInterfaceName]
+		stats.NetInput = defaultStats.TxBytes
+		stats.NetOutput = defaultStats.RxBytes
+		stats.Network = make(map[string]define.ContainerNetworkStats, len(netStats))
+		for name, s := range netStats {
+			stats.Network[name] = define.ContainerNetworkStats{
+				RxBytes:   s.RxBytes,
+				RxPackets: s.RxPackets,
+				RxErrors:  s.RxErrors,
+				RxDropped: s.RxDropped,
+				TxBytes:   s.TxBytes,
+				TxPackets: s.TxPackets,
+				TxErrors:  s.TxErrors,
+				TxDropped: s.TxDropped,
+			}
+		}
 	} else {
 		stats.NetInput = 0
 		stats.NetOutput = 0
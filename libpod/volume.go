@@ -8,6 +8,7 @@ import (
 	"github.com/containers/podman/v2/libpod/define"
 	"github.com/containers/podman/v2/libpod/lock"
 	"github.com/containers/podman/v2/libpod/plugin"
+	"github.com/pkg/errors"
 )
 
 // Volume is a libpod named volume.
@@ -49,6 +50,12 @@ type VolumeConfig struct {
 	UID int `json:"uid"`
 	// GID the volume will be created as.
 	GID int `json:"gid"`
+	// Size is the maximum size of the volume in bytes, enforced with a
+	// project quota. Only used by the local driver. 0 means no limit.
+	Size uint64 `json:"size,omitempty"`
+	// Inodes is the maximum number of inodes of the volume, enforced with
+	// a project quota. Only used by the local driver. 0 means no limit.
+	Inodes uint64 `json:"inodes,omitempty"`
 }
 
 // VolumeState holds the volume's mutable state.
@@ -99,6 +106,28 @@ func (v *Volume) Size() (uint64, error) {
 	return size, err
 }
 
+// Quota returns the size and inodes limits of the volume and their current
+// usage. It returns nil if the volume was created without a quota.
+func (v *Volume) Quota() (*define.InspectVolumeQuota, error) {
+	if !v.hasQuota() {
+		return nil, nil
+	}
+	q, err := v.runtime.getVolumeQuota()
+	if err != nil {
+		return nil, err
+	}
+	limits, usage, err := q.GetQuota(filepath.Join(v.runtime.config.Engine.VolumePath, v.Name()))
+	if err != nil {
+		return nil, errors.Wrapf(err, "error getting quota of volume %s", v.Name())
+	}
+	return &define.InspectVolumeQuota{
+		Size:       limits.Size,
+		Inodes:     limits.Inodes,
+		SizeUsed:   usage.Size,
+		InodesUsed: usage.Inodes,
+	}, nil
+}
+
 // Driver retrieves the volume's driver.
 func (v *Volume) Driver() string {
 	return v.config.Driver
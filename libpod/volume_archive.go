@@ -0,0 +1,73 @@
+package libpod
+
+import (
+	"io"
+
+	"github.com/containers/podman/v2/libpod/define"
+	"github.com/containers/storage/pkg/archive"
+	"github.com/containers/storage/pkg/chrootarchive"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+// Export writes the contents of the volume to w as an uncompressed tar
+// archive. Volumes that need to be mounted, including volumes using a volume
+// plugin, are mounted for the duration of the export.
+func (v *Volume) Export(w io.Writer) error {
+	return v.withMountPoint(func(mountPoint string) error {
+		input, err := archive.Tar(mountPoint, archive.Uncompressed)
+		if err != nil {
+			return errors.Wrapf(err, "error reading volume directory %q", mountPoint)
+		}
+		defer input.Close()
+
+		if _, err := io.Copy(w, input); err != nil {
+			return errors.Wrapf(err, "error exporting volume %s", v.Name())
+		}
+		return nil
+	})
+}
+
+// Import extracts the tar archive read from r, which may be compressed, into
+// the volume. Existing files with the same names are overwritten. Volumes that
+// need to be mounted, including volumes using a volume plugin, are mounted for
+// the duration of the import. The archive is extracted chrooted into the
+// volume, so symlinks planted in the volume cannot redirect writes to the
+// host.
+func (v *Volume) Import(r io.Reader) error {
+	return v.withMountPoint(func(mountPoint string) error {
+		if err := chrootarchive.Untar(r, mountPoint, nil); err != nil {
+			return errors.Wrapf(err, "error importing into volume %s", v.Name())
+		}
+		return nil
+	})
+}
+
+// withMountPoint mounts the volume if necessary and runs fn with its
+// mountpoint. The volume is locked while fn runs.
+func (v *Volume) withMountPoint(fn func(mountPoint string) error) error {
+	if !v.valid {
+		return define.ErrVolumeRemoved
+	}
+
+	v.lock.Lock()
+	defer v.lock.Unlock()
+
+	if err := v.mount(); err != nil {
+		return errors.Wrapf(err, "error mounting volume %s", v.Name())
+	}
+	defer func() {
+		if err := v.unmount(false); err != nil {
+			logrus.Errorf("Error unmounting volume %s: %v", v.Name(), err)
+		}
+	}()
+
+	mountPoint := v.config.MountPoint
+	if v.UsesVolumeDriver() {
+		mountPoint = v.state.MountPoint
+	}
+	if mountPoint == "" {
+		return errors.Wrapf(define.ErrInternal, "volume %s does not have a mountpoint", v.Name())
+	}
+	return fn(mountPoint)
+}
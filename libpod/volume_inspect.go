@@ -61,5 +61,16 @@ func (v *Volume) Inspect() (*define.InspectVolumeData, error) {
 	data.GID = v.gid()
 	data.Anonymous = v.config.IsAnon
 
+	if v.hasQuota() {
+		quota, err := v.Quota()
+		if err != nil {
+			// The limits are still enforced, do not fail inspect
+			// because the usage cannot be retrieved.
+			logrus.Warnf("Unable to get quota of volume %s: %v", v.Name(), err)
+			quota = &define.InspectVolumeQuota{Size: v.config.Size, Inodes: v.config.Inodes}
+		}
+		data.Quota = quota
+	}
+
 	return data, nil
 }
@@ -27,19 +27,49 @@ func (v *Volume) teardownStorage() error {
 	}
 
 	// TODO: Should this be converted to use v.config.MountPoint?
-	return os.RemoveAll(filepath.Join(v.runtime.config.Engine.VolumePath, v.Name()))
+	volPathRoot := filepath.Join(v.runtime.config.Engine.VolumePath, v.Name())
+
+	// Clear the quota before the project ID of the volume is freed, so a
+	// later volume given the same project ID does not inherit its limits.
+	var quotaErr error
+	if v.hasQuota() {
+		q, err := v.runtime.getVolumeQuota()
+		if err == nil {
+			err = q.ClearQuota(volPathRoot)
+		}
+		if err != nil && !os.IsNotExist(errors.Cause(err)) {
+			quotaErr = errors.Wrapf(err, "error clearing quota of volume %s", v.Name())
+		}
+	}
+
+	if err := os.RemoveAll(volPathRoot); err != nil {
+		return err
+	}
+	return quotaErr
 }
 
-// Volumes with options set, or a filesystem type, or a device to mount need to
-// be mounted and unmounted.
+// Volumes with mount options set, or a filesystem type, or a device to mount
+// need to be mounted and unmounted.
 func (v *Volume) needsMount() bool {
 	// Non-local driver always needs mount
 	if v.UsesVolumeDriver() {
 		return true
 	}
 
-	// Local driver with options needs mount
-	return len(v.config.Options) > 0
+	// Local driver with options needs mount, except for quota options,
+	// which apply to the volume directory itself
+	for key := range v.config.Options {
+		if key != "size" && key != "inodes" {
+			return true
+		}
+	}
+	return false
+}
+
+// hasQuota returns whether the size or the number of inodes of the volume is
+// limited.
+func (v *Volume) hasQuota() bool {
+	return v.config.Size > 0 || v.config.Inodes > 0
 }
 
 // update() updates the volume state from the DB.
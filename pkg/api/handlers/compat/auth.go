@@ -0,0 +1,67 @@
+package compat
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	"github.com/containers/image/v5/docker"
+	"github.com/containers/image/v5/pkg/docker/config"
+	"github.com/containers/image/v5/types"
+	"github.com/containers/podman/v2/libpod"
+	"github.com/containers/podman/v2/pkg/api/handlers/utils"
+	"github.com/containers/podman/v2/pkg/registries"
+	docker2 "github.com/docker/docker/api/types"
+	dockerRegistry "github.com/docker/docker/api/types/registry"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+// Auth verifies the credentials against the registry and, if valid, stores
+// them in the auth file of the service, like `podman login` does.
+func Auth(w http.ResponseWriter, r *http.Request) {
+	runtime := r.Context().Value("runtime").(*libpod.Runtime)
+
+	var authConfig docker2.AuthConfig
+	if err := json.NewDecoder(r.Body).Decode(&authConfig); err != nil {
+		utils.Error(w, "Something went wrong.", http.StatusBadRequest, errors.Wrap(err, "Decode()"))
+		return
+	}
+	server := authServerName(authConfig.ServerAddress)
+
+	sysCtx := &types.SystemContext{SystemRegistriesConfPath: registries.SystemRegistriesConfPath()}
+	if sys := runtime.SystemContext(); sys != nil {
+		sysCtx.AuthFilePath = sys.AuthFilePath
+		sysCtx.DockerCertPath = sys.DockerCertPath
+	}
+
+	if err := docker.CheckAuth(r.Context(), sysCtx, authConfig.Username, authConfig.Password, server); err != nil {
+		if _, ok := err.(docker.ErrUnauthorizedForCredentials); ok {
+			logrus.Debugf("error logging into %q: %v", server, err)
+			utils.Error(w, "Something went wrong.", http.StatusUnauthorized, errors.Errorf("login attempt to %s failed: invalid username/password", server))
+			return
+		}
+		utils.Error(w, "Something went wrong.", http.StatusInternalServerError, errors.Wrapf(err, "error authenticating creds for %q", server))
+		return
+	}
+	if err := config.SetAuthentication(sysCtx, server, authConfig.Username, authConfig.Password); err != nil {
+		utils.InternalServerError(w, errors.Wrapf(err, "error storing credentials for %q", server))
+		return
+	}
+
+	utils.WriteResponse(w, http.StatusOK, dockerRegistry.AuthenticateOKBody{
+		Status: "Login Succeeded",
+	})
+}
+
+// authServerName returns the registry of the server address sent by Docker
+// clients, e.g. "https://index.docker.io/v1/" for Docker Hub.
+func authServerName(serverAddress string) string {
+	server := strings.TrimPrefix(strings.TrimPrefix(serverAddress, "https://"), "http://")
+	server = strings.Split(server, "/")[0]
+	switch server {
+	case "", "index.docker.io", "registry-1.docker.io":
+		return "docker.io"
+	}
+	return server
+}
@@ -0,0 +1,120 @@
+package compat
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/containers/podman/v2/libpod"
+	"github.com/containers/podman/v2/libpod/define"
+	"github.com/containers/podman/v2/pkg/api/handlers/utils"
+	"github.com/containers/podman/v2/pkg/util"
+	"github.com/docker/docker/api/types/container"
+	spec "github.com/opencontainers/runtime-spec/specs-go"
+	"github.com/pkg/errors"
+)
+
+func UpdateContainer(w http.ResponseWriter, r *http.Request) {
+	runtime := r.Context().Value("runtime").(*libpod.Runtime)
+
+	// /{version}/containers/(name)/update
+	name := utils.GetName(r)
+	ctr, err := runtime.LookupContainer(name)
+	if err != nil {
+		utils.ContainerNotFound(w, name, err)
+		return
+	}
+
+	var body container.UpdateConfig
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		utils.Error(w, "Something went wrong.", http.StatusBadRequest, errors.Wrap(err, "Decode()"))
+		return
+	}
+
+	var (
+		restartPolicy  *string
+		restartRetries *uint
+	)
+	if body.RestartPolicy.Name != "" {
+		restartPolicy = &body.RestartPolicy.Name
+	}
+	if body.RestartPolicy.MaximumRetryCount > 0 {
+		retries := uint(body.RestartPolicy.MaximumRetryCount)
+		restartRetries = &retries
+	}
+
+	if err := ctr.Update(updateConfigToResources(body.Resources), restartPolicy, restartRetries); err != nil {
+		if errors.Cause(err) == define.ErrInvalidArg {
+			utils.Error(w, "Something went wrong.", http.StatusBadRequest, err)
+			return
+		}
+		utils.InternalServerError(w, err)
+		return
+	}
+	utils.WriteResponse(w, http.StatusOK, container.ContainerUpdateOKBody{Warnings: []string{}})
+}
+
+// updateConfigToResources converts the resources of a Docker update request
+// to the resources of an OCI spec.  Zero values leave the respective limits
+// unchanged, nil is returned if no limit is changed.
+func updateConfigToResources(r container.Resources) *spec.LinuxResources {
+	var (
+		resources spec.LinuxResources
+		changed   bool
+	)
+
+	cpu := spec.LinuxCPU{
+		Cpus: r.CpusetCpus,
+		Mems: r.CpusetMems,
+	}
+	if r.CPUShares > 0 {
+		shares := uint64(r.CPUShares)
+		cpu.Shares = &shares
+	}
+	if r.NanoCPUs > 0 {
+		period, quota := util.CoresToPeriodAndQuota(float64(r.NanoCPUs) / 1e9)
+		cpu.Period = &period
+		cpu.Quota = &quota
+	}
+	if r.CPUPeriod > 0 {
+		period := uint64(r.CPUPeriod)
+		cpu.Period = &period
+	}
+	if r.CPUQuota != 0 {
+		cpu.Quota = &r.CPUQuota
+	}
+	if cpu.Shares != nil || cpu.Period != nil || cpu.Quota != nil || cpu.Cpus != "" || cpu.Mems != "" {
+		resources.CPU = &cpu
+		changed = true
+	}
+
+	memory := spec.LinuxMemory{}
+	if r.Memory > 0 {
+		memory.Limit = &r.Memory
+	}
+	if r.MemorySwap != 0 {
+		memory.Swap = &r.MemorySwap
+	}
+	if memory.Limit != nil || memory.Swap != nil {
+		resources.Memory = &memory
+		changed = true
+	}
+
+	if r.PidsLimit != nil {
+		limit := *r.PidsLimit
+		if limit <= 0 {
+			limit = -1
+		}
+		resources.Pids = &spec.LinuxPids{Limit: limit}
+		changed = true
+	}
+
+	if r.BlkioWeight > 0 {
+		resources.BlockIO = &spec.LinuxBlockIO{Weight: &r.BlkioWeight}
+		changed = true
+	}
+
+	if !changed {
+		return nil
+	}
+	return &resources
+}
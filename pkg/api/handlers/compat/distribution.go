@@ -0,0 +1,79 @@
+package compat
+
+import (
+	"net/http"
+
+	"github.com/containers/image/v5/docker"
+	"github.com/containers/image/v5/transports/alltransports"
+	"github.com/containers/image/v5/types"
+	"github.com/containers/podman/v2/libpod"
+	image2 "github.com/containers/podman/v2/libpod/image"
+	"github.com/containers/podman/v2/pkg/api/handlers/utils"
+	"github.com/containers/podman/v2/pkg/auth"
+	dockerRegistry "github.com/docker/docker/api/types/registry"
+	"github.com/gorilla/schema"
+	imgspecv1 "github.com/opencontainers/image-spec/specs-go/v1"
+	"github.com/pkg/errors"
+)
+
+// InspectDistribution returns the descriptor of the manifest of an image on
+// a registry and the platforms it supports, without pulling the image.
+func InspectDistribution(w http.ResponseWriter, r *http.Request) {
+	runtime := r.Context().Value("runtime").(*libpod.Runtime)
+	decoder := r.Context().Value("decoder").(*schema.Decoder)
+	name := utils.GetName(r)
+
+	query := struct {
+		TLSVerify bool `schema:"tlsVerify"`
+	}{
+		TLSVerify: true,
+	}
+	if err := decoder.Decode(&query, r.URL.Query()); err != nil {
+		utils.Error(w, "Something went wrong.", http.StatusBadRequest, errors.Wrapf(err, "failed to parse parameters for %s", r.URL.String()))
+		return
+	}
+
+	// Only images on registries can be inspected, other transports would
+	// give access to the file system of the server.
+	if ref, err := alltransports.ParseImageName(name); err == nil && ref.Transport().Name() != docker.Transport.Name() {
+		utils.Error(w, "Something went wrong.", http.StatusBadRequest, errors.Errorf("unsupported transport %q in %q: only images on registries can be inspected", ref.Transport().Name(), name))
+		return
+	}
+
+	authConf, authfile, key, err := auth.GetCredentials(r)
+	if err != nil {
+		utils.Error(w, "failed to retrieve repository credentials", http.StatusBadRequest, errors.Wrapf(err, "failed to parse %q header for %s", key, r.URL.String()))
+		return
+	}
+	defer auth.RemoveAuthfile(authfile)
+
+	registryOpts := image2.DockerRegistryOptions{DockerRegistryCreds: authConf}
+	if sys := runtime.SystemContext(); sys != nil {
+		registryOpts.DockerCertPath = sys.DockerCertPath
+	}
+	if _, found := r.URL.Query()["tlsVerify"]; found {
+		registryOpts.DockerInsecureSkipTLSVerify = types.NewOptionalBool(!query.TLSVerify)
+	}
+	data, err := image2.InspectRemotePlatforms(r.Context(), name, authfile, &registryOpts)
+	if err != nil {
+		if _, ok := errors.Cause(err).(docker.ErrUnauthorizedForCredentials); ok {
+			utils.Error(w, "Something went wrong.", http.StatusUnauthorized, err)
+			return
+		}
+		utils.Error(w, "Something went wrong.", http.StatusInternalServerError, err)
+		return
+	}
+
+	report := dockerRegistry.DistributionInspect{
+		Descriptor: imgspecv1.Descriptor{
+			MediaType: data.MediaType,
+			Digest:    data.Digest,
+			Size:      data.Size,
+		},
+		Platforms: []imgspecv1.Platform{},
+	}
+	for _, m := range data.Manifests {
+		report.Platforms = append(report.Platforms, m.Platform)
+	}
+	utils.WriteResponse(w, http.StatusOK, report)
+}
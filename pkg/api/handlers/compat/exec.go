@@ -124,6 +124,12 @@ func ExecInspectHandler(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if session.RemoveOnInspect {
+		if err := sessionCtr.ExecRemove(sessionID, false); err != nil && errors.Cause(err) != define.ErrNoSuchExecSession {
+			logrus.Errorf("Error removing container %s exec session %s: %v", sessionCtr.ID(), sessionID, err)
+		}
+	}
+
 	utils.WriteResponse(w, http.StatusOK, inspectOut)
 }
 
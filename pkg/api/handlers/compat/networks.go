@@ -335,6 +335,7 @@ func Connect(w http.ResponseWriter, r *http.Request) {
 	var (
 		aliases    []string
 		netConnect types.NetworkConnect
+		netOptions libpod.ContainerNetworkOptions
 	)
 	if err := json.NewDecoder(r.Body).Decode(&netConnect); err != nil {
 		utils.Error(w, "Something went wrong.", http.StatusInternalServerError, errors.Wrap(err, "Decode()"))
@@ -345,8 +346,23 @@ func Connect(w http.ResponseWriter, r *http.Request) {
 		if netConnect.EndpointConfig.Aliases != nil {
 			aliases = netConnect.EndpointConfig.Aliases
 		}
+		if ipam := netConnect.EndpointConfig.IPAMConfig; ipam != nil && ipam.IPv4Address != "" {
+			netOptions.StaticIP = net.ParseIP(ipam.IPv4Address)
+			if netOptions.StaticIP == nil {
+				utils.Error(w, "Something went wrong.", http.StatusBadRequest, errors.Errorf("invalid IP address %q", ipam.IPv4Address))
+				return
+			}
+		}
+		if netConnect.EndpointConfig.MacAddress != "" {
+			mac, err := net.ParseMAC(netConnect.EndpointConfig.MacAddress)
+			if err != nil {
+				utils.Error(w, "Something went wrong.", http.StatusBadRequest, err)
+				return
+			}
+			netOptions.StaticMAC = mac
+		}
 	}
-	err := runtime.ConnectContainerToNetwork(netConnect.Container, name, aliases)
+	err := runtime.ConnectContainerToNetwork(netConnect.Container, name, aliases, netOptions)
 	if err != nil {
 		if errors.Cause(err) == define.ErrNoSuchCtr {
 			utils.ContainerNotFound(w, netConnect.Container, err)
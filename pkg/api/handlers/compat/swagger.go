@@ -4,6 +4,8 @@ import (
 	"github.com/containers/podman/v2/pkg/domain/entities"
 	"github.com/containers/storage/pkg/archive"
 	"github.com/docker/docker/api/types"
+	"github.com/docker/docker/api/types/container"
+	"github.com/docker/docker/api/types/registry"
 )
 
 // Create container
@@ -28,6 +30,19 @@ type swagCtrWaitResponse struct {
 	}
 }
 
+// Update container
+// swagger:response ContainerUpdateResponse
+type swagCtrUpdateResponse struct {
+	// in:body
+	Body container.ContainerUpdateOKBody
+}
+
+// Container update configuration
+// swagger:model ContainerUpdateConfig
+type ContainerUpdateConfig struct {
+	container.UpdateConfig
+}
+
 // Object Changes
 // swagger:response Changes
 type swagChangesResponse struct {
@@ -37,6 +52,26 @@ type swagChangesResponse struct {
 	}
 }
 
+// Auth
+// swagger:response SystemAuthResponse
+type swagSystemAuthResponse struct {
+	// in:body
+	Body registry.AuthenticateOKBody
+}
+
+// Auth configuration
+// swagger:model AuthConfig
+type AuthConfig struct {
+	types.AuthConfig
+}
+
+// Distribution inspect
+// swagger:response DistributionInspect
+type swagDistributionInspect struct {
+	// in:body
+	Body registry.DistributionInspect
+}
+
 // Network inspect
 // swagger:response CompatNetworkInspect
 type swagCompatNetworkInspect struct {
@@ -0,0 +1,43 @@
+package libpod
+
+import (
+	"net/http"
+
+	"github.com/containers/podman/v2/libpod"
+	"github.com/containers/podman/v2/pkg/api/handlers/utils"
+	"github.com/gorilla/schema"
+	"github.com/pkg/errors"
+)
+
+// Changes reports the changes of a container or an image, optionally
+// against another image or container and with the details of each path.
+func Changes(w http.ResponseWriter, r *http.Request) {
+	decoder := r.Context().Value("decoder").(*schema.Decoder)
+	runtime := r.Context().Value("runtime").(*libpod.Runtime)
+
+	query := struct {
+		Parent string `schema:"parent"`
+		Detail bool   `schema:"detail"`
+	}{}
+	if err := decoder.Decode(&query, r.URL.Query()); err != nil {
+		utils.Error(w, "Bad Request.", http.StatusBadRequest, errors.Wrapf(err, "failed to parse parameters for %s", r.URL.String()))
+		return
+	}
+
+	id := utils.GetName(r)
+	if query.Detail {
+		details, err := runtime.GetDiffDetails(query.Parent, id)
+		if err != nil {
+			utils.InternalServerError(w, err)
+			return
+		}
+		utils.WriteJSON(w, 200, details)
+		return
+	}
+	changes, err := runtime.GetDiff(query.Parent, id)
+	if err != nil {
+		utils.InternalServerError(w, err)
+		return
+	}
+	utils.WriteJSON(w, 200, changes)
+}
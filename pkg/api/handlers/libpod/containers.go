@@ -1,6 +1,8 @@
 package libpod
 
 import (
+	"encoding/json"
+	"io"
 	"io/ioutil"
 	"net/http"
 	"os"
@@ -14,6 +16,7 @@ import (
 	"github.com/containers/podman/v2/pkg/domain/infra/abi"
 	"github.com/containers/podman/v2/pkg/ps"
 	"github.com/gorilla/schema"
+	spec "github.com/opencontainers/runtime-spec/specs-go"
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
 )
@@ -203,11 +206,12 @@ func Checkpoint(w http.ResponseWriter, r *http.Request) {
 	var targetFile string
 	decoder := r.Context().Value("decoder").(*schema.Decoder)
 	query := struct {
-		Keep           bool `schema:"keep"`
-		LeaveRunning   bool `schema:"leaveRunning"`
-		TCPEstablished bool `schema:"tcpEstablished"`
-		Export         bool `schema:"export"`
-		IgnoreRootFS   bool `schema:"ignoreRootFS"`
+		Keep           bool   `schema:"keep"`
+		LeaveRunning   bool   `schema:"leaveRunning"`
+		TCPEstablished bool   `schema:"tcpEstablished"`
+		Export         bool   `schema:"export"`
+		IgnoreRootFS   bool   `schema:"ignoreRootFS"`
+		CreateImage    string `schema:"createImage"`
 	}{
 		// override any golang type defaults
 	}
@@ -242,6 +246,7 @@ func Checkpoint(w http.ResponseWriter, r *http.Request) {
 		KeepRunning:    query.LeaveRunning,
 		TCPEstablished: query.TCPEstablished,
 		IgnoreRootfs:   query.IgnoreRootFS,
+		CreateImage:    query.CreateImage,
 	}
 	if query.Export {
 		options.TargetFile = targetFile
@@ -290,7 +295,45 @@ func Restore(w http.ResponseWriter, r *http.Request) {
 	runtime := r.Context().Value("runtime").(*libpod.Runtime)
 	ctr, err := runtime.LookupContainer(name)
 	if err != nil {
-		utils.ContainerNotFound(w, name, err)
+		if errors.Cause(err) != define.ErrNoSuchCtr {
+			utils.InternalServerError(w, err)
+			return
+		}
+		// The name may refer to a checkpoint image instead
+		containerEngine := abi.ContainerEngine{Libpod: runtime}
+		restoreOptions := entities.RestoreOptions{
+			Keep:            query.Keep,
+			TCPEstablished:  query.TCPEstablished,
+			Name:            query.Name,
+			IgnoreRootFS:    query.IgnoreRootFS,
+			IgnoreVolumes:   query.IgnoreVolumes,
+			IgnoreStaticIP:  query.IgnoreStaticIP,
+			IgnoreStaticMAC: query.IgnoreStaticMAC,
+		}
+		reports, imgErr := containerEngine.ContainerRestore(r.Context(), []string{name}, restoreOptions)
+		if imgErr != nil {
+			// Neither a container nor a checkpoint image
+			if errors.Cause(imgErr) == define.ErrNoSuchCtr {
+				utils.ContainerNotFound(w, name, imgErr)
+				return
+			}
+			utils.InternalServerError(w, imgErr)
+			return
+		}
+		if len(reports) == 0 {
+			utils.ContainerNotFound(w, name, err)
+			return
+		}
+		if reports[0].Err != nil {
+			utils.InternalServerError(w, reports[0].Err)
+			return
+		}
+		utils.WriteResponse(w, http.StatusOK, entities.RestoreReport{Id: reports[0].Id})
+		return
+	}
+	if !query.Import && (query.Name != "" || query.IgnoreVolumes) {
+		utils.Error(w, http.StatusText(http.StatusBadRequest), http.StatusBadRequest,
+			errors.Errorf("name and ignoreVolumes can only be used with import or a checkpoint image"))
 		return
 	}
 	if query.Import {
@@ -317,6 +360,7 @@ func Restore(w http.ResponseWriter, r *http.Request) {
 	if query.Import {
 		options.TargetFile = targetFile
 		options.Name = query.Name
+		options.IgnoreVolumes = query.IgnoreVolumes
 	}
 	err = ctr.Restore(r.Context(), options)
 	if err != nil {
@@ -369,3 +413,40 @@ func ShouldRestart(w http.ResponseWriter, r *http.Request) {
 		utils.ContainerNotFound(w, name, define.ErrNoSuchCtr)
 	}
 }
+
+func UpdateContainer(w http.ResponseWriter, r *http.Request) {
+	runtime := r.Context().Value("runtime").(*libpod.Runtime)
+	decoder := r.Context().Value("decoder").(*schema.Decoder)
+	query := struct {
+		RestartPolicy  *string `schema:"restartPolicy"`
+		RestartRetries *uint   `schema:"restartRetries"`
+	}{}
+	if err := decoder.Decode(&query, r.URL.Query()); err != nil {
+		utils.Error(w, "Something went wrong.", http.StatusBadRequest, errors.Wrapf(err, "failed to parse parameters for %s", r.URL.String()))
+		return
+	}
+
+	name := utils.GetName(r)
+	ctr, err := runtime.LookupContainer(name)
+	if err != nil {
+		utils.ContainerNotFound(w, name, err)
+		return
+	}
+
+	// The resources are optional, the restart policy can be changed alone.
+	var resources *spec.LinuxResources
+	if err := json.NewDecoder(r.Body).Decode(&resources); err != nil && err != io.EOF {
+		utils.Error(w, "Something went wrong.", http.StatusBadRequest, errors.Wrap(err, "Decode()"))
+		return
+	}
+
+	if err := ctr.Update(resources, query.RestartPolicy, query.RestartRetries); err != nil {
+		if errors.Cause(err) == define.ErrInvalidArg {
+			utils.Error(w, "Something went wrong.", http.StatusBadRequest, err)
+			return
+		}
+		utils.InternalServerError(w, err)
+		return
+	}
+	utils.WriteResponse(w, http.StatusNoContent, "")
+}
@@ -23,8 +23,10 @@ func StatsContainer(w http.ResponseWriter, r *http.Request) {
 	query := struct {
 		Containers []string `schema:"containers"`
 		Stream     bool     `schema:"stream"`
+		Interval   int      `schema:"interval"`
 	}{
-		Stream: true,
+		Stream:   true,
+		Interval: 1,
 	}
 	if err := decoder.Decode(&query, r.URL.Query()); err != nil {
 		utils.Error(w, "Something went wrong.", http.StatusBadRequest, errors.Wrapf(err, "failed to parse parameters for %s", r.URL.String()))
@@ -35,8 +37,14 @@ func StatsContainer(w http.ResponseWriter, r *http.Request) {
 	// container stats.
 	containerEngine := abi.ContainerEngine{Libpod: runtime}
 
+	if query.Interval < 1 {
+		utils.Error(w, "Something went wrong.", http.StatusBadRequest, errors.Errorf("invalid interval %d, must be at least 1 second", query.Interval))
+		return
+	}
+
 	statsOptions := entities.ContainerStatsOptions{
-		Stream: query.Stream,
+		Stream:   query.Stream,
+		Interval: time.Duration(query.Interval) * time.Second,
 	}
 
 	// Stats will stop if the connection is closed.
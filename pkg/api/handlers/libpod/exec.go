@@ -0,0 +1,109 @@
+package libpod
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/containers/podman/v2/libpod"
+	"github.com/containers/podman/v2/libpod/define"
+	"github.com/containers/podman/v2/pkg/api/handlers/utils"
+	"github.com/containers/podman/v2/pkg/api/server/idle"
+	"github.com/containers/podman/v2/pkg/domain/entities"
+	"github.com/containers/podman/v2/pkg/domain/infra/abi"
+	"github.com/gorilla/mux"
+	"github.com/gorilla/schema"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+// ExecSessions lists the exec sessions of a container.
+func ExecSessions(w http.ResponseWriter, r *http.Request) {
+	runtime := r.Context().Value("runtime").(*libpod.Runtime)
+	containerEngine := abi.ContainerEngine{Libpod: runtime}
+
+	name := utils.GetName(r)
+	reports, err := containerEngine.ContainerExecSessions(r.Context(), name, entities.ContainerExecSessionsOptions{})
+	if err != nil {
+		if errors.Cause(err) == define.ErrNoSuchCtr {
+			utils.ContainerNotFound(w, name, err)
+			return
+		}
+		utils.InternalServerError(w, err)
+		return
+	}
+	utils.WriteResponse(w, http.StatusOK, reports)
+}
+
+// ExecAttach attaches to a running exec session.
+func ExecAttach(w http.ResponseWriter, r *http.Request) {
+	runtime := r.Context().Value("runtime").(*libpod.Runtime)
+	decoder := r.Context().Value("decoder").(*schema.Decoder)
+
+	query := struct {
+		DetachKeys string `schema:"detachKeys"`
+		RmSession  bool   `schema:"rmSession"`
+		Stdin      bool   `schema:"stdin"`
+		Stdout     bool   `schema:"stdout"`
+		Stderr     bool   `schema:"stderr"`
+	}{
+		Stdin:  true,
+		Stdout: true,
+		Stderr: true,
+	}
+	if err := decoder.Decode(&query, r.URL.Query()); err != nil {
+		utils.Error(w, http.StatusText(http.StatusBadRequest), http.StatusBadRequest,
+			errors.Wrapf(err, "failed to parse parameters for %s", r.URL.String()))
+		return
+	}
+
+	sessionID := mux.Vars(r)["id"]
+	sessionCtr, err := runtime.GetExecSessionContainer(sessionID)
+	if err != nil {
+		utils.Error(w, fmt.Sprintf("No such exec session: %s", sessionID), http.StatusNotFound, err)
+		return
+	}
+
+	var detachKeys *string
+	if _, found := r.URL.Query()["detachKeys"]; found {
+		detachKeys = &query.DetachKeys
+	}
+	streams := &libpod.HTTPAttachStreams{
+		Stdin:  query.Stdin,
+		Stdout: query.Stdout,
+		Stderr: query.Stderr,
+	}
+
+	logrus.Debugf("Attaching to exec session %s of container %s", sessionID, sessionCtr.ID())
+
+	hijackChan := make(chan bool, 1)
+	err = sessionCtr.ExecHTTPAttach(sessionID, r, w, streams, detachKeys, nil, hijackChan)
+
+	if <-hijackChan {
+		// If connection was Hijacked, we have to signal it's being closed
+		t := r.Context().Value("idletracker").(*idle.Tracker)
+		defer t.Close()
+
+		if err != nil {
+			// Cannot report error to client as a 500 as the Upgrade set status to 101
+			logrus.Error(errors.Wrapf(err, "error attaching to container %s exec session %s", sessionCtr.ID(), sessionID))
+			return
+		}
+	} else {
+		// If the Hijack failed we are going to assume we can still inform client of failure
+		if errors.Cause(err) == define.ErrExecSessionStateInvalid {
+			utils.Error(w, http.StatusText(http.StatusConflict), http.StatusConflict, err)
+			return
+		}
+		utils.InternalServerError(w, err)
+		return
+	}
+
+	if query.RmSession {
+		// The client inspects the session for its exit code after
+		// attaching, so remove it with that.
+		if err := sessionCtr.ExecRemoveOnInspect(sessionID); err != nil {
+			logrus.Errorf("Error removing container %s exec session %s: %v", sessionCtr.ID(), sessionID, err)
+		}
+	}
+	logrus.Debugf("Attach for container %s exec session %s completed successfully", sessionCtr.ID(), sessionID)
+}
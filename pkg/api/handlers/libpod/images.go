@@ -9,6 +9,7 @@ import (
 	"os"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/containers/buildah"
 	"github.com/containers/image/v5/manifest"
@@ -20,6 +21,7 @@ import (
 	"github.com/containers/podman/v2/pkg/api/handlers/utils"
 	"github.com/containers/podman/v2/pkg/auth"
 	"github.com/containers/podman/v2/pkg/domain/entities"
+	"github.com/containers/podman/v2/pkg/domain/entities/reports"
 	"github.com/containers/podman/v2/pkg/domain/infra/abi"
 	"github.com/containers/podman/v2/pkg/errorhandling"
 	utils2 "github.com/containers/podman/v2/utils"
@@ -124,11 +126,20 @@ func PruneImages(w http.ResponseWriter, r *http.Request) {
 	)
 	runtime := r.Context().Value("runtime").(*libpod.Runtime)
 	decoder := r.Context().Value("decoder").(*schema.Decoder)
+	policy := image.DefaultGCPolicy
 	query := struct {
-		All     bool                `schema:"all"`
-		Filters map[string][]string `schema:"filters"`
+		All             bool                `schema:"all"`
+		DryRun          bool                `schema:"dryrun"`
+		Filters         map[string][]string `schema:"filters"`
+		GC              bool                `schema:"gc"`
+		GCHighThreshold uint                `schema:"gchighthreshold"`
+		GCLowThreshold  uint                `schema:"gclowthreshold"`
+		GCMinAge        string              `schema:"gcminage"`
 	}{
 		// override any golang type defaults
+		GCHighThreshold: policy.HighThreshold,
+		GCLowThreshold:  policy.LowThreshold,
+		GCMinAge:        policy.MinAge.String(),
 	}
 
 	if err := decoder.Decode(&query, r.URL.Query()); err != nil {
@@ -154,7 +165,24 @@ func PruneImages(w http.ResponseWriter, r *http.Request) {
 		}
 	}
 
-	imagePruneReports, err := runtime.ImageRuntime().PruneImages(r.Context(), query.All, libpodFilters)
+	var imagePruneReports []*reports.PruneReport
+	if query.GC {
+		policy.HighThreshold = query.GCHighThreshold
+		policy.LowThreshold = query.GCLowThreshold
+		policy.MinAge, err = time.ParseDuration(query.GCMinAge)
+		if err != nil {
+			utils.Error(w, http.StatusText(http.StatusBadRequest), http.StatusBadRequest,
+				errors.Wrapf(err, "invalid gcminage %q", query.GCMinAge))
+			return
+		}
+		if err := policy.Validate(); err != nil {
+			utils.Error(w, http.StatusText(http.StatusBadRequest), http.StatusBadRequest, err)
+			return
+		}
+		imagePruneReports, err = runtime.ImageRuntime().GarbageCollect(r.Context(), policy, query.DryRun)
+	} else {
+		imagePruneReports, err = runtime.ImageRuntime().PruneImages(r.Context(), query.All, query.DryRun, libpodFilters)
+	}
 	if err != nil {
 		utils.Error(w, "Something went wrong.", http.StatusInternalServerError, err)
 		return
@@ -483,6 +511,8 @@ func CommitContainer(w http.ResponseWriter, r *http.Request) {
 		Format    string   `schema:"format"`
 		Pause     bool     `schema:"pause"`
 		Repo      string   `schema:"repo"`
+		Squash    bool     `schema:"squash"`
+		SquashNew string   `schema:"squashNew"`
 		Tag       string   `schema:"tag"`
 	}{
 		Format: "oci",
@@ -520,6 +550,7 @@ func CommitContainer(w http.ResponseWriter, r *http.Request) {
 		ReportWriter:          os.Stderr,
 		SystemContext:         sc,
 		PreferredManifestType: mimeType,
+		Squash:                query.Squash,
 	}
 
 	if len(query.Tag) > 0 {
@@ -529,6 +560,7 @@ func CommitContainer(w http.ResponseWriter, r *http.Request) {
 	options.Author = query.Author
 	options.Pause = query.Pause
 	options.Changes = query.Changes
+	options.SquashBase = query.SquashNew
 	ctr, err := runtime.LookupContainer(query.Container)
 	if err != nil {
 		utils.Error(w, "failed to lookup container", http.StatusNotFound, err)
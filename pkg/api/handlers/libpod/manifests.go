@@ -8,12 +8,14 @@ import (
 	"github.com/containers/image/v5/manifest"
 	"github.com/containers/image/v5/types"
 	"github.com/containers/podman/v2/libpod"
+	"github.com/containers/podman/v2/libpod/define"
 	"github.com/containers/podman/v2/libpod/image"
 	"github.com/containers/podman/v2/pkg/api/handlers"
 	"github.com/containers/podman/v2/pkg/api/handlers/utils"
 	"github.com/containers/podman/v2/pkg/auth"
 	"github.com/containers/podman/v2/pkg/domain/entities"
 	"github.com/containers/podman/v2/pkg/domain/infra/abi"
+	"github.com/containers/podman/v2/pkg/errorhandling"
 	"github.com/gorilla/schema"
 	"github.com/opencontainers/go-digest"
 	"github.com/pkg/errors"
@@ -48,6 +50,23 @@ func ManifestCreate(w http.ResponseWriter, r *http.Request) {
 	utils.WriteResponse(w, http.StatusOK, handlers.IDResponse{ID: manID})
 }
 
+func ManifestExists(w http.ResponseWriter, r *http.Request) {
+	runtime := r.Context().Value("runtime").(*libpod.Runtime)
+	name := utils.GetName(r)
+
+	imageEngine := abi.ImageEngine{Libpod: runtime}
+	report, err := imageEngine.ManifestExists(r.Context(), name)
+	if err != nil {
+		utils.InternalServerError(w, err)
+		return
+	}
+	if !report.Value {
+		utils.Error(w, "Something went wrong.", http.StatusNotFound, errors.Wrapf(define.ErrNoSuchManifestList, "%s", name))
+		return
+	}
+	utils.WriteResponse(w, http.StatusNoContent, "")
+}
+
 func ManifestInspect(w http.ResponseWriter, r *http.Request) {
 	runtime := r.Context().Value("runtime").(*libpod.Runtime)
 	name := utils.GetName(r)
@@ -96,6 +115,7 @@ func ManifestRemove(w http.ResponseWriter, r *http.Request) {
 	runtime := r.Context().Value("runtime").(*libpod.Runtime)
 	decoder := r.Context().Value("decoder").(*schema.Decoder)
 	query := struct {
+		All    bool   `schema:"all"`
 		Digest string `schema:"digest"`
 	}{
 		// Add defaults here once needed.
@@ -106,6 +126,15 @@ func ManifestRemove(w http.ResponseWriter, r *http.Request) {
 			errors.Wrapf(err, "failed to parse parameters for %s", r.URL.String()))
 		return
 	}
+	if query.All {
+		if query.Digest != "" {
+			utils.Error(w, http.StatusText(http.StatusBadRequest), http.StatusBadRequest,
+				errors.New("the all and digest parameters cannot be combined"))
+			return
+		}
+		manifestDelete(w, r, runtime, name)
+		return
+	}
 	newImage, err := runtime.ImageRuntime().NewFromLocal(name)
 	if err != nil {
 		utils.ImageNotFound(w, name, err)
@@ -123,6 +152,24 @@ func ManifestRemove(w http.ResponseWriter, r *http.Request) {
 	}
 	utils.WriteResponse(w, http.StatusOK, handlers.IDResponse{ID: newID})
 }
+
+// manifestDelete removes the manifest list from local storage, leaving the
+// images it references in place.
+func manifestDelete(w http.ResponseWriter, r *http.Request, runtime *libpod.Runtime, name string) {
+	imageEngine := abi.ImageEngine{Libpod: runtime}
+	rmReport, rmErrors := imageEngine.ManifestRm(r.Context(), []string{name})
+	switch rmReport.ExitCode {
+	case 0:
+		report := handlers.LibpodImagesRemoveReport{ImageRemoveReport: *rmReport, Errors: []string{}}
+		utils.WriteResponse(w, http.StatusOK, report)
+	case 1:
+		// 404 - no such manifest list
+		utils.Error(w, "error removing manifest list", http.StatusNotFound, errorhandling.JoinErrors(rmErrors))
+	default:
+		utils.Error(w, "error removing manifest list", http.StatusInternalServerError, errorhandling.JoinErrors(rmErrors))
+	}
+}
+
 func ManifestPush(w http.ResponseWriter, r *http.Request) {
 	runtime := r.Context().Value("runtime").(*libpod.Runtime)
 	decoder := r.Context().Value("decoder").(*schema.Decoder)
@@ -130,6 +177,7 @@ func ManifestPush(w http.ResponseWriter, r *http.Request) {
 		All         bool   `schema:"all"`
 		Destination string `schema:"destination"`
 		Format      string `schema:"format"`
+		Rm          bool   `schema:"rm"`
 		TLSVerify   bool   `schema:"tlsVerify"`
 	}{
 		// Add defaults here once needed.
@@ -164,6 +212,7 @@ func ManifestPush(w http.ResponseWriter, r *http.Request) {
 		Password: password,
 		Format:   query.Format,
 		All:      query.All,
+		Rm:       query.Rm,
 	}
 	if sys := runtime.SystemContext(); sys != nil {
 		options.CertDir = sys.DockerCertPath
@@ -132,6 +132,23 @@ func InspectNetwork(w http.ResponseWriter, r *http.Request) {
 	utils.WriteResponse(w, http.StatusOK, reports)
 }
 
+// ExistsNetwork checks if a network exists
+func ExistsNetwork(w http.ResponseWriter, r *http.Request) {
+	runtime := r.Context().Value("runtime").(*libpod.Runtime)
+	name := utils.GetName(r)
+	ic := abi.ContainerEngine{Libpod: runtime}
+	report, err := ic.NetworkExists(r.Context(), name)
+	if err != nil {
+		utils.InternalServerError(w, err)
+		return
+	}
+	if !report.Value {
+		utils.NetworkNotFound(w, name, define.ErrNoSuchNetwork)
+		return
+	}
+	utils.WriteResponse(w, http.StatusNoContent, "")
+}
+
 // Connect adds a container to a network
 func Connect(w http.ResponseWriter, r *http.Request) {
 	runtime := r.Context().Value("runtime").(*libpod.Runtime)
@@ -142,7 +159,11 @@ func Connect(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 	name := utils.GetName(r)
-	err := runtime.ConnectContainerToNetwork(netConnect.Container, name, netConnect.Aliases)
+	netOptions := libpod.ContainerNetworkOptions{
+		StaticIP:  netConnect.StaticIP,
+		StaticMAC: netConnect.StaticMAC,
+	}
+	err := runtime.ConnectContainerToNetwork(netConnect.Container, name, netConnect.Aliases, netOptions)
 	if err != nil {
 		if errors.Cause(err) == define.ErrNoSuchCtr {
 			utils.ContainerNotFound(w, netConnect.Container, err)
@@ -157,3 +178,25 @@ func Connect(w http.ResponseWriter, r *http.Request) {
 	}
 	utils.WriteResponse(w, http.StatusOK, "OK")
 }
+
+// UpdateNetwork changes the DNS servers and labels of a network
+func UpdateNetwork(w http.ResponseWriter, r *http.Request) {
+	runtime := r.Context().Value("runtime").(*libpod.Runtime)
+	ic := abi.ContainerEngine{Libpod: runtime}
+
+	var options entities.NetworkUpdateOptions
+	if err := json.NewDecoder(r.Body).Decode(&options); err != nil {
+		utils.Error(w, "Something went wrong.", http.StatusInternalServerError, errors.Wrap(err, "Decode()"))
+		return
+	}
+	name := utils.GetName(r)
+	if err := ic.NetworkUpdate(r.Context(), name, options); err != nil {
+		if errors.Cause(err) == define.ErrNoSuchNetwork {
+			utils.Error(w, "network not found", http.StatusNotFound, err)
+			return
+		}
+		utils.Error(w, "Something went wrong.", http.StatusInternalServerError, err)
+		return
+	}
+	utils.WriteResponse(w, http.StatusOK, "OK")
+}
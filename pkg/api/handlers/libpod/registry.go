@@ -0,0 +1,143 @@
+package libpod
+
+import (
+	"io"
+	"net/http"
+	"strconv"
+
+	"github.com/containers/podman/v2/libpod"
+	"github.com/containers/podman/v2/libpod/define"
+	"github.com/containers/podman/v2/pkg/api/handlers/utils"
+	"github.com/gorilla/mux"
+	"github.com/opencontainers/go-digest"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+// registryAPIVersion is announced in the responses of the registry API to
+// let clients know that the endpoint implements the distribution API.
+const registryAPIVersion = "registry/2.0"
+
+// registryErrors is the error body of the registry API.
+type registryErrors struct {
+	Errors []registryError `json:"errors"`
+}
+
+type registryError struct {
+	Code    string `json:"code"`
+	Message string `json:"message"`
+}
+
+func registryErrorResponse(w http.ResponseWriter, status int, code string, err error) {
+	logrus.Infof("Registry request failed: %s: %v", code, err)
+	w.Header().Set("Docker-Distribution-API-Version", registryAPIVersion)
+	utils.WriteJSON(w, status, registryErrors{
+		Errors: []registryError{{Code: code, Message: err.Error()}},
+	})
+}
+
+// RegistryPing answers the version check of registry clients.
+func RegistryPing(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Docker-Distribution-API-Version", registryAPIVersion)
+	utils.WriteJSON(w, http.StatusOK, struct{}{})
+}
+
+// RegistryUnsupported rejects all requests that would modify the registry.
+func RegistryUnsupported(w http.ResponseWriter, r *http.Request) {
+	registryErrorResponse(w, http.StatusMethodNotAllowed, "UNSUPPORTED", errors.New("the registry is read-only"))
+}
+
+// RegistryTags lists the tags of a repository in local storage.
+func RegistryTags(w http.ResponseWriter, r *http.Request) {
+	runtime := r.Context().Value("runtime").(*libpod.Runtime)
+	name := utils.GetName(r)
+
+	tags, err := runtime.ImageRuntime().DistributionTags(name)
+	if err != nil {
+		if errors.Cause(err) == define.ErrNoSuchImage {
+			registryErrorResponse(w, http.StatusNotFound, "NAME_UNKNOWN", err)
+			return
+		}
+		registryErrorResponse(w, http.StatusInternalServerError, "UNKNOWN", err)
+		return
+	}
+	w.Header().Set("Docker-Distribution-API-Version", registryAPIVersion)
+	utils.WriteJSON(w, http.StatusOK, struct {
+		Name string   `json:"name"`
+		Tags []string `json:"tags"`
+	}{
+		Name: name,
+		Tags: tags,
+	})
+}
+
+// RegistryManifest serves the manifest of an image in local storage.
+func RegistryManifest(w http.ResponseWriter, r *http.Request) {
+	runtime := r.Context().Value("runtime").(*libpod.Runtime)
+	name := utils.GetName(r)
+	reference := mux.Vars(r)["reference"]
+
+	img, err := runtime.ImageRuntime().DistributionImage(r.Context(), name, reference)
+	if err != nil {
+		if errors.Cause(err) == define.ErrNoSuchImage {
+			registryErrorResponse(w, http.StatusNotFound, "MANIFEST_UNKNOWN", err)
+			return
+		}
+		registryErrorResponse(w, http.StatusInternalServerError, "UNKNOWN", err)
+		return
+	}
+	manifestBytes, manifestType, err := img.DistributionManifest(r.Context())
+	if err != nil {
+		// Let clients fall back to the next mirror or the registry
+		// itself.
+		registryErrorResponse(w, http.StatusNotFound, "MANIFEST_UNKNOWN", err)
+		return
+	}
+
+	w.Header().Set("Docker-Distribution-API-Version", registryAPIVersion)
+	w.Header().Set("Docker-Content-Digest", digest.FromBytes(manifestBytes).String())
+	w.Header().Set("Content-Type", manifestType)
+	w.Header().Set("Content-Length", strconv.Itoa(len(manifestBytes)))
+	w.WriteHeader(http.StatusOK)
+	if r.Method == http.MethodHead {
+		return
+	}
+	if _, err := w.Write(manifestBytes); err != nil {
+		logrus.Errorf("Failed to write manifest of image %s: %v", img.ID(), err)
+	}
+}
+
+// RegistryBlob serves an image config or an uncompressed layer of an image of
+// the requested repository from local storage.
+func RegistryBlob(w http.ResponseWriter, r *http.Request) {
+	runtime := r.Context().Value("runtime").(*libpod.Runtime)
+	name := utils.GetName(r)
+
+	d, err := digest.Parse(mux.Vars(r)["digest"])
+	if err != nil {
+		registryErrorResponse(w, http.StatusBadRequest, "DIGEST_INVALID", err)
+		return
+	}
+	rc, size, err := runtime.ImageRuntime().DistributionBlob(name, d)
+	if err != nil {
+		if errors.Cause(err) == define.ErrNoSuchBlob {
+			registryErrorResponse(w, http.StatusNotFound, "BLOB_UNKNOWN", err)
+			return
+		}
+		registryErrorResponse(w, http.StatusInternalServerError, "UNKNOWN", err)
+		return
+	}
+	defer rc.Close()
+
+	w.Header().Set("Docker-Distribution-API-Version", registryAPIVersion)
+	w.Header().Set("Docker-Content-Digest", d.String())
+	w.Header().Set("Content-Type", "application/octet-stream")
+	w.Header().Set("Content-Length", strconv.FormatInt(size, 10))
+	w.WriteHeader(http.StatusOK)
+	if r.Method == http.MethodHead {
+		return
+	}
+	if _, err := io.Copy(w, rc); err != nil {
+		logrus.Errorf("Failed to write blob %s: %v", d, err)
+	}
+}
@@ -45,7 +45,7 @@ func SystemPrune(w http.ResponseWriter, r *http.Request) {
 	}
 	systemPruneReport.ContainerPruneReports = containerPruneReports
 
-	imagePruneReports, err := runtime.ImageRuntime().PruneImages(r.Context(), query.All, nil)
+	imagePruneReports, err := runtime.ImageRuntime().PruneImages(r.Context(), query.All, false, nil)
 	if err != nil {
 		utils.InternalServerError(w, err)
 		return
@@ -92,6 +92,57 @@ func InspectVolume(w http.ResponseWriter, r *http.Request) {
 	utils.WriteResponse(w, http.StatusOK, volResponse)
 }
 
+func ExistsVolume(w http.ResponseWriter, r *http.Request) {
+	var (
+		runtime = r.Context().Value("runtime").(*libpod.Runtime)
+	)
+	name := utils.GetName(r)
+	exists, err := runtime.HasVolume(name)
+	if err != nil {
+		utils.InternalServerError(w, err)
+		return
+	}
+	if !exists {
+		utils.VolumeNotFound(w, name, define.ErrNoSuchVolume)
+		return
+	}
+	utils.WriteResponse(w, http.StatusNoContent, "")
+}
+
+func ExportVolume(w http.ResponseWriter, r *http.Request) {
+	var (
+		runtime = r.Context().Value("runtime").(*libpod.Runtime)
+	)
+	name := utils.GetName(r)
+	vol, err := runtime.GetVolume(name)
+	if err != nil {
+		utils.VolumeNotFound(w, name, err)
+		return
+	}
+	w.Header().Set("Content-Type", "application/x-tar")
+	if err := vol.Export(w); err != nil {
+		utils.InternalServerError(w, err)
+		return
+	}
+}
+
+func ImportVolume(w http.ResponseWriter, r *http.Request) {
+	var (
+		runtime = r.Context().Value("runtime").(*libpod.Runtime)
+	)
+	name := utils.GetName(r)
+	vol, err := runtime.GetVolume(name)
+	if err != nil {
+		utils.VolumeNotFound(w, name, err)
+		return
+	}
+	if err := vol.Import(r.Body); err != nil {
+		utils.InternalServerError(w, err)
+		return
+	}
+	utils.WriteResponse(w, http.StatusNoContent, "")
+}
+
 func ListVolumes(w http.ResponseWriter, r *http.Request) {
 	var (
 		decoder = r.Context().Value("decoder").(*schema.Decoder)
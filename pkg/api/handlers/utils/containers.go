@@ -18,8 +18,8 @@ func WaitContainer(w http.ResponseWriter, r *http.Request) (int32, error) {
 	runtime := r.Context().Value("runtime").(*libpod.Runtime)
 	decoder := r.Context().Value("decoder").(*schema.Decoder)
 	query := struct {
-		Interval  string `schema:"interval"`
-		Condition string `schema:"condition"`
+		Interval  string   `schema:"interval"`
+		Condition []string `schema:"condition"`
 	}{
 		// Override golang default values for types
 	}
@@ -40,11 +40,9 @@ func WaitContainer(w http.ResponseWriter, r *http.Request) (int32, error) {
 			return 0, err
 		}
 	}
-	condition := define.ContainerStateStopped
-	if _, found := r.URL.Query()["condition"]; found {
-		condition, err = define.StringToContainerStatus(query.Condition)
-		if err != nil {
-			InternalServerError(w, err)
+	for _, condition := range query.Condition {
+		if err := define.ValidateWaitCondition(condition); err != nil {
+			Error(w, "Something went wrong.", http.StatusBadRequest, err)
 			return 0, err
 		}
 	}
@@ -54,5 +52,10 @@ func WaitContainer(w http.ResponseWriter, r *http.Request) (int32, error) {
 		ContainerNotFound(w, name, err)
 		return 0, err
 	}
-	return con.WaitForConditionWithInterval(interval, condition)
+	exitCode, err := con.WaitForConditions(r.Context(), interval, query.Condition)
+	if err != nil {
+		InternalServerError(w, err)
+		return 0, err
+	}
+	return exitCode, nil
 }
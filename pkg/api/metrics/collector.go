@@ -0,0 +1,300 @@
+package metrics
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/containers/podman/v2/libpod"
+	"github.com/containers/podman/v2/libpod/define"
+	"github.com/containers/podman/v2/libpod/events"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/sirupsen/logrus"
+)
+
+const namespace = "podman"
+
+// statsCacheDuration is how long the resource usage of containers is reused
+// for subsequent scrapes, so that frequent or concurrent scrapes do not read
+// the cgroups of every container each time.
+const statsCacheDuration = 5 * time.Second
+
+var (
+	containerLabels = []string{"id", "name"}
+	podLabels       = []string{"id", "name"}
+
+	containerInfoDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, "container", "info"),
+		"Information about a container, the value is always 1.",
+		[]string{"id", "name", "image", "pod"}, nil,
+	)
+	containerStateDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, "container", "state"),
+		"Current state of a container, the value is always 1.",
+		append(containerLabels, "state"), nil,
+	)
+	containerHealthDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, "container", "health"),
+		"Current healthcheck status of a container with a healthcheck, the value is always 1.",
+		append(containerLabels, "status"), nil,
+	)
+	containerRestartCountDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, "container", "restart_count"),
+		"Number of times a container was restarted by its restart policy.",
+		containerLabels, nil,
+	)
+	containerResources = newResourceDescs("container", containerLabels)
+
+	podInfoDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, "pod", "info"),
+		"Information about a pod, the value is always 1.",
+		[]string{"id", "name", "infra_id"}, nil,
+	)
+	podStateDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, "pod", "state"),
+		"Current state of a pod, the value is always 1.",
+		append(podLabels, "state"), nil,
+	)
+	podContainersDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, "pod", "containers"),
+		"Number of containers in a pod.",
+		podLabels, nil,
+	)
+	podResources = newResourceDescs("pod", podLabels)
+)
+
+// resourceDescs describe the resource usage of a container or a pod, as
+// computed by GetContainerStats.
+type resourceDescs struct {
+	cpu         *prometheus.Desc
+	cpuSystem   *prometheus.Desc
+	memUsage    *prometheus.Desc
+	memLimit    *prometheus.Desc
+	netInput    *prometheus.Desc
+	netOutput   *prometheus.Desc
+	blockInput  *prometheus.Desc
+	blockOutput *prometheus.Desc
+	pids        *prometheus.Desc
+}
+
+func newResourceDescs(subsystem string, labels []string) resourceDescs {
+	desc := func(name, help string) *prometheus.Desc {
+		return prometheus.NewDesc(prometheus.BuildFQName(namespace, subsystem, name), help, labels, nil)
+	}
+	return resourceDescs{
+		cpu:         desc("cpu_seconds_total", "Total CPU time consumed in seconds."),
+		cpuSystem:   desc("cpu_system_seconds_total", "Total CPU time consumed in kernel mode in seconds."),
+		memUsage:    desc("mem_usage_bytes", "Current memory usage in bytes."),
+		memLimit:    desc("mem_limit_bytes", "Memory limit in bytes."),
+		netInput:    desc("net_input_bytes_total", "Total bytes received over the network."),
+		netOutput:   desc("net_output_bytes_total", "Total bytes sent over the network."),
+		blockInput:  desc("block_input_bytes_total", "Total bytes read from block devices."),
+		blockOutput: desc("block_output_bytes_total", "Total bytes written to block devices."),
+		pids:        desc("pids", "Current number of processes."),
+	}
+}
+
+func (r resourceDescs) describe(ch chan<- *prometheus.Desc) {
+	for _, d := range []*prometheus.Desc{r.cpu, r.cpuSystem, r.memUsage, r.memLimit, r.netInput, r.netOutput, r.blockInput, r.blockOutput, r.pids} {
+		ch <- d
+	}
+}
+
+func (r resourceDescs) collect(ch chan<- prometheus.Metric, stats *define.ContainerStats, labels ...string) {
+	ch <- prometheus.MustNewConstMetric(r.cpu, prometheus.CounterValue, float64(stats.CPUNano)/1e9, labels...)
+	ch <- prometheus.MustNewConstMetric(r.cpuSystem, prometheus.CounterValue, float64(stats.CPUSystemNano)/1e9, labels...)
+	ch <- prometheus.MustNewConstMetric(r.memUsage, prometheus.GaugeValue, float64(stats.MemUsage), labels...)
+	ch <- prometheus.MustNewConstMetric(r.memLimit, prometheus.GaugeValue, float64(stats.MemLimit), labels...)
+	ch <- prometheus.MustNewConstMetric(r.netInput, prometheus.CounterValue, float64(stats.NetInput), labels...)
+	ch <- prometheus.MustNewConstMetric(r.netOutput, prometheus.CounterValue, float64(stats.NetOutput), labels...)
+	ch <- prometheus.MustNewConstMetric(r.blockInput, prometheus.CounterValue, float64(stats.BlockInput), labels...)
+	ch <- prometheus.MustNewConstMetric(r.blockOutput, prometheus.CounterValue, float64(stats.BlockOutput), labels...)
+	ch <- prometheus.MustNewConstMetric(r.pids, prometheus.GaugeValue, float64(stats.PIDs), labels...)
+}
+
+// Collector is a prometheus.Collector exposing the state and resource usage
+// of the containers and pods of a libpod runtime, as well as counters of the
+// events the runtime emitted.
+type Collector struct {
+	runtime *libpod.Runtime
+	events  *prometheus.CounterVec
+
+	// statsLock serializes the collection of the resource usage and
+	// guards the cached stats
+	statsLock sync.Mutex
+	// statsUpdated is the time the cached stats were reset
+	statsUpdated time.Time
+	// stats caches the resource usage of running containers by ID
+	stats map[string]*define.ContainerStats
+}
+
+// NewCollector creates a Collector for the given runtime. Events are only
+// counted while WatchEvents is running.
+func NewCollector(runtime *libpod.Runtime) *Collector {
+	return &Collector{
+		runtime: runtime,
+		events: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "events_total",
+			Help:      "Number of libpod events by type and status.",
+		}, []string{"type", "status"}),
+	}
+}
+
+// WatchEvents counts the events emitted by the runtime until ctx is done.
+func (c *Collector) WatchEvents(ctx context.Context) error {
+	eventChannel := make(chan *events.Event)
+	go func() {
+		for e := range eventChannel {
+			c.events.WithLabelValues(e.Type.String(), e.Status.String()).Inc()
+		}
+	}()
+	return c.runtime.Events(ctx, events.ReadOptions{
+		EventChannel: eventChannel,
+		Stream:       true,
+	})
+}
+
+// Describe implements prometheus.Collector.
+func (c *Collector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- containerInfoDesc
+	ch <- containerStateDesc
+	ch <- containerHealthDesc
+	ch <- containerRestartCountDesc
+	containerResources.describe(ch)
+	ch <- podInfoDesc
+	ch <- podStateDesc
+	ch <- podContainersDesc
+	podResources.describe(ch)
+	c.events.Describe(ch)
+}
+
+// Collect implements prometheus.Collector.
+func (c *Collector) Collect(ch chan<- prometheus.Metric) {
+	c.events.Collect(ch)
+
+	ctrs, err := c.runtime.GetAllContainers()
+	if err != nil {
+		logrus.Errorf("Unable to list containers for metrics: %v", err)
+		return
+	}
+	running := make([]*libpod.Container, 0, len(ctrs))
+	for _, ctr := range ctrs {
+		state, err := ctr.State()
+		if err != nil {
+			// The container may have been removed in the meantime
+			logrus.Debugf("Unable to get state of container %s for metrics: %v", ctr.ID(), err)
+			continue
+		}
+		_, imageName := ctr.Image()
+		ch <- prometheus.MustNewConstMetric(containerInfoDesc, prometheus.GaugeValue, 1, ctr.ID(), ctr.Name(), imageName, ctr.PodID())
+		ch <- prometheus.MustNewConstMetric(containerStateDesc, prometheus.GaugeValue, 1, ctr.ID(), ctr.Name(), state.String())
+
+		if ctr.HasHealthCheck() {
+			if status, err := ctr.HealthCheckStatus(); err == nil && status != "" {
+				ch <- prometheus.MustNewConstMetric(containerHealthDesc, prometheus.GaugeValue, 1, ctr.ID(), ctr.Name(), status)
+			}
+		}
+
+		if restarts, err := ctr.RestartCount(); err == nil {
+			ch <- prometheus.MustNewConstMetric(containerRestartCountDesc, prometheus.GaugeValue, float64(restarts), ctr.ID(), ctr.Name())
+		}
+
+		if state == define.ContainerStateRunning {
+			running = append(running, ctr)
+		}
+	}
+
+	ctrStats := c.containerStats(running)
+	for _, ctr := range running {
+		if stats, ok := ctrStats[ctr.ID()]; ok {
+			containerResources.collect(ch, stats, ctr.ID(), ctr.Name())
+		}
+	}
+
+	pods, err := c.runtime.GetAllPods()
+	if err != nil {
+		logrus.Errorf("Unable to list pods for metrics: %v", err)
+		return
+	}
+	for _, pod := range pods {
+		c.collectPod(ch, pod, ctrStats)
+	}
+}
+
+// containerStats returns the resource usage of the running containers by
+// container ID.  The usage is cached for statsCacheDuration, only containers
+// missing from the cache are queried in the meantime.
+func (c *Collector) containerStats(running []*libpod.Container) map[string]*define.ContainerStats {
+	c.statsLock.Lock()
+	defer c.statsLock.Unlock()
+
+	if c.stats == nil || time.Since(c.statsUpdated) >= statsCacheDuration {
+		c.stats = make(map[string]*define.ContainerStats, len(running))
+		c.statsUpdated = time.Now()
+	}
+	ctrStats := make(map[string]*define.ContainerStats, len(running))
+	for _, ctr := range running {
+		stats, ok := c.stats[ctr.ID()]
+		if !ok {
+			var err error
+			stats, err = ctr.GetContainerStats(&define.ContainerStats{})
+			if err != nil {
+				logrus.Debugf("Unable to get stats of container %s for metrics: %v", ctr.ID(), err)
+				continue
+			}
+			c.stats[ctr.ID()] = stats
+		}
+		ctrStats[ctr.ID()] = stats
+	}
+	return ctrStats
+}
+
+// collectPod sends the metrics of a single pod. Its resource usage is the sum
+// of the resource usage of its running containers, except for network usage,
+// which is taken from the infra container when the pod has one as all
+// containers share its network namespace.
+func (c *Collector) collectPod(ch chan<- prometheus.Metric, pod *libpod.Pod, ctrStats map[string]*define.ContainerStats) {
+	status, err := pod.GetPodStatus()
+	if err != nil {
+		logrus.Debugf("Unable to get status of pod %s for metrics: %v", pod.ID(), err)
+		return
+	}
+	infraID, err := pod.InfraContainerID()
+	if err != nil {
+		logrus.Debugf("Unable to get infra container of pod %s for metrics: %v", pod.ID(), err)
+		return
+	}
+	ctrIDs, err := pod.AllContainersByID()
+	if err != nil {
+		logrus.Debugf("Unable to get containers of pod %s for metrics: %v", pod.ID(), err)
+		return
+	}
+	ch <- prometheus.MustNewConstMetric(podInfoDesc, prometheus.GaugeValue, 1, pod.ID(), pod.Name(), infraID)
+	ch <- prometheus.MustNewConstMetric(podStateDesc, prometheus.GaugeValue, 1, pod.ID(), pod.Name(), status)
+	ch <- prometheus.MustNewConstMetric(podContainersDesc, prometheus.GaugeValue, float64(len(ctrIDs)), pod.ID(), pod.Name())
+
+	podStats := new(define.ContainerStats)
+	running := false
+	for _, id := range ctrIDs {
+		stats, ok := ctrStats[id]
+		if !ok {
+			continue
+		}
+		running = true
+		podStats.CPUNano += stats.CPUNano
+		podStats.CPUSystemNano += stats.CPUSystemNano
+		podStats.MemUsage += stats.MemUsage
+		podStats.MemLimit += stats.MemLimit
+		podStats.BlockInput += stats.BlockInput
+		podStats.BlockOutput += stats.BlockOutput
+		podStats.PIDs += stats.PIDs
+		if infraID == "" || id == infraID {
+			podStats.NetInput += stats.NetInput
+			podStats.NetOutput += stats.NetOutput
+		}
+	}
+	if running {
+		podResources.collect(ch, podStats, pod.ID(), pod.Name())
+	}
+}
@@ -34,7 +34,7 @@ func (s *APIServer) registerArchiveHandlers(r *mux.Router) error {
 	//   - in: query
 	//     name: copyUIDGID
 	//     type: string
-	//     description: copy UID/GID maps to the dest file or di (1 or true)
+	//     description: keep the ownership of the files in the archive, translated through the ID mappings of the container, instead of changing it to the user of the container (1 or true)
 	//   - in: body
 	//     name: request
 	//     description: tarfile of files to copy into the container
@@ -115,6 +115,11 @@ func (s *APIServer) registerArchiveHandlers(r *mux.Router) error {
 	//     type: boolean
 	//     description: pause the container while copying (defaults to true)
 	//     default: true
+	//   - in: query
+	//     name: copyUIDGID
+	//     type: boolean
+	//     description: keep the ownership of the files in the archive, translated through the ID mappings of the container, instead of changing it to the user of the container
+	//     default: false
 	//   - in: body
 	//     name: request
 	//     description: tarfile of files to copy into the container
@@ -151,6 +156,11 @@ func (s *APIServer) registerArchiveHandlers(r *mux.Router) error {
 	//     type: string
 	//     description: Path to a directory in the container to extract
 	//     required: true
+	//   - in: query
+	//     name: copyUIDGID
+	//     type: boolean
+	//     description: keep the ownership of the files, translated through the ID mappings of the container, instead of changing it to the user of the container
+	//     default: false
 	//  responses:
 	//    200:
 	//      description: no error
@@ -1,13 +1,38 @@
 package server
 
 import (
+	"net/http"
+
 	"github.com/containers/podman/v2/pkg/api/handlers/compat"
 	"github.com/gorilla/mux"
 )
 
 func (s *APIServer) registerAuthHandlers(r *mux.Router) error {
-	r.Handle(VersionedPath("/auth"), s.APIHandler(compat.UnsupportedHandler))
+	// swagger:operation POST /auth compat auth
+	// ---
+	// tags:
+	//  - system (compat)
+	// summary: Check auth configuration
+	// description: Validate credentials for a registry and store them in the auth file of the service, like `podman login`.
+	// parameters:
+	//  - in: body
+	//    name: authConfig
+	//    description: Authentication to check
+	//    schema:
+	//      $ref: "#/definitions/AuthConfig"
+	// produces:
+	// - application/json
+	// responses:
+	//   200:
+	//     $ref: "#/responses/SystemAuthResponse"
+	//   400:
+	//     $ref: "#/responses/BadParamError"
+	//   401:
+	//     $ref: "#/responses/BadParamError"
+	//   500:
+	//     $ref: "#/responses/InternalError"
+	r.Handle(VersionedPath("/auth"), s.APIHandler(compat.Auth)).Methods(http.MethodPost)
 	// Added non version path to URI to support docker non versioned paths
-	r.Handle("/auth", s.APIHandler(compat.UnsupportedHandler))
+	r.Handle("/auth", s.APIHandler(compat.Auth)).Methods(http.MethodPost)
 	return nil
 }
@@ -637,6 +637,38 @@ func (s *APIServer) registerContainersHandlers(r *mux.Router) error {
 	//     $ref: "#/responses/InternalError"
 	r.HandleFunc(VersionedPath("/containers/{name}/rename"), s.APIHandler(compat.RenameContainer)).Methods(http.MethodPost)
 	r.HandleFunc("/containers/{name}/rename", s.APIHandler(compat.RenameContainer)).Methods(http.MethodPost)
+	// swagger:operation POST /containers/{name}/update compat updateContainer
+	// ---
+	// tags:
+	//   - containers (compat)
+	// summary: Update a container
+	// description: |
+	//   Change the resource limits and the restart policy of a container.
+	//   Running and paused containers are updated immediately.  Only the CPU, cpuset, memory, pids and block IO weight limits can be changed.
+	// parameters:
+	//  - in: path
+	//    name: name
+	//    type: string
+	//    required: true
+	//    description: the name or ID of the container
+	//  - in: body
+	//    name: update
+	//    description: resource limits and restart policy to set
+	//    schema:
+	//      $ref: "#/definitions/ContainerUpdateConfig"
+	// produces:
+	// - application/json
+	// responses:
+	//   200:
+	//     $ref: "#/responses/ContainerUpdateResponse"
+	//   400:
+	//     $ref: "#/responses/BadParamError"
+	//   404:
+	//     $ref: "#/responses/NoSuchContainer"
+	//   500:
+	//     $ref: "#/responses/InternalError"
+	r.HandleFunc(VersionedPath("/containers/{name}/update"), s.APIHandler(compat.UpdateContainer)).Methods(http.MethodPost)
+	r.HandleFunc("/containers/{name}/update", s.APIHandler(compat.UpdateContainer)).Methods(http.MethodPost)
 
 	/*
 		libpod endpoints
@@ -1081,6 +1113,11 @@ func (s *APIServer) registerContainersHandlers(r *mux.Router) error {
 	//    type: boolean
 	//    default: true
 	//    description: Stream the output
+	//  - in: query
+	//    name: interval
+	//    type: integer
+	//    default: 1
+	//    description: Time in seconds between two stats samples when streaming
 	// produces:
 	// - application/json
 	// responses:
@@ -1161,15 +1198,24 @@ func (s *APIServer) registerContainersHandlers(r *mux.Router) error {
 	//    description: the name or ID of the container
 	//  - in: query
 	//    name: condition
-	//    type: string
+	//    type: array
+	//    items:
+	//      type: string
 	//    description: |
-	//      wait until container is to a given condition. default is stopped. valid conditions are:
+	//      wait until container is to a given condition. default is stopped. If the condition is given multiple times, the wait ends once the first one is met. valid conditions are:
 	//        - configured
 	//        - created
 	//        - exited
+	//        - healthy
 	//        - paused
+	//        - removed
 	//        - running
 	//        - stopped
+	//  - in: query
+	//    name: interval
+	//    type: string
+	//    default: "250ms"
+	//    description: Time interval at which the container is polled if events cannot be read.
 	// produces:
 	// - application/json
 	// responses:
@@ -1369,6 +1415,10 @@ func (s *APIServer) registerContainersHandlers(r *mux.Router) error {
 	//    name: ignoreRootFS
 	//    type: boolean
 	//    description: do not include root file-system changes when exporting
+	//  - in: query
+	//    name: createImage
+	//    type: string
+	//    description: create a checkpoint image with the given name
 	// produces:
 	// - application/json
 	// responses:
@@ -1384,7 +1434,7 @@ func (s *APIServer) registerContainersHandlers(r *mux.Router) error {
 	// tags:
 	//   - containers
 	// summary: Restore a container
-	// description: Restore a container from a checkpoint.
+	// description: Restore a container from a checkpoint. The name or ID of a checkpoint image created with createImage can be used to restore the container stored in it.
 	// parameters:
 	//  - in: path
 	//    name: name
@@ -1394,7 +1444,7 @@ func (s *APIServer) registerContainersHandlers(r *mux.Router) error {
 	//  - in: query
 	//    name: name
 	//    type: string
-	//    description: the name of the container when restored from a tar. can only be used with import
+	//    description: the name of the container when restored from a tar or a checkpoint image. can only be used with import or a checkpoint image
 	//  - in: query
 	//    name: keep
 	//    type: boolean
@@ -1416,6 +1466,10 @@ func (s *APIServer) registerContainersHandlers(r *mux.Router) error {
 	//    type: boolean
 	//    description: do not include root file-system changes when exporting
 	//  - in: query
+	//    name: ignoreVolumes
+	//    type: boolean
+	//    description: do not restore the content of volumes. can only be used with import or a checkpoint image
+	//  - in: query
 	//    name: ignoreStaticIP
 	//    type: boolean
 	//    description: ignore IP address if set statically
@@ -1428,16 +1482,16 @@ func (s *APIServer) registerContainersHandlers(r *mux.Router) error {
 	// responses:
 	//   200:
 	//     description: tarball is returned in body if exported
+	//   400:
+	//     $ref: "#/responses/BadParamError"
 	//   404:
 	//     $ref: "#/responses/NoSuchContainer"
 	//   500:
 	//     $ref: "#/responses/InternalError"
 	r.HandleFunc(VersionedPath("/libpod/containers/{name}/restore"), s.APIHandler(libpod.Restore)).Methods(http.MethodPost)
-	// swagger:operation GET /containers/{name}/changes libpod libpodChangesContainer
-	// swagger:operation GET /libpod/containers/{name}/changes compat changesContainer
+	// swagger:operation GET /containers/{name}/changes compat changesContainer
 	// ---
 	// tags:
-	//   - containers
 	//   - containers (compat)
 	// summary: Report on changes to container's filesystem; adds, deletes or modifications.
 	// description: |
@@ -1465,7 +1519,46 @@ func (s *APIServer) registerContainersHandlers(r *mux.Router) error {
 	//     $ref: "#/responses/InternalError"
 	r.HandleFunc(VersionedPath("/containers/{name}/changes"), s.APIHandler(compat.Changes)).Methods(http.MethodGet)
 	r.HandleFunc("/containers/{name}/changes", s.APIHandler(compat.Changes)).Methods(http.MethodGet)
-	r.HandleFunc(VersionedPath("/libpod/containers/{name}/changes"), s.APIHandler(compat.Changes)).Methods(http.MethodGet)
+	// swagger:operation GET /libpod/containers/{name}/changes libpod libpodChangesContainer
+	// ---
+	// tags:
+	//   - containers
+	// summary: Report on changes to container's filesystem; adds, deletes or modifications.
+	// description: |
+	//   Returns which files in a container's filesystem have been added, deleted, or modified. The Kind of modification can be one of:
+	//
+	//   0: Modified
+	//   1: Added
+	//   2: Deleted
+	//
+	//   If detail is set, the size, mode, ownership and content digest of each path before and after the change are reported as well.
+	// parameters:
+	//  - in: path
+	//    name: name
+	//    type: string
+	//    required: true
+	//    description: the name or id of the container
+	//  - in: query
+	//    name: parent
+	//    type: string
+	//    description: image or container to compare against instead of the parent layer
+	//  - in: query
+	//    name: detail
+	//    type: boolean
+	//    default: false
+	//    description: report the details of the changed paths
+	// responses:
+	//   200:
+	//     description: Array of Changes
+	//     content:
+	//       application/json:
+	//       schema:
+	//         $ref: "#/responses/Changes"
+	//   404:
+	//     $ref: "#/responses/NoSuchContainer"
+	//   500:
+	//     $ref: "#/responses/InternalError"
+	r.HandleFunc(VersionedPath("/libpod/containers/{name}/changes"), s.APIHandler(libpod.Changes)).Methods(http.MethodGet)
 	// swagger:operation POST /libpod/containers/{name}/init libpod libpodInitContainer
 	// ---
 	// tags:
@@ -1519,5 +1612,44 @@ func (s *APIServer) registerContainersHandlers(r *mux.Router) error {
 	//   500:
 	//     $ref: "#/responses/InternalError"
 	r.HandleFunc(VersionedPath("/libpod/containers/{name}/rename"), s.APIHandler(compat.RenameContainer)).Methods(http.MethodPost)
+	// swagger:operation POST /libpod/containers/{name}/update libpod libpodUpdateContainer
+	// ---
+	// tags:
+	//   - containers
+	// summary: Update a container
+	// description: |
+	//   Change the resource limits and the restart policy of a container.
+	//   Running and paused containers are updated immediately.  Only the CPU, cpuset, memory, pids and block IO weight limits are changed, all other resources are ignored.
+	// parameters:
+	//  - in: path
+	//    name: name
+	//    type: string
+	//    required: true
+	//    description: the name or ID of the container
+	//  - in: query
+	//    name: restartPolicy
+	//    type: string
+	//    description: the new restart policy of the container, one of "no", "on-failure", "always" or "unless-stopped"
+	//  - in: query
+	//    name: restartRetries
+	//    type: integer
+	//    description: the number of times to restart the container, only valid with the "on-failure" restart policy
+	//  - in: body
+	//    name: resources
+	//    description: the resource limits to set
+	//    schema:
+	//      $ref: "#/definitions/LinuxResources"
+	// produces:
+	// - application/json
+	// responses:
+	//   204:
+	//     description: no error
+	//   400:
+	//     $ref: "#/responses/BadParamError"
+	//   404:
+	//     $ref: "#/responses/NoSuchContainer"
+	//   500:
+	//     $ref: "#/responses/InternalError"
+	r.HandleFunc(VersionedPath("/libpod/containers/{name}/update"), s.APIHandler(libpod.UpdateContainer)).Methods(http.MethodPost)
 	return nil
 }
@@ -4,6 +4,7 @@ import (
 	"net/http"
 
 	"github.com/containers/podman/v2/pkg/api/handlers/compat"
+	"github.com/containers/podman/v2/pkg/api/handlers/libpod"
 	"github.com/gorilla/mux"
 )
 
@@ -333,5 +334,77 @@ func (s *APIServer) registerExecHandlers(r *mux.Router) error {
 	//   500:
 	//     $ref: "#/responses/InternalError"
 	r.Handle(VersionedPath("/libpod/exec/{id}/json"), s.APIHandler(compat.ExecInspectHandler)).Methods(http.MethodGet)
+	// swagger:operation POST /libpod/exec/{id}/attach libpod libpodAttachExec
+	// ---
+	// tags:
+	//   - exec
+	// summary: Attach to a running exec instance
+	// description: |
+	//   Hijacks the connection to forward the standard streams of an exec instance that is already running, usually one started in detached mode.
+	//   Output produced before attaching is not replayed.
+	// parameters:
+	//  - in: path
+	//    name: id
+	//    type: string
+	//    required: true
+	//    description: Exec instance ID
+	//  - in: query
+	//    name: detachKeys
+	//    type: string
+	//    description: keys to use for detaching from the exec instance. Defaults to the detach keys of the exec instance.
+	//  - in: query
+	//    name: stdin
+	//    type: boolean
+	//    default: true
+	//    description: attach to stdin
+	//  - in: query
+	//    name: stdout
+	//    type: boolean
+	//    default: true
+	//    description: attach to stdout
+	//  - in: query
+	//    name: stderr
+	//    type: boolean
+	//    default: true
+	//    description: attach to stderr
+	//  - in: query
+	//    name: rmSession
+	//    type: boolean
+	//    default: false
+	//    description: remove the exec instance once it exited and was inspected
+	// produces:
+	// - application/json
+	// responses:
+	//   101:
+	//     description: No error, connection has been hijacked for transporting streams.
+	//   404:
+	//     $ref: "#/responses/NoSuchExecInstance"
+	//   409:
+	//     description: exec instance is not running.
+	//   500:
+	//     $ref: "#/responses/InternalError"
+	r.Handle(VersionedPath("/libpod/exec/{id}/attach"), s.APIHandler(libpod.ExecAttach)).Methods(http.MethodPost)
+	// swagger:operation GET /libpod/containers/{name}/exec/json libpod libpodListExec
+	// ---
+	// tags:
+	//   - exec
+	// summary: List exec instances
+	// description: Return low-level information about all exec instances of a container, including exited ones that have not been removed.
+	// parameters:
+	//  - in: path
+	//    name: name
+	//    type: string
+	//    required: true
+	//    description: the name or ID of the container
+	// produces:
+	// - application/json
+	// responses:
+	//   200:
+	//     description: no error
+	//   404:
+	//     $ref: "#/responses/NoSuchContainer"
+	//   500:
+	//     $ref: "#/responses/InternalError"
+	r.Handle(VersionedPath("/libpod/containers/{name}/exec/json"), s.APIHandler(libpod.ExecSessions)).Methods(http.MethodGet)
 	return nil
 }
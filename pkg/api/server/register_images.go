@@ -977,11 +977,38 @@ func (s *APIServer) registerImagesHandlers(r *mux.Router) error {
 	//           (or `0`), all unused images are pruned.
 	//        - `until=<string>` Prune images created before this timestamp. The `<timestamp>` can be Unix timestamps, date formatted timestamps, or Go duration strings (e.g. `10m`, `1h30m`) computed relative to the daemon machine’s time.
 	//        - `label` (`label=<key>`, `label=<key>=<value>`, `label!=<key>`, or `label!=<key>=<value>`) Prune images with (or without, in case `label!=...` is used) the specified labels.
+	//  - in: query
+	//    name: dryrun
+	//    type: boolean
+	//    default: false
+	//    description: report the images that would be removed without removing them
+	//  - in: query
+	//    name: gc
+	//    type: boolean
+	//    default: false
+	//    description: evict unused images according to the image garbage collection policy instead of pruning dangling images
+	//  - in: query
+	//    name: gchighthreshold
+	//    type: integer
+	//    default: 85
+	//    description: percentage of the graph root's file system usage above which unused images are evicted (only with gc)
+	//  - in: query
+	//    name: gclowthreshold
+	//    type: integer
+	//    default: 80
+	//    description: percentage of the graph root's file system usage to free space down to (only with gc)
+	//  - in: query
+	//    name: gcminage
+	//    type: string
+	//    default: 2m
+	//    description: minimum time since an image was last used before it may be evicted (only with gc)
 	// produces:
 	// - application/json
 	// responses:
 	//   200:
 	//     $ref: "#/responses/DocsImageDeleteResponse"
+	//   400:
+	//     $ref: "#/responses/BadParamError"
 	//   500:
 	//     $ref: '#/responses/InternalError'
 	r.Handle(VersionedPath("/libpod/images/prune"), s.APIHandler(libpod.PruneImages)).Methods(http.MethodPost)
@@ -1185,6 +1212,14 @@ func (s *APIServer) registerImagesHandlers(r *mux.Router) error {
 	//    name: format
 	//    type: string
 	//    description: format of the image manifest and metadata (default "oci")
+	//  - in: query
+	//    name: squash
+	//    type: boolean
+	//    description: squash the changes of the container and all layers of its image into a single layer
+	//  - in: query
+	//    name: squashNew
+	//    type: string
+	//    description: name or ID of an image the container is based on; its layers are kept and only the layers on top of it are squashed with the changes of the container into a single layer
 	// produces:
 	// - application/json
 	// responses:
@@ -1241,12 +1276,23 @@ func (s *APIServer) registerImagesHandlers(r *mux.Router) error {
 	//   0: Modified
 	//   1: Added
 	//   2: Deleted
+	//
+	//   If detail is set, the size, mode, ownership and content digest of each path before and after the change are reported as well.
 	// parameters:
 	//  - in: path
 	//    name: name
 	//    type: string
 	//    required: true
 	//    description: the name or id of the container
+	//  - in: query
+	//    name: parent
+	//    type: string
+	//    description: image or container to compare against instead of the parent layer
+	//  - in: query
+	//    name: detail
+	//    type: boolean
+	//    default: false
+	//    description: report the details of the changed paths
 	// responses:
 	//   200:
 	//     description: Array of Changes
@@ -1258,7 +1304,7 @@ func (s *APIServer) registerImagesHandlers(r *mux.Router) error {
 	//     $ref: "#/responses/NoSuchContainer"
 	//   500:
 	//     $ref: "#/responses/InternalError"
-	r.HandleFunc(VersionedPath("/libpod/images/{name}/changes"), s.APIHandler(compat.Changes)).Methods(http.MethodGet)
+	r.HandleFunc(VersionedPath("/libpod/images/{name}/changes"), s.APIHandler(libpod.Changes)).Methods(http.MethodGet)
 
 	// swagger:operation POST /libpod/build libpod libpodBuildImage
 	// ---
@@ -38,6 +38,26 @@ func (s *APIServer) registerManifestHandlers(r *mux.Router) error {
 	//   500:
 	//     $ref: "#/responses/InternalError"
 	r.Handle(VersionedPath("/libpod/manifests/create"), s.APIHandler(libpod.ManifestCreate)).Methods(http.MethodPost)
+	// swagger:operation GET /libpod/manifests/{name:.*}/exists manifests ExistsManifest
+	// ---
+	// summary: Exists
+	// description: Check if a manifest list exists in local storage
+	// produces:
+	// - application/json
+	// parameters:
+	//  - in: path
+	//    name: name:.*
+	//    type: string
+	//    required: true
+	//    description: the name or ID of the manifest list
+	// responses:
+	//   204:
+	//     description: manifest list exists
+	//   404:
+	//     $ref: "#/responses/NoSuchManifest"
+	//   500:
+	//     $ref: "#/responses/InternalError"
+	r.Handle(VersionedPath("/libpod/manifests/{name:.*}/exists"), s.APIHandler(libpod.ManifestExists)).Methods(http.MethodGet)
 	// swagger:operation GET /libpod/manifests/{name:.*}/json manifests Inspect
 	// ---
 	// summary: Inspect
@@ -87,7 +107,10 @@ func (s *APIServer) registerManifestHandlers(r *mux.Router) error {
 	// swagger:operation DELETE /libpod/manifests/{name:.*} manifests RemoveManifest
 	// ---
 	// summary: Remove
-	// description: Remove an image from a manifest list
+	// description: |
+	//   Remove an image from a manifest list.  With all=true, the manifest
+	//   list itself is removed from local storage while the images it
+	//   references are kept.
 	// produces:
 	// - application/json
 	// parameters:
@@ -100,9 +123,16 @@ func (s *APIServer) registerManifestHandlers(r *mux.Router) error {
 	//    name: digest
 	//    type: string
 	//    description: image digest to be removed
+	//  - in: query
+	//    name: all
+	//    type: boolean
+	//    default: false
+	//    description: remove the manifest list itself instead of one of its images
 	// responses:
 	//   200:
-	//     $ref: "#/definitions/IDResponse"
+	//     description: |
+	//       the ID of the updated manifest list (IDResponse) if a digest was
+	//       given, the removal report (DocsLibpodImagesRemoveResponse) with all=true
 	//   400:
 	//     $ref: "#/responses/BadParamError"
 	//   404:
@@ -131,6 +161,10 @@ func (s *APIServer) registerManifestHandlers(r *mux.Router) error {
 	//    name: all
 	//    description: push all images
 	//    type: boolean
+	//  - in: query
+	//    name: rm
+	//    description: remove the manifest list from local storage after a successful push
+	//    type: boolean
 	// responses:
 	//   200:
 	//     $ref: "#/definitions/IDResponse"
@@ -0,0 +1,46 @@
+package server
+
+import (
+	"net/http"
+
+	"github.com/containers/podman/v2/pkg/api/metrics"
+	"github.com/gorilla/mux"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"github.com/sirupsen/logrus"
+)
+
+func (s *APIServer) registerMetricsHandlers(r *mux.Router) error {
+	collector := metrics.NewCollector(s.Runtime)
+	registry := prometheus.NewRegistry()
+	if err := registry.Register(collector); err != nil {
+		return err
+	}
+	go func() {
+		if err := collector.WatchEvents(s.Context); err != nil {
+			logrus.Errorf("Unable to watch events for metrics: %v", err)
+		}
+	}()
+
+	// swagger:operation GET /metrics libpod libpodGetMetrics
+	// ---
+	// tags:
+	//  - system
+	// summary: Get metrics
+	// description: |
+	//   Returns resource usage, state, health and restart counts of all containers
+	//   and pods, as well as counters of libpod events by type and status, in the
+	//   Prometheus text exposition format.
+	//   The endpoint is not versioned, as expected by Prometheus.
+	// produces:
+	// - text/plain
+	// responses:
+	//   200:
+	//     description: metrics in the Prometheus text format
+	//   500:
+	//     $ref: "#/responses/InternalError"
+	r.Handle("/metrics", promhttp.HandlerFor(registry, promhttp.HandlerOpts{
+		ErrorLog: logrus.StandardLogger(),
+	})).Methods(http.MethodGet)
+	return nil
+}
@@ -226,6 +226,28 @@ func (s *APIServer) registerNetworkHandlers(r *mux.Router) error {
 	//   500:
 	//     $ref: "#/responses/InternalError"
 	r.HandleFunc(VersionedPath("/libpod/networks/{name}/json"), s.APIHandler(libpod.InspectNetwork)).Methods(http.MethodGet)
+	// swagger:operation GET /libpod/networks/{name}/exists libpod libpodExistsNetwork
+	// ---
+	// tags:
+	//  - networks
+	// summary: Network exists
+	// description: Check if a network exists
+	// parameters:
+	//  - in: path
+	//    name: name
+	//    type: string
+	//    required: true
+	//    description: the name of the network
+	// produces:
+	// - application/json
+	// responses:
+	//   204:
+	//     description: network exists
+	//   404:
+	//     $ref: "#/responses/NoSuchNetwork"
+	//   500:
+	//     $ref: "#/responses/InternalError"
+	r.HandleFunc(VersionedPath("/libpod/networks/{name}/exists"), s.APIHandler(libpod.ExistsNetwork)).Methods(http.MethodGet)
 	// swagger:operation GET /libpod/networks/json libpod libpodListNetwork
 	// ---
 	// tags:
@@ -331,5 +353,34 @@ func (s *APIServer) registerNetworkHandlers(r *mux.Router) error {
 	//   500:
 	//     $ref: "#/responses/InternalError"
 	r.HandleFunc(VersionedPath("/libpod/networks/{name}/disconnect"), s.APIHandler(compat.Disconnect)).Methods(http.MethodPost)
+	// swagger:operation POST /libpod/networks/{name}/update libpod libpodUpdateNetwork
+	// ---
+	// tags:
+	//  - networks
+	// summary: Update a network
+	// description: |
+	//   Add or drop the DNS servers and labels of a network.  The running containers attached
+	//   to the network are reloaded to use the new configuration.
+	// produces:
+	// - application/json
+	// parameters:
+	//  - in: path
+	//    name: name
+	//    type: string
+	//    required: true
+	//    description: the name of the network
+	//  - in: body
+	//    name: update
+	//    description: DNS servers and labels to add and drop
+	//    schema:
+	//      $ref: "#/definitions/NetworkUpdateOptions"
+	// responses:
+	//   200:
+	//     description: OK
+	//   404:
+	//     $ref: "#/responses/NoSuchNetwork"
+	//   500:
+	//     $ref: "#/responses/InternalError"
+	r.HandleFunc(VersionedPath("/libpod/networks/{name}/update"), s.APIHandler(libpod.UpdateNetwork)).Methods(http.MethodPost)
 	return nil
 }
@@ -0,0 +1,24 @@
+package server
+
+import (
+	"net/http"
+
+	"github.com/containers/podman/v2/pkg/api/handlers/libpod"
+	"github.com/gorilla/mux"
+)
+
+// registerRegistryHandlers registers the read-only subset of the registry
+// (distribution) API served in registry mode.  These endpoints implement an
+// external specification and are hence not part of the swagger documentation.
+func (s *APIServer) registerRegistryHandlers(r *mux.Router) error {
+	r.Handle("/v2", s.APIHandler(libpod.RegistryPing)).Methods(http.MethodGet, http.MethodHead)
+	r.Handle("/v2/", s.APIHandler(libpod.RegistryPing)).Methods(http.MethodGet, http.MethodHead)
+	r.Handle("/v2/{name:.*}/tags/list", s.APIHandler(libpod.RegistryTags)).Methods(http.MethodGet)
+	r.Handle("/v2/{name:.*}/manifests/{reference}", s.APIHandler(libpod.RegistryManifest)).Methods(http.MethodGet, http.MethodHead)
+	r.Handle("/v2/{name:.*}/blobs/{digest}", s.APIHandler(libpod.RegistryBlob)).Methods(http.MethodGet, http.MethodHead)
+	// Everything that would modify the registry is rejected.
+	r.PathPrefix("/v2/").MatcherFunc(func(req *http.Request, _ *mux.RouteMatch) bool {
+		return req.Method != http.MethodGet && req.Method != http.MethodHead
+	}).Handler(s.APIHandler(libpod.RegistryUnsupported))
+	return nil
+}
@@ -86,6 +86,80 @@ func (s *APIServer) registerVolumeHandlers(r *mux.Router) error {
 	//   '500':
 	//     "$ref": "#/responses/InternalError"
 	r.Handle(VersionedPath("/libpod/volumes/{name}/json"), s.APIHandler(libpod.InspectVolume)).Methods(http.MethodGet)
+	// swagger:operation GET /libpod/volumes/{name}/exists libpod libpodExistsVolume
+	// ---
+	// tags:
+	//  - volumes
+	// summary: Volume exists
+	// description: Check if a volume exists
+	// parameters:
+	//  - in: path
+	//    name: name
+	//    type: string
+	//    required: true
+	//    description: the name of the volume
+	// produces:
+	// - application/json
+	// responses:
+	//   204:
+	//     description: volume exists
+	//   404:
+	//     $ref: "#/responses/NoSuchVolume"
+	//   500:
+	//     $ref: "#/responses/InternalError"
+	r.Handle(VersionedPath("/libpod/volumes/{name}/exists"), s.APIHandler(libpod.ExistsVolume)).Methods(http.MethodGet)
+	// swagger:operation GET /libpod/volumes/{name}/export libpod libpodExportVolume
+	// ---
+	// tags:
+	//  - volumes
+	// summary: Export a volume
+	// description: Export the contents of a volume as an uncompressed tar archive
+	// parameters:
+	//  - in: path
+	//    name: name
+	//    type: string
+	//    required: true
+	//    description: the name or ID of the volume
+	// produces:
+	// - application/x-tar
+	// responses:
+	//   200:
+	//     description: tarball is returned in body
+	//   404:
+	//     $ref: "#/responses/NoSuchVolume"
+	//   500:
+	//     $ref: "#/responses/InternalError"
+	r.Handle(VersionedPath("/libpod/volumes/{name}/export"), s.APIHandler(libpod.ExportVolume)).Methods(http.MethodGet)
+	// swagger:operation POST /libpod/volumes/{name}/import libpod libpodImportVolume
+	// ---
+	// tags:
+	//  - volumes
+	// summary: Import into a volume
+	// description: Extract a tar archive, which may be compressed, into a volume
+	// consumes:
+	// - application/x-tar
+	// parameters:
+	//  - in: path
+	//    name: name
+	//    type: string
+	//    required: true
+	//    description: the name or ID of the volume
+	//  - in: body
+	//    name: inputStream
+	//    description: tarball to extract into the volume
+	//    schema:
+	//      type: string
+	//      format: binary
+	// produces:
+	// - application/json
+	// responses:
+	//   204:
+	//     description: no error
+	//   404:
+	//     $ref: "#/responses/NoSuchVolume"
+	//   500:
+	//     $ref: "#/responses/InternalError"
+	r.Handle(VersionedPath("/libpod/volumes/{name}/import"), s.APIHandler(libpod.ImportVolume)).Methods(http.MethodPost)
 	// swagger:operation DELETE /libpod/volumes/{name} libpod libpodRemoveVolume
 	// ---
 	// tags:
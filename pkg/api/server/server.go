@@ -47,15 +47,22 @@ var shutdownOnce sync.Once
 
 // NewServer will create and configure a new API server with all defaults
 func NewServer(runtime *libpod.Runtime) (*APIServer, error) {
-	return newServer(runtime, DefaultServiceDuration, nil)
+	return newServer(runtime, DefaultServiceDuration, nil, false)
 }
 
 // NewServerWithSettings will create and configure a new API server using provided settings
 func NewServerWithSettings(runtime *libpod.Runtime, duration time.Duration, listener *net.Listener) (*APIServer, error) {
-	return newServer(runtime, duration, listener)
+	return newServer(runtime, duration, listener, false)
 }
 
-func newServer(runtime *libpod.Runtime, duration time.Duration, listener *net.Listener) (*APIServer, error) {
+// NewRegistryServerWithSettings will create and configure a new server using
+// provided settings which, instead of the API, serves the images in local
+// storage as a read-only registry
+func NewRegistryServerWithSettings(runtime *libpod.Runtime, duration time.Duration, listener *net.Listener) (*APIServer, error) {
+	return newServer(runtime, duration, listener, true)
+}
+
+func newServer(runtime *libpod.Runtime, duration time.Duration, listener *net.Listener, registryMode bool) (*APIServer, error) {
 	// If listener not provided try socket activation protocol
 	if listener == nil {
 		if _, found := os.LookupEnv("LISTEN_PID"); !found {
@@ -75,6 +82,7 @@ func newServer(runtime *libpod.Runtime, duration time.Duration, listener *net.Li
 	logrus.Infof("API server listening on %q", (*listener).Addr())
 	router := mux.NewRouter().UseEncodedPath()
 	idle := idle.NewTracker(duration)
+	ctx, cancel := context.WithCancel(context.Background())
 
 	server := APIServer{
 		Server: http.Server{
@@ -85,6 +93,8 @@ func newServer(runtime *libpod.Runtime, duration time.Duration, listener *net.Li
 			ErrorLog:          log.New(logrus.StandardLogger().Out, "", 0),
 		},
 		Decoder:     handlers.NewAPIDecoder(),
+		Context:     ctx,
+		CancelFunc:  cancel,
 		idleTracker: idle,
 		Listener:    *listener,
 		Runtime:     runtime,
@@ -106,7 +116,7 @@ func newServer(runtime *libpod.Runtime, duration time.Duration, listener *net.Li
 		},
 	)
 
-	for _, fn := range []func(*mux.Router) error{
+	registerFns := []func(*mux.Router) error{
 		server.registerAuthHandlers,
 		server.registerArchiveHandlers,
 		server.registerContainersHandlers,
@@ -118,6 +128,7 @@ func newServer(runtime *libpod.Runtime, duration time.Duration, listener *net.Li
 		server.registerImagesHandlers,
 		server.registerInfoHandlers,
 		server.registerManifestHandlers,
+		server.registerMetricsHandlers,
 		server.registerMonitorHandlers,
 		server.registerNetworkHandlers,
 		server.registerPingHandlers,
@@ -129,7 +140,13 @@ func newServer(runtime *libpod.Runtime, duration time.Duration, listener *net.Li
 		server.registerSystemHandlers,
 		server.registerVersionHandlers,
 		server.registerVolumeHandlers,
-	} {
+	}
+	if registryMode {
+		registerFns = []func(*mux.Router) error{
+			server.registerRegistryHandlers,
+		}
+	}
+	for _, fn := range registerFns {
 		if err := fn(router); err != nil {
 			return nil, err
 		}
@@ -235,6 +252,7 @@ func (s *APIServer) Shutdown() error {
 	}
 
 	shutdownOnce.Do(func() {
+		s.CancelFunc()
 		if logrus.IsLevelEnabled(logrus.DebugLevel) {
 			_, file, line, _ := goRuntime.Caller(1)
 			logrus.Debugf("APIServer.Shutdown by %s:%d, %d/%d connection(s)",
@@ -272,5 +290,6 @@ func (s *APIServer) Shutdown() error {
 
 // Close immediately stops responding to clients and exits
 func (s *APIServer) Close() error {
+	s.CancelFunc()
 	return s.Server.Close()
 }
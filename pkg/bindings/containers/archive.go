@@ -49,12 +49,23 @@ func Stat(ctx context.Context, nameOrID string, path string) (*entities.Containe
 	return statReport, finalErr
 }
 
+// CopyFromArchive copies the contents of a tar archive into the specified
+// path on the container.
 func CopyFromArchive(ctx context.Context, nameOrID string, path string, reader io.Reader) (entities.ContainerCopyFunc, error) {
+	return CopyFromArchiveWithOptions(ctx, nameOrID, path, reader, nil)
+}
+
+// CopyFromArchiveWithOptions copies the contents of a tar archive into the
+// specified path on the container.
+func CopyFromArchiveWithOptions(ctx context.Context, nameOrID string, path string, reader io.Reader, options *CopyOptions) (entities.ContainerCopyFunc, error) {
 	conn, err := bindings.GetClient(ctx)
 	if err != nil {
 		return nil, err
 	}
-	params := url.Values{}
+	params, err := options.ToParams()
+	if err != nil {
+		return nil, err
+	}
 	params.Set("path", path)
 
 	return func() error {
@@ -69,12 +80,23 @@ func CopyFromArchive(ctx context.Context, nameOrID string, path string, reader i
 	}, nil
 }
 
+// CopyToArchive writes a tar archive of the specified path on the container
+// to the writer.
 func CopyToArchive(ctx context.Context, nameOrID string, path string, writer io.Writer) (entities.ContainerCopyFunc, error) {
+	return CopyToArchiveWithOptions(ctx, nameOrID, path, writer, nil)
+}
+
+// CopyToArchiveWithOptions writes a tar archive of the specified path on the
+// container to the writer.
+func CopyToArchiveWithOptions(ctx context.Context, nameOrID string, path string, writer io.Writer, options *CopyOptions) (entities.ContainerCopyFunc, error) {
 	conn, err := bindings.GetClient(ctx)
 	if err != nil {
 		return nil, err
 	}
-	params := url.Values{}
+	params, err := options.ToParams()
+	if err != nil {
+		return nil, err
+	}
 	params.Set("path", path)
 
 	response, err := conn.DoRequest(nil, http.MethodGet, "/containers/%s/archive", params, nil, nameOrID)
@@ -371,6 +371,56 @@ func ExecStartAndAttach(ctx context.Context, sessionID string, options *ExecStar
 	if options == nil {
 		options = new(ExecStartAndAttachOptions)
 	}
+	body := struct {
+		Detach bool `json:"Detach"`
+	}{
+		Detach: false,
+	}
+	bodyJSON, err := json.Marshal(body)
+	if err != nil {
+		return err
+	}
+	logrus.Debugf("Starting & Attaching to exec session ID %q", sessionID)
+	return execAttach(ctx, sessionID, options, "/exec/%s/start", nil, bodyJSON)
+}
+
+// ExecAttach attaches to an exec session that is already running, for
+// example one that was started detached.
+func ExecAttach(ctx context.Context, sessionID string, options *ExecAttachOptions) error {
+	if options == nil {
+		options = new(ExecAttachOptions)
+	}
+	params := url.Values{}
+	if options.Changed("DetachKeys") {
+		params.Set("detachKeys", options.GetDetachKeys())
+	}
+	if options.Changed("RmSession") {
+		params.Set("rmSession", strconv.FormatBool(options.GetRmSession()))
+	}
+	streams := new(ExecStartAndAttachOptions)
+	if options.Changed("OutputStream") {
+		streams.WithOutputStream(options.GetOutputStream())
+	}
+	if options.Changed("ErrorStream") {
+		streams.WithErrorStream(options.GetErrorStream())
+	}
+	if options.Changed("InputStream") {
+		streams.WithInputStream(options.GetInputStream())
+	}
+	streams.WithAttachOutput(options.GetAttachOutput())
+	streams.WithAttachError(options.GetAttachError())
+	streams.WithAttachInput(options.GetAttachInput())
+	params.Set("stdin", strconv.FormatBool(options.GetAttachInput()))
+	params.Set("stdout", strconv.FormatBool(options.GetAttachOutput()))
+	params.Set("stderr", strconv.FormatBool(options.GetAttachError()))
+
+	logrus.Debugf("Attaching to exec session ID %q", sessionID)
+	return execAttach(ctx, sessionID, streams, "/exec/%s/attach", params, nil)
+}
+
+// execAttach sends a request to endpoint that hijacks the connection and
+// copies the streams of the exec session to and from it.
+func execAttach(ctx context.Context, sessionID string, options *ExecStartAndAttachOptions, endpoint string, params url.Values, bodyJSON []byte) error {
 	conn, err := bindings.GetClient(ctx)
 	if err != nil {
 		return err
@@ -380,8 +430,6 @@ func ExecStartAndAttach(ctx context.Context, sessionID string, options *ExecStar
 	// buffered)
 	terminalFile := os.Stdin
 
-	logrus.Debugf("Starting & Attaching to exec session ID %q", sessionID)
-
 	// We need to inspect the exec session first to determine whether to use
 	// -t.
 	resp, err := conn.DoRequest(nil, http.MethodGet, "/exec/%s/json", nil, nil, sessionID)
@@ -414,14 +462,9 @@ func ExecStartAndAttach(ctx context.Context, sessionID string, options *ExecStar
 		}()
 	}
 
-	body := struct {
-		Detach bool `json:"Detach"`
-	}{
-		Detach: false,
-	}
-	bodyJSON, err := json.Marshal(body)
-	if err != nil {
-		return err
+	var body io.Reader
+	if bodyJSON != nil {
+		body = bytes.NewReader(bodyJSON)
 	}
 
 	var socket net.Conn
@@ -442,7 +485,7 @@ func ExecStartAndAttach(ctx context.Context, sessionID string, options *ExecStar
 		IdleConnTimeout: time.Duration(0),
 	}
 	conn.Client.Transport = t
-	response, err := conn.DoRequest(bytes.NewReader(bodyJSON), http.MethodPost, "/exec/%s/start", nil, nil, sessionID)
+	response, err := conn.DoRequest(body, http.MethodPost, endpoint, params, nil, sessionID)
 	if err != nil {
 		return err
 	}
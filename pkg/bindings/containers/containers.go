@@ -331,6 +331,9 @@ func Wait(ctx context.Context, nameOrID string, options *WaitOptions) (int32, er
 	if options.Changed("Condition") {
 		params.Set("condition", options.GetCondition().String())
 	}
+	for _, condition := range options.GetConditions() {
+		params.Add("condition", condition)
+	}
 	response, err := conn.DoRequest(nil, http.MethodPost, "/containers/%s/wait", params, nil, nameOrID)
 	if err != nil {
 		return exitCode, err
@@ -106,3 +106,28 @@ func ExecStart(ctx context.Context, sessionID string, options *ExecStartOptions)
 
 	return resp.Process(nil)
 }
+
+// ExecSessions lists the exec sessions of a container, including sessions
+// that have already exited but were not removed.
+func ExecSessions(ctx context.Context, nameOrID string, options *ExecSessionsOptions) ([]*define.InspectExecSession, error) {
+	if options == nil {
+		options = new(ExecSessionsOptions)
+	}
+	_ = options
+	conn, err := bindings.GetClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := conn.DoRequest(nil, http.MethodGet, "/containers/%s/exec/json", nil, nil, nameOrID)
+	if err != nil {
+		return nil, err
+	}
+
+	var sessions []*define.InspectExecSession
+	if err := resp.Process(&sessions); err != nil {
+		return nil, err
+	}
+
+	return sessions, nil
+}
@@ -25,13 +25,15 @@ type LogOptions struct {
 // image as defined by repo and tag. None of these options
 // are required.
 type CommitOptions struct {
-	Author  *string
-	Changes []string
-	Comment *string
-	Format  *string
-	Pause   *bool
-	Repo    *string
-	Tag     *string
+	Author    *string
+	Changes   []string
+	Comment   *string
+	Format    *string
+	Pause     *bool
+	Repo      *string
+	Squash    *bool
+	SquashNew *string
+	Tag       *string
 }
 
 //go:generate go run ../generator/generator.go AttachOptions
@@ -45,6 +47,7 @@ type AttachOptions struct {
 //go:generate go run ../generator/generator.go CheckpointOptions
 // CheckpointOptions are optional options for checkpointing containers
 type CheckpointOptions struct {
+	CreateImage    *string
 	Export         *string
 	IgnoreRootfs   *bool
 	Keep           *bool
@@ -58,6 +61,7 @@ type RestoreOptions struct {
 	IgnoreRootfs    *bool
 	IgnoreStaticIP  *bool
 	IgnoreStaticMAC *bool
+	IgnoreVolumes   *bool
 	ImportAchive    *string
 	Keep            *bool
 	Name            *string
@@ -68,15 +72,51 @@ type RestoreOptions struct {
 // CreateOptions are optional options for creating containers
 type CreateOptions struct{}
 
+//go:generate go run ../generator/generator.go CopyOptions
+// CopyOptions are optional options for copying files into and out of
+// containers
+type CopyOptions struct {
+	CopyUIDGID *bool
+}
+
 //go:generate go run ../generator/generator.go DiffOptions
 // DiffOptions are optional options for creating containers
-type DiffOptions struct{}
+type DiffOptions struct {
+	Parent *string
+}
 
 //go:generate go run ../generator/generator.go ExecInspectOptions
 // ExecInspectOptions are optional options for inspecting
 // exec sessions
 type ExecInspectOptions struct{}
 
+//go:generate go run ../generator/generator.go ExecSessionsOptions
+// ExecSessionsOptions are optional options for listing the exec sessions of
+// a container
+type ExecSessionsOptions struct{}
+
+//go:generate go run ../generator/generator.go ExecAttachOptions
+// ExecAttachOptions are optional options for attaching to a running exec
+// session
+type ExecAttachOptions struct {
+	// OutputStream will be attached to the exec session's STDOUT
+	OutputStream *io.WriteCloser
+	// ErrorStream will be attached to the exec session's STDERR
+	ErrorStream *io.WriteCloser
+	// InputStream will be attached to the exec session's STDIN
+	InputStream *bufio.Reader
+	// AttachOutput is whether to attach to STDOUT
+	AttachOutput *bool
+	// AttachError is whether to attach to STDERR
+	AttachError *bool
+	// AttachInput is whether to attach to STDIN
+	AttachInput *bool
+	// DetachKeys overrides the detach keys of the exec session
+	DetachKeys *string
+	// RmSession removes the exec session once it exited and was inspected
+	RmSession *bool
+}
+
 //go:generate go run ../generator/generator.go ExecStartOptions
 // ExecStartOptions are optional options for starting
 // exec sessions
@@ -156,7 +196,8 @@ type StartOptions struct {
 //go:generate go run ../generator/generator.go StatsOptions
 // StatsOptions are optional options for getting stats on containers
 type StatsOptions struct {
-	Stream *bool
+	Stream   *bool
+	Interval *int
 }
 
 //go:generate go run ../generator/generator.go TopOptions
@@ -173,7 +214,8 @@ type UnpauseOptions struct{}
 //go:generate go run ../generator/generator.go WaitOptions
 // WaitOptions are optional options for waiting on containers
 type WaitOptions struct {
-	Condition *define.ContainerStatus
+	Condition  *define.ContainerStatus
+	Conditions []string
 }
 
 //go:generate go run ../generator/generator.go StopOptions
@@ -201,6 +243,13 @@ type RenameOptions struct {
 	Name *string
 }
 
+//go:generate go run ../generator/generator.go UpdateOptions
+// UpdateOptions are optional options for updating containers
+type UpdateOptions struct {
+	RestartPolicy  *string
+	RestartRetries *uint
+}
+
 //go:generate go run ../generator/generator.go ResizeTTYOptions
 // ResizeTTYOptions are optional options for resizing
 // container TTYs
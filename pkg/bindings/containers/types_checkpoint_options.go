@@ -87,6 +87,22 @@ func (o *CheckpointOptions) ToParams() (url.Values, error) {
 	return params, nil
 }
 
+// WithCreateImage
+func (o *CheckpointOptions) WithCreateImage(value string) *CheckpointOptions {
+	v := &value
+	o.CreateImage = v
+	return o
+}
+
+// GetCreateImage
+func (o *CheckpointOptions) GetCreateImage() string {
+	var createImage string
+	if o.CreateImage == nil {
+		return createImage
+	}
+	return *o.CreateImage
+}
+
 // WithExport
 func (o *CheckpointOptions) WithExport(value string) *CheckpointOptions {
 	v := &value
@@ -183,6 +183,38 @@ func (o *CommitOptions) GetRepo() string {
 	return *o.Repo
 }
 
+// WithSquash
+func (o *CommitOptions) WithSquash(value bool) *CommitOptions {
+	v := &value
+	o.Squash = v
+	return o
+}
+
+// GetSquash
+func (o *CommitOptions) GetSquash() bool {
+	var squash bool
+	if o.Squash == nil {
+		return squash
+	}
+	return *o.Squash
+}
+
+// WithSquashNew
+func (o *CommitOptions) WithSquashNew(value string) *CommitOptions {
+	v := &value
+	o.SquashNew = v
+	return o
+}
+
+// GetSquashNew
+func (o *CommitOptions) GetSquashNew() string {
+	var squashNew string
+	if o.SquashNew == nil {
+		return squashNew
+	}
+	return *o.SquashNew
+}
+
 // WithTag
 func (o *CommitOptions) WithTag(value string) *CommitOptions {
 	v := &value
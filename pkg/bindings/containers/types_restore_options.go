@@ -135,6 +135,22 @@ func (o *RestoreOptions) GetIgnoreStaticMAC() bool {
 	return *o.IgnoreStaticMAC
 }
 
+// WithIgnoreVolumes
+func (o *RestoreOptions) WithIgnoreVolumes(value bool) *RestoreOptions {
+	v := &value
+	o.IgnoreVolumes = v
+	return o
+}
+
+// GetIgnoreVolumes
+func (o *RestoreOptions) GetIgnoreVolumes() bool {
+	var ignoreVolumes bool
+	if o.IgnoreVolumes == nil {
+		return ignoreVolumes
+	}
+	return *o.IgnoreVolumes
+}
+
 // WithImportAchive
 func (o *RestoreOptions) WithImportAchive(value string) *RestoreOptions {
 	v := &value
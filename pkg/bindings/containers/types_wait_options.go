@@ -103,3 +103,19 @@ func (o *WaitOptions) GetCondition() define.ContainerStatus {
 	}
 	return *o.Condition
 }
+
+// WithConditions
+func (o *WaitOptions) WithConditions(value []string) *WaitOptions {
+	v := value
+	o.Conditions = v
+	return o
+}
+
+// GetConditions
+func (o *WaitOptions) GetConditions() []string {
+	var conditions []string
+	if o.Conditions == nil {
+		return conditions
+	}
+	return o.Conditions
+}
@@ -0,0 +1,43 @@
+package containers
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/containers/podman/v2/pkg/bindings"
+	jsoniter "github.com/json-iterator/go"
+	spec "github.com/opencontainers/runtime-spec/specs-go"
+)
+
+// Update changes the resource limits and the restart policy of a container.
+// Only the CPU, cpuset, memory, pids and block IO weight limits set in
+// resources are changed, resources may be nil to change only the restart
+// policy.
+func Update(ctx context.Context, nameOrID string, resources *spec.LinuxResources, options *UpdateOptions) error {
+	if options == nil {
+		options = new(UpdateOptions)
+	}
+	conn, err := bindings.GetClient(ctx)
+	if err != nil {
+		return err
+	}
+	params, err := options.ToParams()
+	if err != nil {
+		return err
+	}
+	var body io.Reader
+	if resources != nil {
+		resourcesString, err := jsoniter.MarshalToString(resources)
+		if err != nil {
+			return err
+		}
+		body = strings.NewReader(resourcesString)
+	}
+	response, err := conn.DoRequest(body, http.MethodPost, "/containers/%s/update", params, nil, nameOrID)
+	if err != nil {
+		return err
+	}
+	return response.Process(nil)
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"net/http"
 
+	"github.com/containers/podman/v2/libpod/define"
 	"github.com/containers/podman/v2/pkg/bindings"
 	"github.com/containers/storage/pkg/archive"
 )
@@ -13,16 +14,43 @@ func Diff(ctx context.Context, nameOrID string, options *DiffOptions) ([]archive
 	if options == nil {
 		options = new(DiffOptions)
 	}
-	_ = options
 	conn, err := bindings.GetClient(ctx)
 	if err != nil {
 		return nil, err
 	}
+	params, err := options.ToParams()
+	if err != nil {
+		return nil, err
+	}
 
-	response, err := conn.DoRequest(nil, http.MethodGet, "/images/%s/changes", nil, nil, nameOrID)
+	response, err := conn.DoRequest(nil, http.MethodGet, "/images/%s/changes", params, nil, nameOrID)
 	if err != nil {
 		return nil, err
 	}
 	var changes []archive.Change
 	return changes, response.Process(&changes)
 }
+
+// DiffDetails provides the changes between two image layers along with the
+// size, mode, ownership and content digest of the changed paths
+func DiffDetails(ctx context.Context, nameOrID string, options *DiffOptions) ([]define.ChangeDetails, error) {
+	if options == nil {
+		options = new(DiffOptions)
+	}
+	conn, err := bindings.GetClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+	params, err := options.ToParams()
+	if err != nil {
+		return nil, err
+	}
+	params.Set("detail", "true")
+
+	response, err := conn.DoRequest(nil, http.MethodGet, "/images/%s/changes", params, nil, nameOrID)
+	if err != nil {
+		return nil, err
+	}
+	var details []define.ChangeDetails
+	return details, response.Process(&details)
+}
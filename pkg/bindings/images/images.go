@@ -13,6 +13,7 @@ import (
 	"github.com/containers/podman/v2/pkg/bindings"
 	"github.com/containers/podman/v2/pkg/domain/entities"
 	"github.com/containers/podman/v2/pkg/domain/entities/reports"
+	dockerRegistry "github.com/docker/docker/api/types/registry"
 	"github.com/pkg/errors"
 )
 
@@ -325,3 +326,32 @@ func Search(ctx context.Context, term string, options *SearchOptions) ([]entitie
 
 	return results, nil
 }
+
+// InspectDistribution returns the descriptor of the manifest of an image on
+// a registry and the platforms it supports.  The image is not pulled.
+func InspectDistribution(ctx context.Context, name string, options *InspectDistributionOptions) (*dockerRegistry.DistributionInspect, error) {
+	if options == nil {
+		options = new(InspectDistributionOptions)
+	}
+	conn, err := bindings.GetClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+	params := url.Values{}
+	if options.SkipTLSVerify != nil {
+		// Note: we have to verify if skipped is false.
+		params.Set("tlsVerify", strconv.FormatBool(!options.GetSkipTLSVerify()))
+	}
+
+	header, err := auth.Header(nil, auth.XRegistryAuthHeader, options.GetAuthfile(), options.GetUsername(), options.GetPassword())
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := conn.DoRequest(nil, http.MethodGet, "/distribution/%s/json", params, header, name)
+	if err != nil {
+		return nil, err
+	}
+	report := dockerRegistry.DistributionInspect{}
+	return &report, response.Process(&report)
+}
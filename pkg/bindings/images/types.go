@@ -17,6 +17,7 @@ type RemoveOptions struct {
 //go:generate go run ../generator/generator.go DiffOptions
 // DiffOptions are optional options image diffs
 type DiffOptions struct {
+	Parent *string
 }
 
 //go:generate go run ../generator/generator.go ListOptions
@@ -71,6 +72,20 @@ type PruneOptions struct {
 	All *bool
 	// Filters to apply when pruning images
 	Filters map[string][]string
+	// DryRun reports the images that would be pruned without removing them
+	DryRun *bool
+	// GC evicts unused images according to the image garbage collection
+	// policy instead of pruning dangling images
+	GC *bool
+	// GCHighThreshold is the percentage of the graph root's file system
+	// usage above which GC evicts images
+	GCHighThreshold *uint
+	// GCLowThreshold is the percentage of the graph root's file system
+	// usage GC frees space down to
+	GCLowThreshold *uint
+	// GCMinAge is the minimum time since an image was last used before GC
+	// may evict it (e.g., "2m")
+	GCMinAge *string
 }
 
 //go:generate go run ../generator/generator.go TagOptions
@@ -155,6 +170,21 @@ type SearchOptions struct {
 	ListTags *bool
 }
 
+//go:generate go run ../generator/generator.go InspectDistributionOptions
+// InspectDistributionOptions are optional options for inspecting an image on
+// its registry
+type InspectDistributionOptions struct {
+	// Authfile is the path to the authentication file. Ignored for remote
+	// calls.
+	Authfile *string
+	// Username for authenticating against the registry.
+	Username *string
+	// Password for authenticating against the registry.
+	Password *string
+	// SkipTLSVerify to skip HTTPS and certificate verification.
+	SkipTLSVerify *bool
+}
+
 //go:generate go run ../generator/generator.go PullOptions
 // PullOptions are optional options for pulling images
 type PullOptions struct {
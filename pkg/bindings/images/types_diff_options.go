@@ -86,3 +86,19 @@ func (o *DiffOptions) ToParams() (url.Values, error) {
 	}
 	return params, nil
 }
+
+// WithParent
+func (o *DiffOptions) WithParent(value string) *DiffOptions {
+	v := &value
+	o.Parent = v
+	return o
+}
+
+// GetParent
+func (o *DiffOptions) GetParent() string {
+	var parent string
+	if o.Parent == nil {
+		return parent
+	}
+	return *o.Parent
+}
@@ -118,3 +118,83 @@ func (o *PruneOptions) GetFilters() map[string][]string {
 	}
 	return o.Filters
 }
+
+// WithDryRun
+func (o *PruneOptions) WithDryRun(value bool) *PruneOptions {
+	v := &value
+	o.DryRun = v
+	return o
+}
+
+// GetDryRun
+func (o *PruneOptions) GetDryRun() bool {
+	var dryRun bool
+	if o.DryRun == nil {
+		return dryRun
+	}
+	return *o.DryRun
+}
+
+// WithGC
+func (o *PruneOptions) WithGC(value bool) *PruneOptions {
+	v := &value
+	o.GC = v
+	return o
+}
+
+// GetGC
+func (o *PruneOptions) GetGC() bool {
+	var gC bool
+	if o.GC == nil {
+		return gC
+	}
+	return *o.GC
+}
+
+// WithGCHighThreshold
+func (o *PruneOptions) WithGCHighThreshold(value uint) *PruneOptions {
+	v := &value
+	o.GCHighThreshold = v
+	return o
+}
+
+// GetGCHighThreshold
+func (o *PruneOptions) GetGCHighThreshold() uint {
+	var gCHighThreshold uint
+	if o.GCHighThreshold == nil {
+		return gCHighThreshold
+	}
+	return *o.GCHighThreshold
+}
+
+// WithGCLowThreshold
+func (o *PruneOptions) WithGCLowThreshold(value uint) *PruneOptions {
+	v := &value
+	o.GCLowThreshold = v
+	return o
+}
+
+// GetGCLowThreshold
+func (o *PruneOptions) GetGCLowThreshold() uint {
+	var gCLowThreshold uint
+	if o.GCLowThreshold == nil {
+		return gCLowThreshold
+	}
+	return *o.GCLowThreshold
+}
+
+// WithGCMinAge
+func (o *PruneOptions) WithGCMinAge(value string) *PruneOptions {
+	v := &value
+	o.GCMinAge = v
+	return o
+}
+
+// GetGCMinAge
+func (o *PruneOptions) GetGCMinAge() string {
+	var gCMinAge string
+	if o.GCMinAge == nil {
+		return gCMinAge
+	}
+	return *o.GCMinAge
+}
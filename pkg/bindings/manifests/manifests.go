@@ -12,6 +12,7 @@ import (
 	"github.com/containers/podman/v2/pkg/api/handlers"
 	"github.com/containers/podman/v2/pkg/bindings"
 	"github.com/containers/podman/v2/pkg/bindings/images"
+	"github.com/containers/podman/v2/pkg/domain/entities"
 	jsoniter "github.com/json-iterator/go"
 )
 
@@ -49,6 +50,24 @@ func Create(ctx context.Context, names, images []string, options *CreateOptions)
 	return idr.ID, response.Process(&idr)
 }
 
+// Exists returns true if a manifest list with the given name exists in local
+// storage.
+func Exists(ctx context.Context, name string, options *ExistsOptions) (bool, error) {
+	if options == nil {
+		options = new(ExistsOptions)
+	}
+	_ = options
+	conn, err := bindings.GetClient(ctx)
+	if err != nil {
+		return false, err
+	}
+	response, err := conn.DoRequest(nil, http.MethodGet, "/manifests/%s/exists", nil, nil, name)
+	if err != nil {
+		return false, err
+	}
+	return response.IsSuccess(), nil
+}
+
 // Inspect returns a manifest list for a given name.
 func Inspect(ctx context.Context, name string, options *InspectOptions) (*manifest.Schema2List, error) {
 	var list manifest.Schema2List
@@ -111,6 +130,30 @@ func Remove(ctx context.Context, name, digest string, options *RemoveOptions) (s
 	return idr.ID, response.Process(&idr)
 }
 
+// Delete removes a manifest list from local storage.  The images referenced
+// by the list are not removed.
+func Delete(ctx context.Context, name string, options *DeleteOptions) (*entities.ImageRemoveReport, error) {
+	var report handlers.LibpodImagesRemoveReport
+	if options == nil {
+		options = new(DeleteOptions)
+	}
+	_ = options
+	conn, err := bindings.GetClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+	params := url.Values{}
+	params.Set("all", "true")
+	response, err := conn.DoRequest(nil, http.MethodDelete, "/manifests/%s", params, nil, name)
+	if err != nil {
+		return nil, err
+	}
+	if err := response.Process(&report); err != nil {
+		return nil, err
+	}
+	return &report.ImageRemoveReport, nil
+}
+
 // Push takes a manifest list and pushes to a destination.  If the destination is not specified,
 // the name will be used instead.  If the optional all boolean is specified, all images specified
 // in the list will be pushed as well.
@@ -28,3 +28,13 @@ type AddOptions struct {
 // RemoveOptions are optional options for removing manifests
 type RemoveOptions struct {
 }
+
+//go:generate go run ../generator/generator.go ExistsOptions
+// ExistsOptions are optional options for checking if a manifest list exists
+type ExistsOptions struct {
+}
+
+//go:generate go run ../generator/generator.go DeleteOptions
+// DeleteOptions are optional options for deleting manifest lists
+type DeleteOptions struct {
+}
@@ -2,6 +2,7 @@ package network
 
 import (
 	"context"
+	"net"
 	"net/http"
 	"net/url"
 	"strings"
@@ -55,6 +56,19 @@ func Inspect(ctx context.Context, nameOrID string, options *InspectOptions) ([]e
 	return reports, response.Process(&reports)
 }
 
+// Exists returns true if a given network exists
+func Exists(ctx context.Context, name string) (bool, error) {
+	conn, err := bindings.GetClient(ctx)
+	if err != nil {
+		return false, err
+	}
+	response, err := conn.DoRequest(nil, http.MethodGet, "/networks/%s/exists", nil, nil, name)
+	if err != nil {
+		return false, err
+	}
+	return response.IsSuccess(), nil
+}
+
 // Remove deletes a defined CNI network configuration by name.  The optional force boolean
 // will remove all containers associated with the network when set to true.  A slice
 // of NetworkRemoveReports are returned.
@@ -150,12 +164,20 @@ func Connect(ctx context.Context, networkName string, ContainerNameOrId string,
 	connect := struct {
 		Container string
 		Aliases   []string
+		StaticIP  net.IP
+		StaticMAC net.HardwareAddr
 	}{
 		Container: ContainerNameOrId,
 	}
 	if aliases := options.GetAliases(); options.Changed("Aliases") {
 		connect.Aliases = aliases
 	}
+	if options.Changed("StaticIP") {
+		connect.StaticIP = options.GetStaticIP()
+	}
+	if options.Changed("StaticMAC") {
+		connect.StaticMAC = options.GetStaticMAC()
+	}
 	body, err := jsoniter.MarshalToString(connect)
 	if err != nil {
 		return err
@@ -167,3 +189,31 @@ func Connect(ctx context.Context, networkName string, ContainerNameOrId string,
 	}
 	return response.Process(nil)
 }
+
+// Update adds or drops the DNS servers and labels of a network
+func Update(ctx context.Context, networkName string, options *UpdateOptions) error {
+	if options == nil {
+		options = new(UpdateOptions)
+	}
+	conn, err := bindings.GetClient(ctx)
+	if err != nil {
+		return err
+	}
+	// Update sends everything in body
+	update := entities.NetworkUpdateOptions{
+		DNSAdd:   options.GetDNSAdd(),
+		DNSDrop:  options.GetDNSDrop(),
+		LabelAdd: options.GetLabelAdd(),
+		LabelRm:  options.GetLabelRm(),
+	}
+	body, err := jsoniter.MarshalToString(update)
+	if err != nil {
+		return err
+	}
+	stringReader := strings.NewReader(body)
+	response, err := conn.DoRequest(stringReader, http.MethodPost, "/networks/%s/update", nil, nil, networkName)
+	if err != nil {
+		return err
+	}
+	return response.Process(nil)
+}
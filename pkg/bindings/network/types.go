@@ -67,4 +67,25 @@ type ConnectOptions struct {
 	// Aliases are names the container will be known as
 	// when using the dns plugin
 	Aliases *[]string
+	// StaticIP is the IP address requested for the container
+	// in the network
+	StaticIP *net.IP
+	// StaticMAC is the MAC address requested for the container
+	// in the network
+	StaticMAC *net.HardwareAddr
+}
+
+//go:generate go run ../generator/generator.go UpdateOptions
+// UpdateOptions are optional options for updating
+// the DNS servers and labels of a network
+type UpdateOptions struct {
+	// DNSAdd are the DNS servers to add to the network
+	DNSAdd *[]string
+	// DNSDrop are the DNS servers to drop from the network
+	DNSDrop *[]string
+	// LabelAdd are the labels to add to the network
+	LabelAdd map[string]string
+	// LabelRm are the keys of the labels to remove
+	// from the network
+	LabelRm *[]string
 }
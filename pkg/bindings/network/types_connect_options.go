@@ -1,6 +1,7 @@
 package network
 
 import (
+	"net"
 	"net/url"
 	"reflect"
 	"strconv"
@@ -102,3 +103,35 @@ func (o *ConnectOptions) GetAliases() []string {
 	}
 	return *o.Aliases
 }
+
+// WithStaticIP
+func (o *ConnectOptions) WithStaticIP(value net.IP) *ConnectOptions {
+	v := &value
+	o.StaticIP = v
+	return o
+}
+
+// GetStaticIP
+func (o *ConnectOptions) GetStaticIP() net.IP {
+	var staticIP net.IP
+	if o.StaticIP == nil {
+		return staticIP
+	}
+	return *o.StaticIP
+}
+
+// WithStaticMAC
+func (o *ConnectOptions) WithStaticMAC(value net.HardwareAddr) *ConnectOptions {
+	v := &value
+	o.StaticMAC = v
+	return o
+}
+
+// GetStaticMAC
+func (o *ConnectOptions) GetStaticMAC() net.HardwareAddr {
+	var staticMAC net.HardwareAddr
+	if o.StaticMAC == nil {
+		return staticMAC
+	}
+	return *o.StaticMAC
+}
@@ -0,0 +1,152 @@
+package network
+
+import (
+	"net/url"
+	"reflect"
+	"strconv"
+	"strings"
+
+	jsoniter "github.com/json-iterator/go"
+	"github.com/pkg/errors"
+)
+
+/*
+This file is generated automatically by go generate.  Do not edit.
+*/
+
+// Changed
+func (o *UpdateOptions) Changed(fieldName string) bool {
+	r := reflect.ValueOf(o)
+	value := reflect.Indirect(r).FieldByName(fieldName)
+	return !value.IsNil()
+}
+
+// ToParams
+func (o *UpdateOptions) ToParams() (url.Values, error) {
+	params := url.Values{}
+	if o == nil {
+		return params, nil
+	}
+	json := jsoniter.ConfigCompatibleWithStandardLibrary
+	s := reflect.ValueOf(o)
+	if reflect.Ptr == s.Kind() {
+		s = s.Elem()
+	}
+	sType := s.Type()
+	for i := 0; i < s.NumField(); i++ {
+		fieldName := sType.Field(i).Name
+		if !o.Changed(fieldName) {
+			continue
+		}
+		fieldName = strings.ToLower(fieldName)
+		f := s.Field(i)
+		if reflect.Ptr == f.Kind() {
+			f = f.Elem()
+		}
+		switch f.Kind() {
+		case reflect.Bool:
+			params.Set(fieldName, strconv.FormatBool(f.Bool()))
+		case reflect.String:
+			params.Set(fieldName, f.String())
+		case reflect.Int, reflect.Int64:
+			// f.Int() is always an int64
+			params.Set(fieldName, strconv.FormatInt(f.Int(), 10))
+		case reflect.Uint, reflect.Uint64:
+			// f.Uint() is always an uint64
+			params.Set(fieldName, strconv.FormatUint(f.Uint(), 10))
+		case reflect.Slice:
+			typ := reflect.TypeOf(f.Interface()).Elem()
+			switch typ.Kind() {
+			case reflect.String:
+				sl := f.Slice(0, f.Len())
+				s, ok := sl.Interface().([]string)
+				if !ok {
+					return nil, errors.New("failed to convert to string slice")
+				}
+				for _, val := range s {
+					params.Add(fieldName, val)
+				}
+			default:
+				return nil, errors.Errorf("unknown slice type %s", f.Kind().String())
+			}
+		case reflect.Map:
+			lowerCaseKeys := make(map[string][]string)
+			iter := f.MapRange()
+			for iter.Next() {
+				lowerCaseKeys[iter.Key().Interface().(string)] = iter.Value().Interface().([]string)
+
+			}
+			s, err := json.MarshalToString(lowerCaseKeys)
+			if err != nil {
+				return nil, err
+			}
+
+			params.Set(fieldName, s)
+		}
+	}
+	return params, nil
+}
+
+// WithDNSAdd
+func (o *UpdateOptions) WithDNSAdd(value []string) *UpdateOptions {
+	v := &value
+	o.DNSAdd = v
+	return o
+}
+
+// GetDNSAdd
+func (o *UpdateOptions) GetDNSAdd() []string {
+	var dNSAdd []string
+	if o.DNSAdd == nil {
+		return dNSAdd
+	}
+	return *o.DNSAdd
+}
+
+// WithDNSDrop
+func (o *UpdateOptions) WithDNSDrop(value []string) *UpdateOptions {
+	v := &value
+	o.DNSDrop = v
+	return o
+}
+
+// GetDNSDrop
+func (o *UpdateOptions) GetDNSDrop() []string {
+	var dNSDrop []string
+	if o.DNSDrop == nil {
+		return dNSDrop
+	}
+	return *o.DNSDrop
+}
+
+// WithLabelAdd
+func (o *UpdateOptions) WithLabelAdd(value map[string]string) *UpdateOptions {
+	v := value
+	o.LabelAdd = v
+	return o
+}
+
+// GetLabelAdd
+func (o *UpdateOptions) GetLabelAdd() map[string]string {
+	var labelAdd map[string]string
+	if o.LabelAdd == nil {
+		return labelAdd
+	}
+	return o.LabelAdd
+}
+
+// WithLabelRm
+func (o *UpdateOptions) WithLabelRm(value []string) *UpdateOptions {
+	v := &value
+	o.LabelRm = v
+	return o
+}
+
+// GetLabelRm
+func (o *UpdateOptions) GetLabelRm() []string {
+	var labelRm []string
+	if o.LabelRm == nil {
+		return labelRm
+	}
+	return *o.LabelRm
+}
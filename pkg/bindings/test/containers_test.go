@@ -13,6 +13,7 @@ import (
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	"github.com/onsi/gomega/gexec"
+	spec "github.com/opencontainers/runtime-spec/specs-go"
 )
 
 var _ = Describe("Podman containers ", func() {
@@ -763,4 +764,37 @@ var _ = Describe("Podman containers ", func() {
 		Expect(len(c)).To(Equal(1))
 		Expect(c[0].PodName).To(Equal(podName))
 	})
+
+	It("podman update running container", func() {
+		var name = "top"
+		_, err := bt.RunTopContainer(&name, bindings.PFalse, nil)
+		Expect(err).To(BeNil())
+
+		limit := int64(64 * 1024 * 1024)
+		resources := spec.LinuxResources{
+			Memory: &spec.LinuxMemory{Limit: &limit},
+			Pids:   &spec.LinuxPids{Limit: 64},
+		}
+		err = containers.Update(bt.conn, name, &resources, new(containers.UpdateOptions).WithRestartPolicy("on-failure").WithRestartRetries(3))
+		Expect(err).To(BeNil())
+
+		data, err := containers.Inspect(bt.conn, name, nil)
+		Expect(err).To(BeNil())
+		Expect(data.HostConfig.Memory).To(Equal(limit))
+		Expect(data.HostConfig.PidsLimit).To(Equal(int64(64)))
+		Expect(data.HostConfig.RestartPolicy.Name).To(Equal("on-failure"))
+		Expect(data.HostConfig.RestartPolicy.MaximumRetryCount).To(Equal(uint(3)))
+
+		// Retries are only valid with the on-failure policy
+		err = containers.Update(bt.conn, name, nil, new(containers.UpdateOptions).WithRestartPolicy("always").WithRestartRetries(3))
+		Expect(err).ToNot(BeNil())
+		code, _ := bindings.CheckResponseCode(err)
+		Expect(code).To(BeNumerically("==", http.StatusBadRequest))
+
+		// Updating a non-existent container should fail
+		err = containers.Update(bt.conn, "foobar", nil, new(containers.UpdateOptions).WithRestartPolicy("always"))
+		Expect(err).ToNot(BeNil())
+		code, _ = bindings.CheckResponseCode(err)
+		Expect(code).To(BeNumerically("==", http.StatusNotFound))
+	})
 })
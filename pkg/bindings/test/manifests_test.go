@@ -64,6 +64,43 @@ var _ = Describe("Podman containers ", func() {
 		Expect(code).To(BeNumerically("==", http.StatusNotFound))
 	})
 
+	It("exists and delete manifest", func() {
+		exists, err := manifests.Exists(bt.conn, "quay.io/libpod/foobar:latest", nil)
+		Expect(err).To(BeNil())
+		Expect(exists).To(BeFalse())
+
+		id, err := manifests.Create(bt.conn, []string{"quay.io/libpod/foobar:latest"}, []string{}, nil)
+		Expect(err).To(BeNil())
+		exists, err = manifests.Exists(bt.conn, id, nil)
+		Expect(err).To(BeNil())
+		Expect(exists).To(BeTrue())
+
+		// an image which is not a manifest list
+		exists, err = manifests.Exists(bt.conn, alpine.name, nil)
+		Expect(err).To(BeNil())
+		Expect(exists).To(BeFalse())
+		_, err = manifests.Delete(bt.conn, alpine.name, nil)
+		Expect(err).ToNot(BeNil())
+		code, _ := bindings.CheckResponseCode(err)
+		Expect(code).To(BeNumerically("==", http.StatusNotFound))
+
+		// removing without a digest must not delete the list
+		_, err = manifests.Remove(bt.conn, id, "", nil)
+		Expect(err).ToNot(BeNil())
+		code, _ = bindings.CheckResponseCode(err)
+		Expect(code).To(BeNumerically("==", http.StatusBadRequest))
+		exists, err = manifests.Exists(bt.conn, id, nil)
+		Expect(err).To(BeNil())
+		Expect(exists).To(BeTrue())
+
+		report, err := manifests.Delete(bt.conn, id, nil)
+		Expect(err).To(BeNil())
+		Expect(report.Deleted).To(ContainElement(id))
+		exists, err = manifests.Exists(bt.conn, id, nil)
+		Expect(err).To(BeNil())
+		Expect(exists).To(BeFalse())
+	})
+
 	It("add manifest", func() {
 		// add to bogus should 404
 		_, err := manifests.Add(bt.conn, "foobar", nil)
@@ -5,6 +5,16 @@ package volumes
 type CreateOptions struct {
 }
 
+//go:generate go run ../generator/generator.go ExportOptions
+// ExportOptions are optional options for exporting volumes
+type ExportOptions struct {
+}
+
+//go:generate go run ../generator/generator.go ImportOptions
+// ImportOptions are optional options for importing volumes
+type ImportOptions struct {
+}
+
 //go:generate go run ../generator/generator.go InspectOptions
 // InspectOptions are optional options for inspecting volumes
 type InspectOptions struct {
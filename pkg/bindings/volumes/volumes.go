@@ -2,6 +2,7 @@ package volumes
 
 import (
 	"context"
+	"io"
 	"net/http"
 	"strings"
 
@@ -114,3 +115,54 @@ func Remove(ctx context.Context, nameOrID string, options *RemoveOptions) error
 	}
 	return response.Process(nil)
 }
+
+// Exists returns true if a given volume exists
+func Exists(ctx context.Context, name string) (bool, error) {
+	conn, err := bindings.GetClient(ctx)
+	if err != nil {
+		return false, err
+	}
+	response, err := conn.DoRequest(nil, http.MethodGet, "/volumes/%s/exists", nil, nil, name)
+	if err != nil {
+		return false, err
+	}
+	return response.IsSuccess(), nil
+}
+
+// Export writes the contents of the given volume to w as a tar archive.
+func Export(ctx context.Context, nameOrID string, w io.Writer, options *ExportOptions) error {
+	if options == nil {
+		options = new(ExportOptions)
+	}
+	_ = options
+	conn, err := bindings.GetClient(ctx)
+	if err != nil {
+		return err
+	}
+	response, err := conn.DoRequest(nil, http.MethodGet, "/volumes/%s/export", nil, nil, nameOrID)
+	if err != nil {
+		return err
+	}
+	if response.StatusCode/100 == 2 {
+		_, err = io.Copy(w, response.Body)
+		return err
+	}
+	return response.Process(nil)
+}
+
+// Import extracts the tar archive read from r into the given volume.
+func Import(ctx context.Context, nameOrID string, r io.Reader, options *ImportOptions) error {
+	if options == nil {
+		options = new(ImportOptions)
+	}
+	_ = options
+	conn, err := bindings.GetClient(ctx)
+	if err != nil {
+		return err
+	}
+	response, err := conn.DoRequest(r, http.MethodPost, "/volumes/%s/import", nil, nil, nameOrID)
+	if err != nil {
+		return err
+	}
+	return response.Process(nil)
+}
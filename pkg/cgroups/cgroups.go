@@ -70,6 +70,12 @@ type BlkioMetrics struct {
 // MemoryMetrics keeps usage stats for the memory cgroup controller
 type MemoryMetrics struct {
 	Usage MemoryUsage
+	// Cache is the page cache memory, including tmpfs
+	Cache uint64
+	// RSS is the anonymous memory
+	RSS uint64
+	// Swap is the swap usage
+	Swap uint64
 }
 
 // PidsMetrics keeps usage stats for the pids cgroup controller
@@ -2,9 +2,13 @@ package cgroups
 
 import (
 	"fmt"
+	"os"
 	"path/filepath"
+	"strconv"
 
 	spec "github.com/opencontainers/runtime-spec/specs-go"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
 )
 
 type memHandler struct {
@@ -42,15 +46,22 @@ func (c *memHandler) Stat(ctr *CgroupControl, m *Metrics) error {
 
 	var memoryRoot string
 	filenames := map[string]string{}
+	// keys of the memory.stat file
+	statKeys := map[string]string{}
 
 	if ctr.cgroup2 {
 		memoryRoot = filepath.Join(cgroupRoot, ctr.path)
 		filenames["usage"] = "memory.current"
 		filenames["limit"] = "memory.max"
+		statKeys["cache"] = "file"
+		statKeys["rss"] = "anon"
 	} else {
 		memoryRoot = ctr.getCgroupv1Path(Memory)
 		filenames["usage"] = "memory.usage_in_bytes"
 		filenames["limit"] = "memory.limit_in_bytes"
+		statKeys["cache"] = "total_cache"
+		statKeys["rss"] = "total_rss"
+		statKeys["swap"] = "total_swap"
 	}
 	usage.Usage, err = readFileAsUint64(filepath.Join(memoryRoot, filenames["usage"]))
 	if err != nil {
@@ -62,5 +73,54 @@ func (c *memHandler) Stat(ctr *CgroupControl, m *Metrics) error {
 	}
 
 	m.Memory = MemoryMetrics{Usage: usage}
+
+	// Cache, RSS and swap are not essential, so only the usage and limit
+	// are reported if memory.stat cannot be read
+	details, err := memoryStatDetails(ctr, memoryRoot, statKeys)
+	if err != nil {
+		logrus.Debugf("Unable to read the memory details of cgroup %s: %v", ctr.path, err)
+		return nil
+	}
+	m.Memory.Cache = details.Cache
+	m.Memory.RSS = details.RSS
+	m.Memory.Swap = details.Swap
 	return nil
 }
+
+// memoryStatDetails reads the cache, RSS and swap usage from memory.stat and,
+// on cgroup v2, memory.swap.current
+func memoryStatDetails(ctr *CgroupControl, memoryRoot string, statKeys map[string]string) (MemoryMetrics, error) {
+	var details MemoryMetrics
+	values, err := readCgroup2MapPath(filepath.Join(memoryRoot, "memory.stat"))
+	if err != nil {
+		return details, err
+	}
+	details.Cache, err = memoryStatValue(values, statKeys["cache"])
+	if err != nil {
+		return details, err
+	}
+	details.RSS, err = memoryStatValue(values, statKeys["rss"])
+	if err != nil {
+		return details, err
+	}
+	if ctr.cgroup2 {
+		// memory.swap.current is missing without swap accounting
+		details.Swap, err = readFileAsUint64(filepath.Join(memoryRoot, "memory.swap.current"))
+		if err != nil && !os.IsNotExist(errors.Cause(err)) {
+			return details, err
+		}
+		return details, nil
+	}
+	details.Swap, err = memoryStatValue(values, statKeys["swap"])
+	return details, err
+}
+
+// memoryStatValue returns the value of key in the parsed memory.stat file, or
+// 0 if the key is not present
+func memoryStatValue(values map[string][]string, key string) (uint64, error) {
+	val, found := values[key]
+	if !found {
+		return 0, nil
+	}
+	return strconv.ParseUint(cleanString(val[0]), 10, 0)
+}
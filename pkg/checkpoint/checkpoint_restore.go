@@ -7,6 +7,7 @@ import (
 	"path/filepath"
 
 	"github.com/containers/podman/v2/libpod"
+	"github.com/containers/podman/v2/libpod/define"
 	"github.com/containers/podman/v2/libpod/image"
 	"github.com/containers/podman/v2/pkg/domain/entities"
 	"github.com/containers/podman/v2/pkg/errorhandling"
@@ -35,6 +36,17 @@ func crImportFromJSON(filePath string, v interface{}) error {
 	return nil
 }
 
+// CRIsCheckpointImage reports whether the given image has been created by
+// 'podman container checkpoint --create-image'
+func CRIsCheckpointImage(ctx context.Context, img *image.Image) (bool, error) {
+	annotations, err := img.Annotations(ctx)
+	if err != nil {
+		return false, err
+	}
+	_, ok := annotations[define.CheckpointAnnotationName]
+	return ok, nil
+}
+
 // CRImportCheckpoint it the function which imports the information
 // from checkpoint tarball and re-creates the container from that information
 func CRImportCheckpoint(ctx context.Context, runtime *libpod.Runtime, restoreOptions entities.RestoreOptions) ([]*libpod.Container, error) {
@@ -12,22 +12,14 @@ import (
 // they start with a dot or slash.
 //
 // It returns, in order, the source container and path, followed by the
-// destination container and path, and an error.  Note that exactly one
+// destination container and path, and an error.  Note that at least one
 // container must be specified.
 func ParseSourceAndDestination(source, destination string) (string, string, string, string, error) {
 	sourceContainer, sourcePath := parseUserInput(source)
 	destContainer, destPath := parseUserInput(destination)
 
-	numContainers := 0
-	if len(sourceContainer) > 0 {
-		numContainers++
-	}
-	if len(destContainer) > 0 {
-		numContainers++
-	}
-
-	if numContainers != 1 {
-		return "", "", "", "", errors.Errorf("invalid arguments %q, %q: exactly 1 container expected but %d specified", source, destination, numContainers)
+	if len(sourceContainer) == 0 && len(destContainer) == 0 {
+		return "", "", "", "", errors.Errorf("invalid arguments %q, %q: at least 1 container expected but none specified", source, destination)
 	}
 
 	if len(sourcePath) == 0 || len(destPath) == 0 {
@@ -32,6 +32,9 @@ type ListContainer struct {
 	Image string
 	// Container image ID
 	ImageID string
+	// Health status of the container, empty if the container has no
+	// healthcheck
+	Health string `json:",omitempty"`
 	// If this container is a Pod infra container
 	IsInfra bool
 	// Labels for container
@@ -55,12 +58,21 @@ type ListContainer struct {
 	PodName string
 	// Port mappings
 	Ports []ocicni.PortMapping
+	// How many times the container was restarted by its restart policy
+	RestartCount uint
+	// If the container exited and is waiting to be restarted by its
+	// restart policy
+	Restarting bool
+	// Restart policy of the container
+	RestartPolicy string
 	// Size of the container rootfs.  Requires the size boolean to be true
 	Size *define.ContainerSize
 	// Time when container started
 	StartedAt int64
 	// State of container
 	State string
+	// If the container was last stopped explicitly by the user
+	StoppedByUser bool
 	// Status is a human-readable approximation of a duration for json output
 	Status string
 }
@@ -51,7 +51,9 @@ type ContainerRunlabelReport struct {
 }
 
 type WaitOptions struct {
-	Condition define.ContainerStatus
+	// Condition lists the conditions to wait for, the first one met ends
+	// the wait.  Conditions are container states, "healthy" or "removed".
+	Condition []string
 	Interval  time.Duration
 	Latest    bool
 }
@@ -85,6 +87,9 @@ type StopOptions struct {
 	CIDFiles []string
 	Ignore   bool
 	Latest   bool
+	// Shutdown stops the containers without recording an explicit stop
+	// by the user, so they are started again at boot.
+	Shutdown bool
 	Timeout  *uint
 }
 
@@ -158,6 +163,8 @@ type CommitOptions struct {
 	Message        string
 	Pause          bool
 	Quiet          bool
+	Squash         bool
+	SquashNew      string
 	Writer         io.Writer
 }
 
@@ -171,6 +178,7 @@ type ContainerExportOptions struct {
 
 type CheckpointOptions struct {
 	All            bool
+	CreateImage    string
 	Export         string
 	IgnoreRootFS   bool
 	IgnoreVolumes  bool
@@ -254,11 +262,27 @@ type ExecOptions struct {
 	Latest      bool
 	PreserveFDs uint
 	Privileged  bool
+	RmSession   bool
 	Tty         bool
 	User        string
 	WorkDir     string
 }
 
+// ExecAttachOptions describes the cli values to attach to a running exec
+// session
+type ExecAttachOptions struct {
+	// DetachKeys overrides the detach keys of the exec session, if set.
+	DetachKeys  *string
+	Interactive bool
+	RmSession   bool
+}
+
+// ContainerExecSessionsOptions describes the cli values to list the exec
+// sessions of a container
+type ContainerExecSessionsOptions struct {
+	Latest bool
+}
+
 // ContainerExistsOptions describes the cli values to check if a container exists
 type ContainerExistsOptions struct {
 	External bool
@@ -267,8 +291,10 @@ type ContainerExistsOptions struct {
 // ContainerStartOptions describes the val from the
 // CLI needed to start a container
 type ContainerStartOptions struct {
+	All         bool
 	Attach      bool
 	DetachKeys  string
+	Filters     map[string][]string
 	Interactive bool
 	Latest      bool
 	SigProxy    bool
@@ -417,6 +443,15 @@ type ContainerCpOptions struct {
 	Extract bool
 }
 
+// ContainerCopyOptions describes input options for copying files into and
+// out of a container.
+type ContainerCopyOptions struct {
+	// CopyUIDGID preserves the ownership of the copied files by translating
+	// it through the ID mappings of the container instead of changing it to
+	// the user of the container.
+	CopyUIDGID bool
+}
+
 // ContainerStatsOptions describes input options for getting
 // stats on containers
 type ContainerStatsOptions struct {
@@ -425,6 +460,9 @@ type ContainerStatsOptions struct {
 	Latest bool
 	// Stream stats.
 	Stream bool
+	// Interval between two samples when streaming. Defaults to one
+	// second.
+	Interval time.Duration
 }
 
 // ContainerStatsReport is used for streaming container stats.
@@ -247,8 +247,13 @@ type ImageListOptions struct {
 }
 
 type ImagePruneOptions struct {
-	All    bool     `json:"all" schema:"all"`
-	Filter []string `json:"filter" schema:"filter"`
+	All             bool          `json:"all" schema:"all"`
+	DryRun          bool          `json:"dry_run" schema:"dryrun"`
+	Filter          []string      `json:"filter" schema:"filter"`
+	GC              bool          `json:"gc" schema:"gc"`
+	GCHighThreshold uint          `json:"gc_high_threshold" schema:"gchighthreshold"`
+	GCLowThreshold  uint          `json:"gc_low_threshold" schema:"gclowthreshold"`
+	GCMinAge        time.Duration `json:"gc_min_age" schema:"gcminage"`
 }
 
 type ImageTagOptions struct{}
@@ -259,6 +264,26 @@ type ImageInspectReport struct {
 	*inspect.ImageData
 }
 
+// ImageInspectRemoteOptions are the options for inspecting images on their
+// registry
+type ImageInspectRemoteOptions struct {
+	// Authfile is the path to the authentication file.
+	Authfile string
+	// CertDir is the path to certificate directories.
+	CertDir string
+	// Username for authenticating against the registry.
+	Username string
+	// Password for authenticating against the registry.
+	Password string
+	// SkipTLSVerify to skip HTTPS and certificate verification.
+	SkipTLSVerify types.OptionalBool
+}
+
+// ImageInspectRemoteReport describes an image on its registry
+type ImageInspectRemoteReport struct {
+	*inspect.RemoteImageData
+}
+
 type ImageLoadOptions struct {
 	Input           string
 	Quiet           bool
@@ -347,6 +372,51 @@ type SignOptions struct {
 // SignReport describes the result of signing
 type SignReport struct{}
 
+// ImageVerifyOptions describes the CLI options for verifying an image
+// against the trust policy
+type ImageVerifyOptions struct {
+	// Authfile is the path to the authentication file.
+	Authfile string
+	// CertDir is the path to certificate directories.
+	CertDir string
+	// Username for authenticating against the registry.
+	Username string
+	// Password for authenticating against the registry.
+	Password string
+	// PolicyPath is the path to the signature policy.  Defaults to the
+	// policy of the system.
+	PolicyPath string
+	// SkipTLSVerify to skip HTTPS and certificate verification.
+	SkipTLSVerify types.OptionalBool
+}
+
+// ImageVerifyRequirement describes whether an image satisfies a single
+// requirement of the trust policy
+type ImageVerifyRequirement struct {
+	// Type of the requirement, e.g. "signedBy".
+	Type string `json:"type"`
+	// KeyPath of the keys accepted by the requirement, if any.
+	KeyPath string `json:"keyPath,omitempty"`
+	// Satisfied is true if the image satisfies the requirement.
+	Satisfied bool `json:"satisfied"`
+	// Reason why the requirement is not satisfied.
+	Reason string `json:"reason,omitempty"`
+}
+
+// ImageVerifyReport describes the result of verifying an image against the
+// trust policy
+type ImageVerifyReport struct {
+	// Image is the verified image including its transport.
+	Image string `json:"image"`
+	// Scope of the trust policy the requirements are taken from, empty for
+	// the default policy.
+	Scope string `json:"scope"`
+	// Allowed is true if the image satisfies all requirements.
+	Allowed bool `json:"allowed"`
+	// Requirements of the scope and whether the image satisfies them.
+	Requirements []ImageVerifyRequirement `json:"requirements"`
+}
+
 // ImageMountOptions describes the input values for mounting images
 // in the CLI
 type ImageMountOptions struct {
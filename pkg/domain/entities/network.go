@@ -22,6 +22,16 @@ type NetworkListReport struct {
 // NetworkInspectReport describes the results from inspect networks
 type NetworkInspectReport map[string]interface{}
 
+// NetworkContainerInfo describes a container attached to a network in the
+// results of inspecting the network
+type NetworkContainerInfo struct {
+	Name        string   `json:"name"`
+	IPv4Address string   `json:"ipv4Address,omitempty"`
+	IPv6Address string   `json:"ipv6Address,omitempty"`
+	MacAddress  string   `json:"macAddress,omitempty"`
+	Aliases     []string `json:"aliases,omitempty"`
+}
+
 // NetworkReloadOptions describes options for reloading container network
 // configuration.
 type NetworkReloadOptions struct {
@@ -67,6 +77,16 @@ type NetworkCreateReport struct {
 	Filename string
 }
 
+// NetworkUpdateOptions describes options to update the DNS servers and
+// labels of an existing network
+// swagger:model NetworkUpdateOptions
+type NetworkUpdateOptions struct {
+	DNSAdd   []string
+	DNSDrop  []string
+	LabelAdd map[string]string
+	LabelRm  []string
+}
+
 // NetworkDisconnectOptions describes options for disconnecting
 // containers from networks
 type NetworkDisconnectOptions struct {
@@ -79,4 +99,6 @@ type NetworkDisconnectOptions struct {
 type NetworkConnectOptions struct {
 	Aliases   []string
 	Container string
+	StaticIP  net.IP
+	StaticMAC net.HardwareAddr
 }
@@ -50,3 +50,10 @@ type PlayKubeReport struct {
 	// Pods - pods created by play kube.
 	Pods []PlayKubePod
 }
+
+// PlayKubeDownReport contains the results of tearing down the pods created
+// by play kube.
+type PlayKubeDownReport struct {
+	// Pods - the IDs of the removed pods.
+	Pods []string
+}
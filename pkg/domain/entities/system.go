@@ -11,9 +11,10 @@ import (
 
 // ServiceOptions provides the input for starting an API Service
 type ServiceOptions struct {
-	URI     string         // Path to unix domain socket service should listen on
-	Timeout time.Duration  // duration of inactivity the service should wait before shutting down
-	Command *cobra.Command // CLI command provided. Used in V1 code
+	URI      string         // Path to unix domain socket service should listen on
+	Timeout  time.Duration  // duration of inactivity the service should wait before shutting down
+	Command  *cobra.Command // CLI command provided. Used in V1 code
+	Registry bool           // serve the images in local storage as a read-only registry instead of the API
 }
 
 // SystemPruneOptions provides options to prune system.
@@ -82,6 +83,11 @@ type SystemDfVolumeReport struct {
 	Links           int
 	Size            int64
 	ReclaimableSize int64
+	// SizeLimit, Inodes and InodesLimit are only set for volumes
+	// created with the size or inodes options
+	SizeLimit   int64 `json:",omitempty"`
+	Inodes      int64 `json:",omitempty"`
+	InodesLimit int64 `json:",omitempty"`
 }
 
 // SystemResetOptions describes the options for resetting your
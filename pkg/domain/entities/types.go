@@ -4,6 +4,7 @@ import (
 	"net"
 
 	"github.com/containers/buildah/imagebuildah"
+	"github.com/containers/podman/v2/libpod/define"
 	"github.com/containers/podman/v2/libpod/events"
 	"github.com/containers/podman/v2/pkg/specgen"
 	"github.com/containers/storage/pkg/archive"
@@ -58,6 +59,9 @@ type InspectOptions struct {
 	Type string `json:",omitempty"`
 	// All -- inspect all
 	All bool `json:",omitempty"`
+	// Remote (images only) - inspect the image on its registry instead of
+	// the local storage.
+	Remote bool `json:",omitempty"`
 }
 
 // All API and CLI diff commands and diff sub-commands use the same options
@@ -65,11 +69,15 @@ type DiffOptions struct {
 	Format  string `json:",omitempty"` // CLI only
 	Latest  bool   `json:",omitempty"` // API and CLI, only supported by containers
 	Archive bool   `json:",omitempty"` // CLI only
+	Parent  string `json:",omitempty"` // API and CLI, image or container to compare against instead of the parent layer
+	Detail  bool   `json:",omitempty"` // API and CLI, report the details of the changed paths
 }
 
 // DiffReport provides changes for object
 type DiffReport struct {
 	Changes []archive.Change
+	// Details of the changes, only set if requested
+	Details []define.ChangeDetails
 }
 
 type EventsOptions struct {
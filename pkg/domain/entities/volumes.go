@@ -1,6 +1,7 @@
 package entities
 
 import (
+	"io"
 	"net/url"
 
 	"github.com/containers/podman/v2/libpod/define"
@@ -93,6 +94,19 @@ type VolumeListReport struct {
 	VolumeConfigResponse
 }
 
+// VolumeExportOptions describes the options needed to export a volume
+type VolumeExportOptions struct {
+	// Output receives the tar archive of the volume
+	Output io.Writer
+}
+
+// VolumeImportOptions describes the options needed to import a tar archive
+// into a volume
+type VolumeImportOptions struct {
+	// Input provides the tar archive to extract into the volume
+	Input io.Reader
+}
+
 // VolumeListBody Volume list response
 // swagger:model VolumeListBody
 type VolumeListBody struct {
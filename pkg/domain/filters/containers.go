@@ -185,6 +185,41 @@ func GenerateContainerFilterFuncs(filter string, filterValues []string, r *libpo
 			}
 			return false
 		}, nil
+	case "restart-policy":
+		for _, filterValue := range filterValues {
+			if !util.StringInSlice(filterValue, []string{libpod.RestartPolicyNo, libpod.RestartPolicyAlways, libpod.RestartPolicyOnFailure, libpod.RestartPolicyUnlessStopped}) {
+				return nil, errors.Errorf("%s is not a valid restart policy", filterValue)
+			}
+		}
+		return func(c *libpod.Container) bool {
+			policy := c.RestartPolicy()
+			if policy == libpod.RestartPolicyNone {
+				policy = libpod.RestartPolicyNo
+			}
+			return util.StringInSlice(policy, filterValues)
+		}, nil
+	case "restart-count":
+		// all comparisons have to match, e.g. >1 and <5
+		comparisons := make([]func(uint) bool, 0, len(filterValues))
+		for _, filterValue := range filterValues {
+			comparison, err := parseRestartCountFilter(filterValue)
+			if err != nil {
+				return nil, err
+			}
+			comparisons = append(comparisons, comparison)
+		}
+		return func(c *libpod.Container) bool {
+			restartCount, err := c.RestartCount()
+			if err != nil {
+				return false
+			}
+			for _, comparison := range comparisons {
+				if !comparison(restartCount) {
+					return false
+				}
+			}
+			return true
+		}, nil
 	case "until":
 		if len(filterValues) != 1 {
 			return nil, errors.Errorf("specify exactly one timestamp for %s", filter)
@@ -255,3 +290,32 @@ func GenerateContainerFilterFuncs(filter string, filterValues []string, r *libpo
 	}
 	return nil, errors.Errorf("%s is an invalid filter", filter)
 }
+
+// parseRestartCountFilter parses a restart-count filter value, a number
+// optionally preceded by one of the operators >, >=, < or <=, into a
+// function comparing a restart count with it.
+func parseRestartCountFilter(filterValue string) (func(uint) bool, error) {
+	operator := ""
+	for _, op := range []string{">=", "<=", ">", "<"} {
+		if strings.HasPrefix(filterValue, op) {
+			operator = op
+			break
+		}
+	}
+	n, err := strconv.ParseUint(strings.TrimPrefix(filterValue, operator), 10, 32)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid restart-count filter %q", filterValue)
+	}
+	count := uint(n)
+	switch operator {
+	case ">=":
+		return func(c uint) bool { return c >= count }, nil
+	case "<=":
+		return func(c uint) bool { return c <= count }, nil
+	case ">":
+		return func(c uint) bool { return c > count }, nil
+	case "<":
+		return func(c uint) bool { return c < count }, nil
+	}
+	return func(c uint) bool { return c == count }, nil
+}
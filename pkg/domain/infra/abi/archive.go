@@ -20,7 +20,11 @@ import (
 
 // NOTE: Only the parent directory of the container path must exist.  The path
 // itself may be created while copying.
-func (ic *ContainerEngine) ContainerCopyFromArchive(ctx context.Context, nameOrID string, containerPath string, reader io.Reader) (entities.ContainerCopyFunc, error) {
+//
+// Unless options.CopyUIDGID is set, the copied files are owned by the user of
+// the container.  Otherwise, the ownership in the archive is translated
+// through the container's ID mappings.
+func (ic *ContainerEngine) ContainerCopyFromArchive(ctx context.Context, nameOrID string, containerPath string, reader io.Reader, options entities.ContainerCopyOptions) (entities.ContainerCopyFunc, error) {
 	container, err := ic.Libpod.LookupContainer(nameOrID)
 	if err != nil {
 		return nil, err
@@ -56,16 +60,21 @@ func (ic *ContainerEngine) ContainerCopyFromArchive(ctx context.Context, nameOrI
 		defer unmount()
 		defer decompressed.Close()
 		putOptions := buildahCopiah.PutOptions{
-			UIDMap:     idMappings.UIDMap,
-			GIDMap:     idMappings.GIDMap,
-			ChownDirs:  idPair,
-			ChownFiles: idPair,
+			UIDMap: idMappings.UIDMap,
+			GIDMap: idMappings.GIDMap,
+		}
+		if !options.CopyUIDGID {
+			putOptions.ChownDirs = idPair
+			putOptions.ChownFiles = idPair
 		}
 		return buildahCopiah.Put(resolvedRoot, resolvedContainerPath, putOptions, decompressed)
 	}, nil
 }
 
-func (ic *ContainerEngine) ContainerCopyToArchive(ctx context.Context, nameOrID string, containerPath string, writer io.Writer) (entities.ContainerCopyFunc, error) {
+// Unless options.CopyUIDGID is set, the files in the archive are owned by the
+// user of the container.  Otherwise, their ownership is translated through the
+// container's ID mappings.
+func (ic *ContainerEngine) ContainerCopyToArchive(ctx context.Context, nameOrID string, containerPath string, writer io.Writer, options entities.ContainerCopyOptions) (entities.ContainerCopyFunc, error) {
 	container, err := ic.Libpod.LookupContainer(nameOrID)
 	if err != nil {
 		return nil, err
@@ -104,8 +113,10 @@ func (ic *ContainerEngine) ContainerCopyToArchive(ctx context.Context, nameOrID
 			KeepDirectoryNames: !strings.HasSuffix(resolvedContainerPath, "."),
 			UIDMap:             idMappings.UIDMap,
 			GIDMap:             idMappings.GIDMap,
-			ChownDirs:          idPair,
-			ChownFiles:         idPair,
+		}
+		if !options.CopyUIDGID {
+			getOptions.ChownDirs = idPair
+			getOptions.ChownFiles = idPair
 		}
 		return buildahCopiah.Get(resolvedRoot, "", getOptions, []string{resolvedContainerPath}, writer)
 	}, nil
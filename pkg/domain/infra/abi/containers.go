@@ -5,6 +5,8 @@ import (
 	"fmt"
 	"io/ioutil"
 	"os"
+	"path/filepath"
+	"sort"
 	"strconv"
 	"strings"
 	"sync"
@@ -101,7 +103,7 @@ func (ic *ContainerEngine) ContainerWait(ctx context.Context, namesOrIds []strin
 	responses := make([]entities.WaitReport, 0, len(ctrs))
 	for _, c := range ctrs {
 		response := entities.WaitReport{Id: c.ID()}
-		exitCode, err := c.WaitForConditionWithInterval(options.Interval, options.Condition)
+		exitCode, err := c.WaitForConditions(ctx, options.Interval, options.Condition)
 		if err != nil {
 			response.Error = err
 		} else {
@@ -153,9 +155,16 @@ func (ic *ContainerEngine) ContainerStop(ctx context.Context, namesOrIds []strin
 	}
 	errMap, err := parallelctr.ContainerOp(ctx, ctrs, func(c *libpod.Container) error {
 		var err error
-		if options.Timeout != nil {
+		switch {
+		case options.Shutdown:
+			timeout := c.StopTimeout()
+			if options.Timeout != nil {
+				timeout = *options.Timeout
+			}
+			err = c.StopForShutdown(timeout)
+		case options.Timeout != nil:
 			err = c.StopWithTimeout(*options.Timeout)
-		} else {
+		default:
 			err = c.Stop()
 		}
 		if err != nil {
@@ -437,6 +446,7 @@ func (ic *ContainerEngine) ContainerCommit(ctx context.Context, nameOrID string,
 		ReportWriter:          options.Writer,
 		SystemContext:         sc,
 		PreferredManifestType: mimeType,
+		Squash:                options.Squash,
 	}
 	opts := libpod.ContainerCommitOptions{
 		CommitOptions:  coptions,
@@ -445,6 +455,7 @@ func (ic *ContainerEngine) ContainerCommit(ctx context.Context, nameOrID string,
 		Message:        options.Message,
 		Changes:        options.Changes,
 		Author:         options.Author,
+		SquashBase:     options.SquashNew,
 	}
 	newImage, err := ctr.Commit(ctx, options.ImageName, opts)
 	if err != nil {
@@ -475,6 +486,7 @@ func (ic *ContainerEngine) ContainerCheckpoint(ctx context.Context, namesOrIds [
 		KeepRunning:    options.LeaveRunning,
 		PreCheckPoint:  options.PreCheckPoint,
 		WithPrevious:   options.WithPrevious,
+		CreateImage:    options.CreateImage,
 	}
 
 	if options.All {
@@ -525,17 +537,75 @@ func (ic *ContainerEngine) ContainerRestore(ctx context.Context, namesOrIds []st
 		},
 	}
 
+	reports := []*entities.RestoreReport{}
 	switch {
 	case options.Import != "":
 		cons, err = checkpoint.CRImportCheckpoint(ctx, ic.Libpod, options)
 	case options.All:
 		cons, err = ic.Libpod.GetContainers(filterFuncs...)
-	default:
+	case options.Latest:
 		cons, err = getContainersByContext(false, options.Latest, namesOrIds, ic.Libpod)
+	default:
+		for _, nameOrID := range namesOrIds {
+			ctr, ctrErr := ic.Libpod.LookupContainer(nameOrID)
+			if ctrErr == nil {
+				cons = append(cons, ctr)
+				continue
+			}
+			// Not a container, check if it is a checkpoint image
+			img, imgErr := ic.Libpod.ImageRuntime().NewFromLocal(nameOrID)
+			if imgErr != nil {
+				return nil, ctrErr
+			}
+			isCheckpoint, imgErr := checkpoint.CRIsCheckpointImage(ctx, img)
+			if imgErr != nil {
+				return nil, imgErr
+			}
+			if !isCheckpoint {
+				return nil, errors.Wrapf(ctrErr, "image %s is not a checkpoint image", nameOrID)
+			}
+			imgReports, imgErr := ic.restoreCheckpointImage(ctx, img, options, restoreOptions)
+			if imgErr != nil {
+				return nil, imgErr
+			}
+			reports = append(reports, imgReports...)
+		}
+		if len(cons) > 0 && (options.Name != "" || options.IgnoreRootFS || options.IgnoreVolumes || options.ImportPrevious != "") {
+			return nil, errors.Errorf("--name, --ignore-rootfs, --ignore-volumes and --import-previous can only be used with --import or a checkpoint image")
+		}
 	}
 	if err != nil {
 		return nil, err
 	}
+	for _, con := range cons {
+		err := con.Restore(ctx, restoreOptions)
+		reports = append(reports, &entities.RestoreReport{
+			Err: err,
+			Id:  con.ID(),
+		})
+	}
+	return reports, nil
+}
+
+// restoreCheckpointImage re-creates and restores the container stored in the
+// given checkpoint image
+func (ic *ContainerEngine) restoreCheckpointImage(ctx context.Context, img *image.Image, options entities.RestoreOptions, restoreOptions libpod.ContainerCheckpointOptions) ([]*entities.RestoreReport, error) {
+	mountPoint, err := img.Mount(nil, "")
+	if err != nil {
+		return nil, errors.Wrapf(err, "error mounting checkpoint image %s", img.ID())
+	}
+	defer func() {
+		if err := img.Unmount(false); err != nil {
+			logrus.Errorf("error unmounting checkpoint image %s: %v", img.ID(), err)
+		}
+	}()
+
+	options.Import = filepath.Join(mountPoint, define.CheckpointImageArchive)
+	restoreOptions.TargetFile = options.Import
+	cons, err := checkpoint.CRImportCheckpoint(ctx, ic.Libpod, options)
+	if err != nil {
+		return nil, err
+	}
 	reports := make([]*entities.RestoreReport, 0, len(cons))
 	for _, con := range cons {
 		err := con.Restore(ctx, restoreOptions)
@@ -664,7 +734,9 @@ func (ic *ContainerEngine) ContainerExecDetached(ctx context.Context, nameOrID s
 		return "", errors.Wrapf(err, "error retrieving Libpod configuration to build exec exit command")
 	}
 	// TODO: Add some ability to toggle syslog
-	exitCommandArgs, err := generate.CreateExitCommandArgs(storageConfig, runtimeConfig, false, true, true)
+	// The session is removed once it exits unless it was requested to be
+	// kept, so that its exit code can be listed.
+	exitCommandArgs, err := generate.CreateExitCommandArgs(storageConfig, runtimeConfig, false, options.RmSession, true)
 	if err != nil {
 		return "", errors.Wrapf(err, "error constructing exit command for exec session")
 	}
@@ -683,10 +755,107 @@ func (ic *ContainerEngine) ContainerExecDetached(ctx context.Context, nameOrID s
 	return id, nil
 }
 
+func (ic *ContainerEngine) ContainerExecAttach(ctx context.Context, sessionID string, options entities.ExecAttachOptions, streams define.AttachStreams) (int, error) {
+	ctr, err := ic.Libpod.GetExecSessionContainer(sessionID)
+	if err != nil {
+		return define.ExecErrorCodeGeneric, err
+	}
+
+	ec, err := terminal.ExecAttachSession(ctx, ctr, sessionID, options.DetachKeys, &streams)
+	if err != nil {
+		if errors.Cause(err) == define.ErrDetach {
+			return 0, nil
+		}
+		return define.TranslateExecErrorToExitCode(ec, err), err
+	}
+
+	if options.RmSession {
+		// The exit command of a detached session may have removed it
+		// already.
+		if err := ctr.ExecRemove(sessionID, false); err != nil && errors.Cause(err) != define.ErrNoSuchExecSession {
+			return ec, err
+		}
+	}
+	return ec, nil
+}
+
+func (ic *ContainerEngine) ContainerExecSessions(ctx context.Context, nameOrID string, options entities.ContainerExecSessionsOptions) ([]*define.InspectExecSession, error) {
+	ctrs, err := getContainersByContext(false, options.Latest, []string{nameOrID}, ic.Libpod)
+	if err != nil {
+		return nil, err
+	}
+	ctr := ctrs[0]
+
+	ids, err := ctr.ExecSessions()
+	if err != nil {
+		return nil, err
+	}
+	sort.Strings(ids)
+
+	reports := make([]*define.InspectExecSession, 0, len(ids))
+	for _, id := range ids {
+		session, err := ctr.ExecSession(id)
+		if err != nil {
+			if errors.Cause(err) == define.ErrNoSuchExecSession {
+				continue
+			}
+			return nil, err
+		}
+		report, err := session.Inspect()
+		if err != nil {
+			return nil, err
+		}
+		reports = append(reports, report)
+	}
+	return reports, nil
+}
+
+// getContainersToStart returns the containers selected by the start options.
+// When all containers are started, running containers are skipped.  With a
+// restart-policy filter, as done at boot by podman-restart.service,
+// containers with the unless-stopped policy that were explicitly stopped by
+// the user are skipped as well.
+func (ic *ContainerEngine) getContainersToStart(namesOrIds []string, options entities.ContainerStartOptions) ([]*libpod.Container, []string, error) {
+	if !options.All {
+		return getContainersAndInputByContext(false, options.Latest, namesOrIds, ic.Libpod)
+	}
+
+	filterFuncs := make([]libpod.ContainerFilter, 0, len(options.Filters))
+	for k, v := range options.Filters {
+		generatedFunc, err := dfilters.GenerateContainerFilterFuncs(k, v, ic.Libpod)
+		if err != nil {
+			return nil, nil, err
+		}
+		filterFuncs = append(filterFuncs, generatedFunc)
+	}
+	filterFuncs = append(filterFuncs, func(c *libpod.Container) bool {
+		state, err := c.State()
+		return err == nil && state != define.ContainerStateRunning
+	})
+	if _, ok := options.Filters["restart-policy"]; ok {
+		filterFuncs = append(filterFuncs, func(c *libpod.Container) bool {
+			if c.RestartPolicy() != libpod.RestartPolicyUnlessStopped {
+				return true
+			}
+			stoppedByUser, err := c.StoppedByUser()
+			return err == nil && !stoppedByUser
+		})
+	}
+	ctrs, err := ic.Libpod.GetContainers(filterFuncs...)
+	if err != nil {
+		return nil, nil, err
+	}
+	rawInputs := make([]string, 0, len(ctrs))
+	for _, ctr := range ctrs {
+		rawInputs = append(rawInputs, ctr.ID())
+	}
+	return ctrs, rawInputs, nil
+}
+
 func (ic *ContainerEngine) ContainerStart(ctx context.Context, namesOrIds []string, options entities.ContainerStartOptions) ([]*entities.ContainerStartReport, error) {
 	reports := []*entities.ContainerStartReport{}
 	var exitCode = define.ExecErrorCodeGeneric
-	ctrs, rawInputs, err := getContainersAndInputByContext(false, options.Latest, namesOrIds, ic.Libpod)
+	ctrs, rawInputs, err := ic.getContainersToStart(namesOrIds, options)
 	if err != nil {
 		return nil, err
 	}
@@ -814,8 +983,7 @@ func (ic *ContainerEngine) ContainerDiff(ctx context.Context, nameOrID string, o
 		}
 		nameOrID = ctnr.ID()
 	}
-	changes, err := ic.Libpod.GetDiff("", nameOrID)
-	return &entities.DiffReport{Changes: changes}, err
+	return getDiffReport(ic.Libpod, nameOrID, opts)
 }
 
 func (ic *ContainerEngine) ContainerRun(ctx context.Context, opts entities.ContainerRunOptions) (*entities.ContainerRunReport, error) {
@@ -967,7 +1135,17 @@ func (ic *ContainerEngine) ContainerCleanup(ctx context.Context, namesOrIds []st
 		report := entities.ContainerCleanupReport{Id: ctr.ID()}
 
 		if options.Exec != "" {
-			if options.Remove {
+			remove := options.Remove
+			if remove {
+				// A client attached to the session still has to
+				// read its exit code, and removes it itself.
+				session, err := ctr.ExecSession(options.Exec)
+				if err != nil {
+					return nil, err
+				}
+				remove = !session.Attached
+			}
+			if remove {
 				if err := ctr.ExecRemove(options.Exec, false); err != nil {
 					return nil, err
 				}
@@ -1296,7 +1474,16 @@ func (ic *ContainerEngine) ContainerStats(ctx context.Context, namesOrIds []stri
 			return
 		}
 
-		time.Sleep(time.Second)
+		interval := options.Interval
+		if interval <= 0 {
+			interval = time.Second
+		}
+		select {
+		case <-ctx.Done():
+			logrus.Debugf("Container stats stopped: context cancelled")
+			return
+		case <-time.After(interval):
+		}
 		goto stream
 	}()
 
@@ -0,0 +1,28 @@
+package abi
+
+import (
+	"github.com/containers/podman/v2/libpod"
+	"github.com/containers/podman/v2/pkg/domain/entities"
+	"github.com/containers/storage/pkg/archive"
+)
+
+// getDiffReport compares the image or container to options.Parent or, if
+// not set, to its parent layer.
+func getDiffReport(runtime *libpod.Runtime, nameOrID string, options entities.DiffOptions) (*entities.DiffReport, error) {
+	if options.Detail {
+		details, err := runtime.GetDiffDetails(options.Parent, nameOrID)
+		if err != nil {
+			return nil, err
+		}
+		report := entities.DiffReport{Details: details}
+		for _, d := range details {
+			report.Changes = append(report.Changes, archive.Change{Path: d.Path, Kind: d.Kind})
+		}
+		return &report, nil
+	}
+	changes, err := runtime.GetDiff(options.Parent, nameOrID)
+	if err != nil {
+		return nil, err
+	}
+	return &entities.DiffReport{Changes: changes}, nil
+}
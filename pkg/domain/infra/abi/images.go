@@ -50,7 +50,15 @@ func (ir *ImageEngine) Exists(_ context.Context, nameOrID string) (*entities.Boo
 }
 
 func (ir *ImageEngine) Prune(ctx context.Context, opts entities.ImagePruneOptions) ([]*reports.PruneReport, error) {
-	reports, err := ir.Libpod.ImageRuntime().PruneImages(ctx, opts.All, opts.Filter)
+	if opts.GC {
+		policy := image.GCPolicy{
+			HighThreshold: opts.GCHighThreshold,
+			LowThreshold:  opts.GCLowThreshold,
+			MinAge:        opts.GCMinAge,
+		}
+		return ir.Libpod.ImageRuntime().GarbageCollect(ctx, policy, opts.DryRun)
+	}
+	reports, err := ir.Libpod.ImageRuntime().PruneImages(ctx, opts.All, opts.DryRun, opts.Filter)
 	if err != nil {
 		return nil, err
 	}
@@ -324,6 +332,29 @@ func (ir *ImageEngine) Inspect(ctx context.Context, namesOrIDs []string, opts en
 	return reports, errs, nil
 }
 
+func (ir *ImageEngine) InspectRemote(ctx context.Context, names []string, opts entities.ImageInspectRemoteOptions) ([]*entities.ImageInspectRemoteReport, []error, error) {
+	registryOpts := image.DockerRegistryOptions{
+		DockerCertPath:              opts.CertDir,
+		DockerInsecureSkipTLSVerify: opts.SkipTLSVerify,
+	}
+	if opts.Username != "" {
+		registryOpts.DockerRegistryCreds = &types.DockerAuthConfig{Username: opts.Username, Password: opts.Password}
+	}
+	reports := []*entities.ImageInspectRemoteReport{}
+	errs := []error{}
+	for _, name := range names {
+		data, err := image.InspectRemote(ctx, name, opts.Authfile, &registryOpts)
+		if err != nil {
+			// The image may not exist or not be accessible, treat as
+			// nonfatal.
+			errs = append(errs, err)
+			continue
+		}
+		reports = append(reports, &entities.ImageInspectRemoteReport{RemoteImageData: data})
+	}
+	return reports, errs, nil
+}
+
 func (ir *ImageEngine) Push(ctx context.Context, source string, destination string, options entities.ImagePushOptions) error {
 	var writer io.Writer
 	if !options.Quiet {
@@ -452,12 +483,8 @@ func (ir *ImageEngine) Save(ctx context.Context, nameOrID string, tags []string,
 	return newImage.Save(ctx, nameOrID, options.Format, options.Output, tags, options.Quiet, options.Compress, true)
 }
 
-func (ir *ImageEngine) Diff(_ context.Context, nameOrID string, _ entities.DiffOptions) (*entities.DiffReport, error) {
-	changes, err := ir.Libpod.GetDiff("", nameOrID)
-	if err != nil {
-		return nil, err
-	}
-	return &entities.DiffReport{Changes: changes}, nil
+func (ir *ImageEngine) Diff(_ context.Context, nameOrID string, opts entities.DiffOptions) (*entities.DiffReport, error) {
+	return getDiffReport(ir.Libpod, nameOrID, opts)
 }
 
 func (ir *ImageEngine) Search(ctx context.Context, term string, opts entities.ImageSearchOptions) ([]entities.ImageSearchReport, error) {
@@ -544,7 +571,7 @@ func removeErrorsToExitCode(rmErrors []error) int {
 
 	for _, e := range rmErrors {
 		switch errors.Cause(e) {
-		case define.ErrNoSuchImage:
+		case define.ErrNoSuchImage, define.ErrNoSuchManifestList:
 			noSuchImageErrors = true
 		case define.ErrImageInUse, storage.ErrImageUsedByContainer:
 			inUseErrors = true
@@ -19,6 +19,7 @@ import (
 	"github.com/containers/image/v5/transports"
 	"github.com/containers/image/v5/transports/alltransports"
 	"github.com/containers/image/v5/types"
+	"github.com/containers/podman/v2/libpod/define"
 	libpodImage "github.com/containers/podman/v2/libpod/image"
 	"github.com/containers/podman/v2/pkg/domain/entities"
 	"github.com/opencontainers/go-digest"
@@ -40,6 +41,22 @@ func (ir *ImageEngine) ManifestCreate(ctx context.Context, names, images []strin
 	return imageID, err
 }
 
+// ManifestExists checks if a manifest list with the given name exists in local storage
+func (ir *ImageEngine) ManifestExists(ctx context.Context, name string) (*entities.BoolReport, error) {
+	listImage, err := ir.Libpod.ImageRuntime().NewFromLocal(name)
+	if err != nil {
+		if errors.Cause(err) == define.ErrNoSuchImage {
+			return &entities.BoolReport{Value: false}, nil
+		}
+		return nil, err
+	}
+	isList, err := listImage.IsManifestList()
+	if err != nil {
+		return nil, err
+	}
+	return &entities.BoolReport{Value: isList}, nil
+}
+
 // ManifestInspect returns the content of a manifest list or image
 func (ir *ImageEngine) ManifestInspect(ctx context.Context, name string) ([]byte, error) {
 	if newImage, err := ir.Libpod.ImageRuntime().NewFromLocal(name); err == nil {
@@ -240,6 +257,37 @@ func (ir *ImageEngine) ManifestRemove(ctx context.Context, names []string) (stri
 	return "", err
 }
 
+// ManifestRm removes the specified manifest lists from local storage.  The
+// images referenced by the lists are not removed.
+func (ir *ImageEngine) ManifestRm(ctx context.Context, names []string) (report *entities.ImageRemoveReport, rmErrors []error) {
+	report = new(entities.ImageRemoveReport)
+
+	// Set the exit code at very end.
+	defer func() {
+		report.ExitCode = removeErrorsToExitCode(rmErrors)
+	}()
+
+	for _, name := range names {
+		listImage, err := ir.Libpod.ImageRuntime().NewFromLocal(name)
+		if err != nil {
+			if errors.Cause(err) == define.ErrNoSuchImage {
+				err = errors.Wrapf(define.ErrNoSuchManifestList, "%s", name)
+			}
+			rmErrors = append(rmErrors, err)
+			continue
+		}
+		if err := listImage.RemoveManifestList(); err != nil {
+			if errors.Cause(err) == define.ErrNoSuchManifestList {
+				err = errors.Wrapf(define.ErrNoSuchManifestList, "%s", name)
+			}
+			rmErrors = append(rmErrors, err)
+			continue
+		}
+		report.Deleted = append(report.Deleted, listImage.ID())
+	}
+	return report, rmErrors
+}
+
 // ManifestPush pushes a manifest list or image index to the destination
 func (ir *ImageEngine) ManifestPush(ctx context.Context, name, destination string, opts entities.ImagePushOptions) (string, error) {
 	listImage, err := ir.Libpod.ImageRuntime().NewFromLocal(name)
@@ -302,7 +350,7 @@ func (ir *ImageEngine) ManifestPush(ctx context.Context, name, destination strin
 	}
 	manDigest, err := listImage.PushManifest(dest, options)
 	if err == nil && opts.Rm {
-		_, err = ir.Libpod.GetStore().DeleteImage(listImage.ID(), true)
+		err = listImage.RemoveManifestList()
 	}
 	return manDigest.String(), err
 }
@@ -2,10 +2,14 @@ package abi
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
 
+	"github.com/containers/podman/v2/libpod"
 	"github.com/containers/podman/v2/libpod/define"
 	"github.com/containers/podman/v2/libpod/network"
 	"github.com/containers/podman/v2/pkg/domain/entities"
+	"github.com/containers/podman/v2/pkg/rootless"
 	"github.com/containers/podman/v2/pkg/util"
 	"github.com/pkg/errors"
 )
@@ -38,6 +42,18 @@ func (ic *ContainerEngine) NetworkList(ctx context.Context, options entities.Net
 	return reports, nil
 }
 
+func (ic *ContainerEngine) NetworkExists(ctx context.Context, name string) (*entities.BoolReport, error) {
+	config, err := ic.Libpod.GetConfig()
+	if err != nil {
+		return nil, err
+	}
+	exists, err := network.Exists(config, name)
+	if err != nil {
+		return nil, err
+	}
+	return &entities.BoolReport{Value: exists}, nil
+}
+
 func (ic *ContainerEngine) NetworkInspect(ctx context.Context, namesOrIds []string, options entities.InspectOptions) ([]entities.NetworkInspectReport, []error, error) {
 	config, err := ic.Libpod.GetConfig()
 	if err != nil {
@@ -55,11 +71,83 @@ func (ic *ContainerEngine) NetworkInspect(ctx context.Context, namesOrIds []stri
 				return nil, nil, errors.Wrapf(err, "error inspecting network %s", name)
 			}
 		}
+		containers, err := ic.networkContainers(rawList)
+		if err != nil {
+			return nil, nil, errors.Wrapf(err, "error inspecting containers of network %s", name)
+		}
+		// The report is a raw map, so the containers are added in
+		// their JSON representation for --format templates to use the
+		// same keys locally and remotely.
+		b, err := json.Marshal(containers)
+		if err != nil {
+			return nil, nil, err
+		}
+		var rawContainers map[string]interface{}
+		if err := json.Unmarshal(b, &rawContainers); err != nil {
+			return nil, nil, err
+		}
+		rawList["containers"] = rawContainers
 		rawCNINetworks = append(rawCNINetworks, rawList)
 	}
 	return rawCNINetworks, errs, nil
 }
 
+// usesCNI returns whether the network of the container is configured by CNI
+func usesCNI(c *libpod.Container) bool {
+	config := c.Config()
+	return config.CreateNetNS && !config.NetMode.IsSlirp4netns()
+}
+
+// networkContainers returns the containers attached to the given network
+// keyed by their IDs.
+func (ic *ContainerEngine) networkContainers(rawList map[string]interface{}) (map[string]entities.NetworkContainerInfo, error) {
+	containers := make(map[string]entities.NetworkContainerInfo)
+	netName, ok := rawList["name"].(string)
+	if !ok {
+		return containers, nil
+	}
+	ctrs, err := ic.Libpod.GetContainers(usesCNI)
+	if err != nil {
+		return nil, err
+	}
+	for _, ctr := range ctrs {
+		networks, isDefault, err := ctr.Networks()
+		if err != nil {
+			if errors.Cause(err) == define.ErrNoSuchCtr || errors.Cause(err) == define.ErrCtrRemoved {
+				continue
+			}
+			return nil, err
+		}
+		if !util.StringInSlice(netName, networks) {
+			continue
+		}
+		data, err := ctr.Inspect(false)
+		if err != nil {
+			if errors.Cause(err) == define.ErrNoSuchCtr || errors.Cause(err) == define.ErrCtrRemoved {
+				continue
+			}
+			return nil, err
+		}
+		info := entities.NetworkContainerInfo{Name: ctr.Name()}
+		var netConfig define.InspectBasicNetworkConfig
+		if isDefault {
+			netConfig = data.NetworkSettings.InspectBasicNetworkConfig
+		} else if netData, ok := data.NetworkSettings.Networks[netName]; ok {
+			netConfig = netData.InspectBasicNetworkConfig
+			info.Aliases = netData.Aliases
+		}
+		if netConfig.IPAddress != "" {
+			info.IPv4Address = fmt.Sprintf("%s/%d", netConfig.IPAddress, netConfig.IPPrefixLen)
+		}
+		if netConfig.GlobalIPv6Address != "" {
+			info.IPv6Address = fmt.Sprintf("%s/%d", netConfig.GlobalIPv6Address, netConfig.GlobalIPv6PrefixLen)
+		}
+		info.MacAddress = netConfig.MacAddress
+		containers[ctr.ID()] = info
+	}
+	return containers, nil
+}
+
 func (ic *ContainerEngine) NetworkReload(ctx context.Context, names []string, options entities.NetworkReloadOptions) ([]*entities.NetworkReloadReport, error) {
 	ctrs, err := getContainersByContext(options.All, options.Latest, names, ic.Libpod)
 	if err != nil {
@@ -138,5 +226,51 @@ func (ic *ContainerEngine) NetworkDisconnect(ctx context.Context, networkname st
 }
 
 func (ic *ContainerEngine) NetworkConnect(ctx context.Context, networkname string, options entities.NetworkConnectOptions) error {
-	return ic.Libpod.ConnectContainerToNetwork(options.Container, networkname, options.Aliases)
+	netOptions := libpod.ContainerNetworkOptions{
+		StaticIP:  options.StaticIP,
+		StaticMAC: options.StaticMAC,
+	}
+	return ic.Libpod.ConnectContainerToNetwork(options.Container, networkname, options.Aliases, netOptions)
+}
+
+// NetworkUpdate changes the DNS servers and labels of a network and reloads
+// the network of the running containers attached to it
+func (ic *ContainerEngine) NetworkUpdate(ctx context.Context, name string, options entities.NetworkUpdateOptions) error {
+	runtimeConfig, err := ic.Libpod.GetConfig()
+	if err != nil {
+		return err
+	}
+	if err := network.Update(name, options, runtimeConfig); err != nil {
+		return err
+	}
+	if rootless.IsRootless() {
+		// Rootless containers cannot reload their network, they
+		// pick up the changes when they are restarted.
+		return nil
+	}
+	rawList, err := network.InspectNetwork(runtimeConfig, name)
+	if err != nil {
+		return err
+	}
+	netName, _ := rawList["name"].(string)
+	ctrs, err := ic.Libpod.GetContainers(usesCNI, func(c *libpod.Container) bool {
+		state, _ := c.State()
+		return state == define.ContainerStateRunning
+	})
+	if err != nil {
+		return err
+	}
+	for _, ctr := range ctrs {
+		networks, _, err := ctr.Networks()
+		if err != nil {
+			return err
+		}
+		if !util.StringInSlice(netName, networks) {
+			continue
+		}
+		if err := ctr.ReloadNetwork(); err != nil {
+			return errors.Wrapf(err, "network %s was updated but the network of container %s could not be reloaded", netName, ctr.ID())
+		}
+	}
+	return nil
 }
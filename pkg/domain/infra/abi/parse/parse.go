@@ -6,12 +6,13 @@ import (
 
 	"github.com/containers/podman/v2/libpod"
 	"github.com/containers/podman/v2/libpod/define"
+	"github.com/docker/go-units"
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
 )
 
 // Handle volume options from CLI.
-// Parse "o" option to find UID, GID.
+// Parse "o" option to find UID, GID, and the "size" and "inodes" options.
 func VolumeOptions(opts map[string]string) ([]libpod.VolumeCreateOption, error) {
 	libpodOptions := []libpod.VolumeCreateOption{}
 	volumeOptions := make(map[string]string)
@@ -61,6 +62,26 @@ func VolumeOptions(opts map[string]string) ([]libpod.VolumeCreateOption, error)
 			if len(finalVal) > 0 {
 				volumeOptions[key] = strings.Join(finalVal, ",")
 			}
+		case "size":
+			size, err := units.RAMInBytes(value)
+			if err != nil {
+				return nil, errors.Wrapf(err, "cannot convert size %s to bytes", value)
+			}
+			if size <= 0 {
+				return nil, errors.Wrapf(define.ErrInvalidArg, "size must be greater than 0")
+			}
+			libpodOptions = append(libpodOptions, libpod.WithVolumeSize(uint64(size)))
+			volumeOptions[key] = value
+		case "inodes":
+			inodes, err := strconv.ParseUint(value, 10, 64)
+			if err != nil {
+				return nil, errors.Wrapf(err, "cannot convert inodes %s to integer", value)
+			}
+			if inodes == 0 {
+				return nil, errors.Wrapf(define.ErrInvalidArg, "inodes must be greater than 0")
+			}
+			libpodOptions = append(libpodOptions, libpod.WithVolumeInodes(inodes))
+			volumeOptions[key] = value
 		default:
 			volumeOptions[key] = value
 		}
@@ -12,9 +12,11 @@ import (
 	"github.com/containers/podman/v2/libpod"
 	"github.com/containers/podman/v2/libpod/define"
 	"github.com/containers/podman/v2/libpod/image"
+	"github.com/containers/podman/v2/pkg/autoupdate"
 	"github.com/containers/podman/v2/pkg/domain/entities"
 	"github.com/containers/podman/v2/pkg/specgen/generate"
 	"github.com/containers/podman/v2/pkg/specgen/generate/kube"
+	systemdGen "github.com/containers/podman/v2/pkg/systemd/generate"
 	"github.com/containers/podman/v2/pkg/util"
 	"github.com/docker/distribution/reference"
 	"github.com/ghodss/yaml"
@@ -84,7 +86,7 @@ func (ic *ContainerEngine) playKubeDeployment(ctx context.Context, deploymentYAM
 
 	// create "replicas" number of pods
 	for i = 0; i < numReplicas; i++ {
-		podName := fmt.Sprintf("%s-pod-%d", deploymentName, i)
+		podName := deploymentPodName(deploymentName, i)
 		podReport, err := ic.playKubePod(ctx, podName, &podSpec, options)
 		if err != nil {
 			return nil, errors.Wrapf(err, "error encountered while bringing up pod %s", podName)
@@ -106,12 +108,10 @@ func (ic *ContainerEngine) playKubePod(ctx context.Context, podName string, podY
 	if podName == "" {
 		return nil, errors.Errorf("pod does not have a name")
 	}
-	for _, n := range podYAML.Spec.Containers {
-		if n.Name == podName {
-			playKubePod.Logs = append(playKubePod.Logs,
-				fmt.Sprintf("a container exists with the same name (%q) as the pod in your YAML file; changing pod name to %s_pod\n", podName, podName))
-			podName = fmt.Sprintf("%s_pod", podName)
-		}
+	if name := kubePodName(podName, &podYAML.Spec); name != podName {
+		playKubePod.Logs = append(playKubePod.Logs,
+			fmt.Sprintf("a container exists with the same name (%q) as the pod in your YAML file; changing pod name to %s\n", podName, name))
+		podName = name
 	}
 
 	p, err := kube.ToPodGen(ctx, podName, podYAML)
@@ -238,6 +238,7 @@ func (ic *ContainerEngine) playKubePod(ctx context.Context, podName string, podY
 			SeccompPaths:  seccompPaths,
 			RestartPolicy: ctrRestartPolicy,
 			NetNSIsHost:   p.NetNS.IsHost(),
+			Labels:        kubeContainerLabels(podYAML.ObjectMeta.Annotations, container.Name),
 		}
 		specGen, err := kube.ToSpecGen(ctx, &specgenOpts)
 		if err != nil {
@@ -272,6 +273,102 @@ func (ic *ContainerEngine) playKubePod(ctx context.Context, podName string, podY
 	return &report, nil
 }
 
+// PlayKubeDown stops and removes the pods play kube created for the
+// Kubernetes YAML file.  Pods that do not exist are skipped.
+func (ic *ContainerEngine) PlayKubeDown(ctx context.Context, path string) (*entities.PlayKubeDownReport, error) {
+	var (
+		kubeObject v1.ObjectReference
+		podNames   []string
+		report     entities.PlayKubeDownReport
+	)
+
+	content, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := yaml.Unmarshal(content, &kubeObject); err != nil {
+		return nil, errors.Wrapf(err, "unable to read %q as YAML", path)
+	}
+
+	switch kubeObject.Kind {
+	case "Pod":
+		var podYAML v1.Pod
+		if err := yaml.Unmarshal(content, &podYAML); err != nil {
+			return nil, errors.Wrapf(err, "unable to read YAML %q as Kube Pod", path)
+		}
+		podNames = append(podNames, kubePodName(podYAML.ObjectMeta.Name, &podYAML.Spec))
+	case "Deployment":
+		var deploymentYAML v1apps.Deployment
+		if err := yaml.Unmarshal(content, &deploymentYAML); err != nil {
+			return nil, errors.Wrapf(err, "unable to read YAML %q as Kube Deployment", path)
+		}
+		numReplicas := int32(1)
+		if deploymentYAML.Spec.Replicas != nil {
+			numReplicas = *deploymentYAML.Spec.Replicas
+		}
+		for i := int32(0); i < numReplicas; i++ {
+			podName := deploymentPodName(deploymentYAML.ObjectMeta.Name, i)
+			podNames = append(podNames, kubePodName(podName, &deploymentYAML.Spec.Template.Spec))
+		}
+	default:
+		return nil, errors.Errorf("invalid YAML kind: %q. [Pod|Deployment] are the only supported Kubernetes Kinds", kubeObject.Kind)
+	}
+
+	for _, podName := range podNames {
+		pod, err := ic.Libpod.LookupPod(podName)
+		if err != nil {
+			if errors.Cause(err) == define.ErrNoSuchPod {
+				continue
+			}
+			return nil, err
+		}
+		if err := ic.Libpod.RemovePod(ctx, pod, true, true); err != nil {
+			return nil, errors.Wrapf(err, "error removing pod %s", podName)
+		}
+		report.Pods = append(report.Pods, pod.ID())
+	}
+	return &report, nil
+}
+
+// deploymentPodName returns the name of the i-th replica of a deployment.
+func deploymentPodName(deploymentName string, i int32) string {
+	return fmt.Sprintf("%s-pod-%d", deploymentName, i)
+}
+
+// kubePodName returns the name of the pod created for the pod spec.  The
+// name is changed if a container of the pod has the same name.
+func kubePodName(podName string, podSpec *v1.PodSpec) string {
+	for _, n := range podSpec.Containers {
+		if n.Name == podName {
+			podName = fmt.Sprintf("%s_pod", podName)
+		}
+	}
+	return podName
+}
+
+// kubeContainerLabels returns the labels to set on a container of a pod in
+// addition to the labels of its image.  The auto-update policy and authfile
+// can be set for all containers of the pod in the io.containers.autoupdate
+// and io.containers.autoupdate.authfile annotations, or for a single
+// container by appending "/" and its name to the key.  When run by a systemd
+// unit, the containers are labeled with the unit to allow auto updates.
+func kubeContainerLabels(annotations map[string]string, ctrName string) map[string]string {
+	labels := make(map[string]string)
+	for _, key := range []string{autoupdate.Label, autoupdate.AuthfileLabel} {
+		if value, ok := annotations[key]; ok {
+			labels[key] = value
+		}
+		if value, ok := annotations[key+"/"+ctrName]; ok {
+			labels[key] = value
+		}
+	}
+	if unit, ok := os.LookupEnv(systemdGen.EnvVariable); ok {
+		labels[systemdGen.EnvVariable] = unit
+	}
+	return labels
+}
+
 // readConfigMapFromFile returns a kubernetes configMap obtained from --configmap flag
 func readConfigMapFromFile(r io.Reader) (v1.ConfigMap, error) {
 	var cm v1.ConfigMap
@@ -192,7 +192,7 @@ func (ic *ContainerEngine) SystemPrune(ctx context.Context, options entities.Sys
 		for k, v := range options.Filters {
 			filters = append(filters, fmt.Sprintf("%s=%s", k, v[0]))
 		}
-		imagePruneReports, err := ic.Libpod.ImageRuntime().PruneImages(ctx, options.All, filters)
+		imagePruneReports, err := ic.Libpod.ImageRuntime().PruneImages(ctx, options.All, false, filters)
 		reclaimedSpace = reclaimedSpace + reports.PruneReportsSize(imagePruneReports)
 
 		if err != nil {
@@ -322,9 +322,20 @@ func (ic *ContainerEngine) SystemDf(ctx context.Context, options entities.System
 			// TODO: fix this.
 			continue
 		}
-		volSize, err := sizeOfPath(mountPoint)
+		var volSize int64
+		volQuota, err := v.Quota()
 		if err != nil {
-			return nil, err
+			logrus.Warnf("Unable to get quota of volume %s: %v", v.Name(), err)
+		}
+		if volQuota != nil {
+			// The quota tracks the usage of the volume, no
+			// need to walk it
+			volSize = int64(volQuota.SizeUsed)
+		} else {
+			volSize, err = sizeOfPath(mountPoint)
+			if err != nil {
+				return nil, err
+			}
 		}
 		inUse, err := v.VolumeInUse()
 		if err != nil {
@@ -344,6 +355,11 @@ func (ic *ContainerEngine) SystemDf(ctx context.Context, options entities.System
 			Size:            volSize,
 			ReclaimableSize: reclaimableSize,
 		}
+		if volQuota != nil {
+			report.SizeLimit = int64(volQuota.Size)
+			report.Inodes = int64(volQuota.InodesUsed)
+			report.InodesLimit = int64(volQuota.Inodes)
+		}
 		dfVolumes = append(dfVolumes, &report)
 	}
 	return &entities.SystemDfReport{
@@ -37,6 +37,34 @@ func ExecAttachCtr(ctx context.Context, ctr *libpod.Container, execConfig *libpo
 	return ctr.Exec(execConfig, streams, resize)
 }
 
+// ExecAttachSession attaches to a running exec session of a container
+func ExecAttachSession(ctx context.Context, ctr *libpod.Container, sessionID string, detachKeys *string, streams *define.AttachStreams) (int, error) {
+	session, err := ctr.ExecSession(sessionID)
+	if err != nil {
+		return -1, err
+	}
+
+	resize := make(chan remotecommand.TerminalSize)
+	haveTerminal := terminal.IsTerminal(int(os.Stdin.Fd()))
+
+	// Check if we are attached to a terminal. If we are, generate resize
+	// events, and set the terminal to raw mode
+	if haveTerminal && session.Config.Terminal {
+		cancel, oldTermState, err := handleTerminalAttach(ctx, resize)
+		if err != nil {
+			return -1, err
+		}
+		defer cancel()
+		defer func() {
+			if err := restoreTerminal(oldTermState); err != nil {
+				logrus.Errorf("unable to restore terminal: %q", err)
+			}
+		}()
+	}
+
+	return ctr.ExecAttach(sessionID, streams, detachKeys, resize)
+}
+
 // StartAttachCtr starts and (if required) attaches to a container
 // if you change the signature of this function from os.File to io.Writer, it will trigger a downstream
 // error. we may need to just lint disable this one.
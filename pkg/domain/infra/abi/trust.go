@@ -3,10 +3,17 @@ package abi
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"io/ioutil"
 	"os"
 	"strings"
 
+	"github.com/containers/image/v5/docker"
+	"github.com/containers/image/v5/image"
+	"github.com/containers/image/v5/signature"
+	"github.com/containers/image/v5/transports"
+	"github.com/containers/image/v5/transports/alltransports"
+	"github.com/containers/image/v5/types"
 	"github.com/containers/podman/v2/pkg/domain/entities"
 	"github.com/containers/podman/v2/pkg/trust"
 	"github.com/pkg/errors"
@@ -112,6 +119,121 @@ func (ir *ImageEngine) SetTrust(ctx context.Context, args []string, options enti
 	return ioutil.WriteFile(policyPath, data, 0644)
 }
 
+// Verify evaluates the requirements of the trust policy that apply to the
+// image one by one.  Only the manifest and the signatures of the image are
+// fetched, the image is not pulled into the local storage.
+func (ir *ImageEngine) Verify(ctx context.Context, nameOrID string, options entities.ImageVerifyOptions) (*entities.ImageVerifyReport, error) {
+	ref, err := alltransports.ParseImageName(nameOrID)
+	if err != nil {
+		ref, err = alltransports.ParseImageName(fmt.Sprintf("%s://%s", docker.Transport.Name(), nameOrID))
+		if err != nil {
+			return nil, errors.Wrapf(err, "error parsing image name %q", nameOrID)
+		}
+	}
+
+	// Copy the runtime's system context, which is shared by all requests
+	// of the service, so the credentials and TLS settings of this request
+	// do not leak into others.
+	sc := *ir.Libpod.SystemContext()
+	sc.AuthFilePath = options.Authfile
+	sc.DockerCertPath = options.CertDir
+	sc.DockerInsecureSkipTLSVerify = options.SkipTLSVerify
+	if options.Username != "" {
+		sc.DockerAuthConfig = &types.DockerAuthConfig{Username: options.Username, Password: options.Password}
+	}
+	if options.PolicyPath != "" {
+		sc.SignaturePolicyPath = options.PolicyPath
+	}
+	policy, err := signature.DefaultPolicy(&sc)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not read trust policies")
+	}
+	scope, requirements := policyRequirementsForRef(policy, ref)
+
+	src, err := ref.NewImageSource(ctx, &sc)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error getting image source")
+	}
+	defer func() {
+		if err := src.Close(); err != nil {
+			logrus.Errorf("unable to close %s image source: %v", transports.ImageName(ref), err)
+		}
+	}()
+	// The unparsed image caches the manifest and the signatures across
+	// the evaluations of the requirements.
+	unparsed := image.UnparsedInstance(src, nil)
+
+	report := entities.ImageVerifyReport{
+		Image:   transports.ImageName(ref),
+		Scope:   scope,
+		Allowed: true,
+	}
+	for _, requirement := range requirements {
+		result, err := verifyPolicyRequirement(ctx, requirement, unparsed)
+		if err != nil {
+			return nil, err
+		}
+		if !result.Satisfied {
+			report.Allowed = false
+		}
+		report.Requirements = append(report.Requirements, *result)
+	}
+	return &report, nil
+}
+
+// policyRequirementsForRef returns the scope and the requirements of the
+// policy applying to the reference.  This mirrors the lookup done by
+// c/image when evaluating a policy.
+func policyRequirementsForRef(policy *signature.Policy, ref types.ImageReference) (string, signature.PolicyRequirements) {
+	if transportScopes, ok := policy.Transports[ref.Transport().Name()]; ok {
+		identity := ref.PolicyConfigurationIdentity()
+		if req, ok := transportScopes[identity]; ok {
+			return identity, req
+		}
+		for _, name := range ref.PolicyConfigurationNamespaces() {
+			if req, ok := transportScopes[name]; ok {
+				return name, req
+			}
+		}
+		if req, ok := transportScopes[""]; ok {
+			return "", req
+		}
+	}
+	return "", policy.Default
+}
+
+// verifyPolicyRequirement evaluates a single policy requirement against the
+// image
+func verifyPolicyRequirement(ctx context.Context, requirement signature.PolicyRequirement, img types.UnparsedImage) (*entities.ImageVerifyRequirement, error) {
+	// The definition of policy requirements is private to c/image, so get
+	// their type and keys from their JSON representation.
+	b, err := json.Marshal(requirement)
+	if err != nil {
+		return nil, err
+	}
+	result := entities.ImageVerifyRequirement{}
+	if err := json.Unmarshal(b, &result); err != nil {
+		return nil, err
+	}
+
+	pc, err := signature.NewPolicyContext(&signature.Policy{Default: signature.PolicyRequirements{requirement}})
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err := pc.Destroy(); err != nil {
+			logrus.Errorf("unable to destroy policy context: %v", err)
+		}
+	}()
+	result.Satisfied, err = pc.IsRunningImageAllowed(ctx, img)
+	if err != nil {
+		// Errors of the evaluation itself, e.g. a missing key file, are
+		// reported like a rejection as the requirement is not satisfied.
+		result.Reason = err.Error()
+	}
+	return &result, nil
+}
+
 func getPolicyShowOutput(policyContentStruct trust.PolicyContent, systemRegistriesDirPath string) ([]*trust.Policy, error) {
 	var output []*trust.Policy
 
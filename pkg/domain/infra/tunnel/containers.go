@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"io"
 	"io/ioutil"
+	"math"
 	"os"
 	"strconv"
 	"strings"
@@ -17,6 +18,7 @@ import (
 	"github.com/containers/podman/v2/libpod/events"
 	"github.com/containers/podman/v2/pkg/api/handlers"
 	"github.com/containers/podman/v2/pkg/bindings/containers"
+	"github.com/containers/podman/v2/pkg/bindings/images"
 	"github.com/containers/podman/v2/pkg/domain/entities"
 	"github.com/containers/podman/v2/pkg/domain/entities/reports"
 	"github.com/containers/podman/v2/pkg/errorhandling"
@@ -41,7 +43,7 @@ func (ic *ContainerEngine) ContainerWait(ctx context.Context, namesOrIds []strin
 		return nil, err
 	}
 	responses := make([]entities.WaitReport, 0, len(cons))
-	options := new(containers.WaitOptions).WithCondition(opts.Condition)
+	options := new(containers.WaitOptions).WithConditions(opts.Condition)
 	for _, c := range cons {
 		response := entities.WaitReport{Id: c.ID}
 		exitCode, err := containers.Wait(ic.ClientCtx, c.ID, options)
@@ -263,6 +265,12 @@ func (ic *ContainerEngine) ContainerCommit(ctx context.Context, nameOrID string,
 	}
 	options := new(containers.CommitOptions).WithAuthor(opts.Author).WithChanges(opts.Changes).WithComment(opts.Message)
 	options.WithFormat(opts.Format).WithPause(opts.Pause).WithRepo(repo).WithTag(tag)
+	if opts.Squash {
+		options.WithSquash(opts.Squash)
+	}
+	if opts.SquashNew != "" {
+		options.WithSquashNew(opts.SquashNew)
+	}
 	response, err := containers.Commit(ic.ClientCtx, nameOrID, options)
 	if err != nil {
 		return nil, err
@@ -311,6 +319,9 @@ func (ic *ContainerEngine) ContainerCheckpoint(ctx context.Context, namesOrIds [
 	reports := make([]*entities.CheckpointReport, 0, len(ctrs))
 	options := new(containers.CheckpointOptions).WithExport(opts.Export).WithIgnoreRootfs(opts.IgnoreRootFS).WithKeep(opts.Keep)
 	options.WithLeaveRunning(opts.LeaveRunning).WithTCPEstablished(opts.TCPEstablished)
+	if opts.CreateImage != "" {
+		options.WithCreateImage(opts.CreateImage)
+	}
 	for _, c := range ctrs {
 		report, err := containers.Checkpoint(ic.ClientCtx, c.ID, options)
 		if err != nil {
@@ -322,10 +333,7 @@ func (ic *ContainerEngine) ContainerCheckpoint(ctx context.Context, namesOrIds [
 }
 
 func (ic *ContainerEngine) ContainerRestore(ctx context.Context, namesOrIds []string, opts entities.RestoreOptions) ([]*entities.RestoreReport, error) {
-	var (
-		err  error
-		ctrs = []entities.ListContainer{}
-	)
+	ctrs := []entities.ListContainer{}
 	if opts.All {
 		allCtrs, err := getContainersByContext(ic.ClientCtx, true, false, []string{})
 		if err != nil {
@@ -339,17 +347,39 @@ func (ic *ContainerEngine) ContainerRestore(ctx context.Context, namesOrIds []st
 		}
 
 	} else {
-		ctrs, err = getContainersByContext(ic.ClientCtx, false, false, namesOrIds)
-		if err != nil {
-			return nil, err
+		for _, nameOrID := range namesOrIds {
+			found, err := getContainersByContext(ic.ClientCtx, false, false, []string{nameOrID})
+			if err == nil {
+				ctrs = append(ctrs, found...)
+				continue
+			}
+			if !errorhandling.Contains(err, define.ErrNoSuchCtr) {
+				return nil, err
+			}
+			// Not a container, checkpoint images are restored by
+			// the server
+			exists, imgErr := images.Exists(ic.ClientCtx, nameOrID)
+			if imgErr != nil {
+				return nil, imgErr
+			}
+			if !exists {
+				return nil, err
+			}
+			ctrs = append(ctrs, entities.ListContainer{ID: nameOrID})
 		}
 	}
 	reports := make([]*entities.RestoreReport, 0, len(ctrs))
-	options := new(containers.RestoreOptions)
+	options := new(containers.RestoreOptions).WithIgnoreRootfs(opts.IgnoreRootFS).WithKeep(opts.Keep)
+	options.WithIgnoreStaticIP(opts.IgnoreStaticIP).WithIgnoreStaticMAC(opts.IgnoreStaticMAC).WithTCPEstablished(opts.TCPEstablished)
+	options.WithIgnoreVolumes(opts.IgnoreVolumes)
+	if opts.Name != "" {
+		options.WithName(opts.Name)
+	}
 	for _, c := range ctrs {
 		report, err := containers.Restore(ic.ClientCtx, c.ID, options)
 		if err != nil {
 			reports = append(reports, &entities.RestoreReport{Id: c.ID, Err: err})
+			continue
 		}
 		reports = append(reports, report)
 	}
@@ -475,6 +505,39 @@ func (ic *ContainerEngine) ContainerExecDetached(ctx context.Context, nameOrID s
 	return sessionID, nil
 }
 
+func (ic *ContainerEngine) ContainerExecAttach(ctx context.Context, sessionID string, options entities.ExecAttachOptions, streams define.AttachStreams) (int, error) {
+	attachOptions := new(containers.ExecAttachOptions).WithRmSession(options.RmSession)
+	if options.DetachKeys != nil {
+		attachOptions.WithDetachKeys(*options.DetachKeys)
+	}
+	attachOptions.WithOutputStream(streams.OutputStream).WithErrorStream(streams.ErrorStream)
+	if streams.InputStream != nil {
+		attachOptions.WithInputStream(*streams.InputStream)
+	}
+	attachOptions.WithAttachError(streams.AttachError).WithAttachOutput(streams.AttachOutput).WithAttachInput(streams.AttachInput)
+	if err := containers.ExecAttach(ic.ClientCtx, sessionID, attachOptions); err != nil {
+		return 125, err
+	}
+
+	inspectOut, err := containers.ExecInspect(ic.ClientCtx, sessionID, nil)
+	if err != nil {
+		return 125, err
+	}
+	if inspectOut.Running {
+		// We detached from the session.
+		return 0, nil
+	}
+
+	return inspectOut.ExitCode, nil
+}
+
+func (ic *ContainerEngine) ContainerExecSessions(ctx context.Context, nameOrID string, options entities.ContainerExecSessionsOptions) ([]*define.InspectExecSession, error) {
+	if options.Latest {
+		return nil, errors.New("latest is not supported for the remote client")
+	}
+	return containers.ExecSessions(ic.ClientCtx, nameOrID, nil)
+}
+
 func startAndAttach(ic *ContainerEngine, name string, detachKeys *string, input, output, errput *os.File) error { //nolint
 	attachErr := make(chan error)
 	attachReady := make(chan bool)
@@ -504,10 +567,38 @@ func startAndAttach(ic *ContainerEngine, name string, detachKeys *string, input,
 	return <-attachErr
 }
 
+// getContainersToStart returns the containers selected by the start options.
+// When all containers are started, running containers are skipped.  With a
+// restart-policy filter, containers with the unless-stopped policy that were
+// explicitly stopped by the user are skipped as well.
+func (ic *ContainerEngine) getContainersToStart(namesOrIds []string, options entities.ContainerStartOptions) ([]entities.ListContainer, error) {
+	if !options.All {
+		return getContainersByContext(ic.ClientCtx, false, false, namesOrIds)
+	}
+
+	listOptions := new(containers.ListOptions).WithAll(true).WithFilters(options.Filters)
+	allContainers, err := containers.List(ic.ClientCtx, listOptions)
+	if err != nil {
+		return nil, err
+	}
+	_, restartPolicyFilter := options.Filters["restart-policy"]
+	ctrs := make([]entities.ListContainer, 0, len(allContainers))
+	for _, ctr := range allContainers {
+		if ctr.State == define.ContainerStateRunning.String() {
+			continue
+		}
+		if restartPolicyFilter && ctr.RestartPolicy == "unless-stopped" && ctr.StoppedByUser {
+			continue
+		}
+		ctrs = append(ctrs, ctr)
+	}
+	return ctrs, nil
+}
+
 func (ic *ContainerEngine) ContainerStart(ctx context.Context, namesOrIds []string, options entities.ContainerStartOptions) ([]*entities.ContainerStartReport, error) {
 	reports := []*entities.ContainerStartReport{}
 	var exitCode = define.ExecErrorCodeGeneric
-	ctrs, err := getContainersByContext(ic.ClientCtx, false, false, namesOrIds)
+	ctrs, err := ic.getContainersToStart(namesOrIds, options)
 	if err != nil {
 		return nil, err
 	}
@@ -515,9 +606,13 @@ func (ic *ContainerEngine) ContainerStart(ctx context.Context, namesOrIds []stri
 	// There can only be one container if attach was used
 	for i, ctr := range ctrs {
 		name := ctr.ID
+		rawInput := name
+		if !options.All {
+			rawInput = namesOrIds[i]
+		}
 		report := entities.ContainerStartReport{
 			Id:       name,
-			RawInput: namesOrIds[i],
+			RawInput: rawInput,
 			ExitCode: exitCode,
 		}
 		ctrRunning := ctr.State == define.ContainerStateRunning.String()
@@ -725,8 +820,19 @@ func (ic *ContainerEngine) ContainerRun(ctx context.Context, opts entities.Conta
 	return &report, err
 }
 
-func (ic *ContainerEngine) ContainerDiff(ctx context.Context, nameOrID string, _ entities.DiffOptions) (*entities.DiffReport, error) {
-	changes, err := containers.Diff(ic.ClientCtx, nameOrID, nil)
+func (ic *ContainerEngine) ContainerDiff(ctx context.Context, nameOrID string, opts entities.DiffOptions) (*entities.DiffReport, error) {
+	options := new(containers.DiffOptions)
+	if opts.Parent != "" {
+		options.WithParent(opts.Parent)
+	}
+	if opts.Detail {
+		details, err := containers.DiffDetails(ic.ClientCtx, nameOrID, options)
+		if err != nil {
+			return nil, err
+		}
+		return diffDetailsToReport(details), nil
+	}
+	changes, err := containers.Diff(ic.ClientCtx, nameOrID, options)
 	return &entities.DiffReport{Changes: changes}, err
 }
 
@@ -793,12 +899,12 @@ func (ic *ContainerEngine) ContainerPort(ctx context.Context, nameOrID string, o
 	return reports, nil
 }
 
-func (ic *ContainerEngine) ContainerCopyFromArchive(ctx context.Context, nameOrID string, path string, reader io.Reader) (entities.ContainerCopyFunc, error) {
-	return containers.CopyFromArchive(ic.ClientCtx, nameOrID, path, reader)
+func (ic *ContainerEngine) ContainerCopyFromArchive(ctx context.Context, nameOrID string, path string, reader io.Reader, options entities.ContainerCopyOptions) (entities.ContainerCopyFunc, error) {
+	return containers.CopyFromArchiveWithOptions(ic.ClientCtx, nameOrID, path, reader, new(containers.CopyOptions).WithCopyUIDGID(options.CopyUIDGID))
 }
 
-func (ic *ContainerEngine) ContainerCopyToArchive(ctx context.Context, nameOrID string, path string, writer io.Writer) (entities.ContainerCopyFunc, error) {
-	return containers.CopyToArchive(ic.ClientCtx, nameOrID, path, writer)
+func (ic *ContainerEngine) ContainerCopyToArchive(ctx context.Context, nameOrID string, path string, writer io.Writer, options entities.ContainerCopyOptions) (entities.ContainerCopyFunc, error) {
+	return containers.CopyToArchiveWithOptions(ic.ClientCtx, nameOrID, path, writer, new(containers.CopyOptions).WithCopyUIDGID(options.CopyUIDGID))
 }
 
 func (ic *ContainerEngine) ContainerStat(ctx context.Context, nameOrID string, path string) (*entities.ContainerStatReport, error) {
@@ -813,7 +919,12 @@ func (ic *ContainerEngine) ContainerStats(ctx context.Context, namesOrIds []stri
 	if options.Latest {
 		return nil, errors.New("latest is not supported for the remote client")
 	}
-	return containers.Stats(ic.ClientCtx, namesOrIds, new(containers.StatsOptions).WithStream(options.Stream))
+	opts := new(containers.StatsOptions).WithStream(options.Stream)
+	if options.Interval > 0 {
+		// The API only supports whole seconds
+		opts.WithInterval(int(math.Ceil(options.Interval.Seconds())))
+	}
+	return containers.Stats(ic.ClientCtx, namesOrIds, opts)
 }
 
 // ShouldRestart reports back whether the container will restart
@@ -8,6 +8,7 @@ import (
 	"github.com/containers/podman/v2/pkg/bindings/pods"
 	"github.com/containers/podman/v2/pkg/domain/entities"
 	"github.com/containers/podman/v2/pkg/errorhandling"
+	"github.com/containers/storage/pkg/archive"
 	"github.com/pkg/errors"
 )
 
@@ -116,3 +117,13 @@ func getPodsByContext(contextWithConnection context.Context, all bool, namesOrID
 	}
 	return filtered, nil
 }
+
+// diffDetailsToReport returns a diff report with both the changes and their
+// details
+func diffDetailsToReport(details []define.ChangeDetails) *entities.DiffReport {
+	report := entities.DiffReport{Details: details}
+	for _, d := range details {
+		report.Changes = append(report.Changes, archive.Change{Path: d.Path, Kind: d.Kind})
+	}
+	return &report
+}
@@ -17,6 +17,7 @@ import (
 	"github.com/containers/podman/v2/pkg/domain/entities/reports"
 	"github.com/containers/podman/v2/pkg/domain/utils"
 	"github.com/containers/podman/v2/pkg/errorhandling"
+	"github.com/containers/podman/v2/pkg/inspect"
 	utils2 "github.com/containers/podman/v2/utils"
 	"github.com/pkg/errors"
 )
@@ -96,7 +97,10 @@ func (ir *ImageEngine) Prune(ctx context.Context, opts entities.ImagePruneOption
 		f := strings.Split(filter, "=")
 		filters[f[0]] = f[1:]
 	}
-	options := new(images.PruneOptions).WithAll(opts.All).WithFilters(filters)
+	options := new(images.PruneOptions).WithAll(opts.All).WithFilters(filters).WithDryRun(opts.DryRun).WithGC(opts.GC)
+	if opts.GC {
+		options.WithGCHighThreshold(opts.GCHighThreshold).WithGCLowThreshold(opts.GCLowThreshold).WithGCMinAge(opts.GCMinAge.String())
+	}
 	reports, err := images.Prune(ir.ClientCtx, options)
 	if err != nil {
 		return nil, err
@@ -201,6 +205,39 @@ func (ir *ImageEngine) Inspect(ctx context.Context, namesOrIDs []string, opts en
 	return reports, errs, nil
 }
 
+func (ir *ImageEngine) InspectRemote(ctx context.Context, names []string, opts entities.ImageInspectRemoteOptions) ([]*entities.ImageInspectRemoteReport, []error, error) {
+	options := new(images.InspectDistributionOptions)
+	options.WithAuthfile(opts.Authfile).WithUsername(opts.Username).WithPassword(opts.Password)
+	if s := opts.SkipTLSVerify; s != types.OptionalBoolUndefined {
+		options.WithSkipTLSVerify(s == types.OptionalBoolTrue)
+	}
+	reports := []*entities.ImageInspectRemoteReport{}
+	errs := []error{}
+	for _, name := range names {
+		r, err := images.InspectDistribution(ir.ClientCtx, name, options)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		// The endpoint only reports the descriptor of the image and
+		// its platforms, not the configurations of the instances.
+		if !strings.Contains(name, "://") {
+			name = "docker://" + name
+		}
+		data := inspect.RemoteImageData{
+			Name:      name,
+			Digest:    r.Descriptor.Digest,
+			MediaType: r.Descriptor.MediaType,
+			Size:      r.Descriptor.Size,
+		}
+		for _, platform := range r.Platforms {
+			data.Manifests = append(data.Manifests, inspect.RemoteManifestData{Platform: platform})
+		}
+		reports = append(reports, &entities.ImageInspectRemoteReport{RemoteImageData: &data})
+	}
+	return reports, errs, nil
+}
+
 func (ir *ImageEngine) Load(ctx context.Context, opts entities.ImageLoadOptions) (*entities.ImageLoadReport, error) {
 	f, err := os.Open(opts.Input)
 	if err != nil {
@@ -304,8 +341,18 @@ func (ir *ImageEngine) Save(ctx context.Context, nameOrID string, tags []string,
 }
 
 // Diff reports the changes to the given image
-func (ir *ImageEngine) Diff(ctx context.Context, nameOrID string, _ entities.DiffOptions) (*entities.DiffReport, error) {
+func (ir *ImageEngine) Diff(ctx context.Context, nameOrID string, opts entities.DiffOptions) (*entities.DiffReport, error) {
 	options := new(images.DiffOptions)
+	if opts.Parent != "" {
+		options.WithParent(opts.Parent)
+	}
+	if opts.Detail {
+		details, err := images.DiffDetails(ir.ClientCtx, nameOrID, options)
+		if err != nil {
+			return nil, err
+		}
+		return diffDetailsToReport(details), nil
+	}
 	changes, err := images.Diff(ir.ClientCtx, nameOrID, options)
 	if err != nil {
 		return nil, err
@@ -10,6 +10,7 @@ import (
 	images "github.com/containers/podman/v2/pkg/bindings/images"
 	"github.com/containers/podman/v2/pkg/bindings/manifests"
 	"github.com/containers/podman/v2/pkg/domain/entities"
+	"github.com/containers/podman/v2/pkg/errorhandling"
 	"github.com/pkg/errors"
 )
 
@@ -23,6 +24,15 @@ func (ir *ImageEngine) ManifestCreate(ctx context.Context, names, images []strin
 	return imageID, err
 }
 
+// ManifestExists checks if a manifest list with the given name exists
+func (ir *ImageEngine) ManifestExists(ctx context.Context, name string) (*entities.BoolReport, error) {
+	found, err := manifests.Exists(ir.ClientCtx, name, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &entities.BoolReport{Value: found}, nil
+}
+
 // ManifestInspect returns contents of manifest list with given name
 func (ir *ImageEngine) ManifestInspect(ctx context.Context, name string) ([]byte, error) {
 	list, err := manifests.Inspect(ir.ClientCtx, name, nil)
@@ -74,6 +84,35 @@ func (ir *ImageEngine) ManifestRemove(ctx context.Context, names []string) (stri
 	return fmt.Sprintf("%s :%s\n", updatedListID, names[1]), nil
 }
 
+// ManifestRm removes the specified manifest lists
+func (ir *ImageEngine) ManifestRm(ctx context.Context, names []string) (*entities.ImageRemoveReport, []error) {
+	var (
+		rmErrors    []error
+		otherErrors bool
+		rmReport    = new(entities.ImageRemoveReport)
+	)
+	for _, name := range names {
+		report, err := manifests.Delete(ir.ClientCtx, name, nil)
+		if err != nil {
+			if errModel, ok := err.(errorhandling.ErrorModel); !ok || errModel.ResponseCode != 404 {
+				otherErrors = true
+			}
+			rmErrors = append(rmErrors, err)
+			continue
+		}
+		rmReport.Deleted = append(rmReport.Deleted, report.Deleted...)
+	}
+	// Exit codes match the ones of local removals: 1 if only lists that
+	// do not exist failed to be removed, 125 otherwise.
+	switch {
+	case otherErrors:
+		rmReport.ExitCode = 125
+	case len(rmErrors) > 0:
+		rmReport.ExitCode = 1
+	}
+	return rmReport, rmErrors
+}
+
 // ManifestPush pushes a manifest list or image index to the destination
 func (ir *ImageEngine) ManifestPush(ctx context.Context, name, destination string, opts entities.ImagePushOptions) (string, error) {
 	options := new(images.PushOptions)
@@ -90,5 +129,8 @@ func (ir *ImageEngine) ManifestPush(ctx context.Context, name, destination strin
 		}
 	}
 	digest, err := manifests.Push(ir.ClientCtx, name, destination, options)
+	if err == nil && opts.Rm {
+		_, err = manifests.Delete(ir.ClientCtx, name, nil)
+	}
 	return digest, err
 }
@@ -14,6 +14,11 @@ func (ic *ContainerEngine) NetworkList(ctx context.Context, opts entities.Networ
 	return network.List(ic.ClientCtx, options)
 }
 
+func (ic *ContainerEngine) NetworkExists(ctx context.Context, name string) (*entities.BoolReport, error) {
+	exists, err := network.Exists(ic.ClientCtx, name)
+	return &entities.BoolReport{Value: exists}, err
+}
+
 func (ic *ContainerEngine) NetworkInspect(ctx context.Context, namesOrIds []string, opts entities.InspectOptions) ([]entities.NetworkInspectReport, []error, error) {
 	var (
 		reports = make([]entities.NetworkInspectReport, 0, len(namesOrIds))
@@ -76,5 +81,17 @@ func (ic *ContainerEngine) NetworkDisconnect(ctx context.Context, networkname st
 // NetworkConnect removes a container from a given network
 func (ic *ContainerEngine) NetworkConnect(ctx context.Context, networkname string, opts entities.NetworkConnectOptions) error {
 	options := new(network.ConnectOptions).WithAliases(opts.Aliases)
+	if opts.StaticIP != nil {
+		options.WithStaticIP(opts.StaticIP)
+	}
+	if opts.StaticMAC != nil {
+		options.WithStaticMAC(opts.StaticMAC)
+	}
 	return network.Connect(ic.ClientCtx, networkname, opts.Container, options)
 }
+
+// NetworkUpdate changes the DNS servers and labels of a network
+func (ic *ContainerEngine) NetworkUpdate(ctx context.Context, name string, opts entities.NetworkUpdateOptions) error {
+	options := new(network.UpdateOptions).WithDNSAdd(opts.DNSAdd).WithDNSDrop(opts.DNSDrop).WithLabelAdd(opts.LabelAdd).WithLabelRm(opts.LabelRm)
+	return network.Update(ic.ClientCtx, name, options)
+}
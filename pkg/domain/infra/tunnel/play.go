@@ -6,6 +6,7 @@ import (
 	"github.com/containers/image/v5/types"
 	"github.com/containers/podman/v2/pkg/bindings/play"
 	"github.com/containers/podman/v2/pkg/domain/entities"
+	"github.com/pkg/errors"
 )
 
 func (ic *ContainerEngine) PlayKube(ctx context.Context, path string, opts entities.PlayKubeOptions) (*entities.PlayKubeReport, error) {
@@ -21,3 +22,7 @@ func (ic *ContainerEngine) PlayKube(ctx context.Context, path string, opts entit
 	}
 	return play.Kube(ic.ClientCtx, path, options)
 }
+
+func (ic *ContainerEngine) PlayKubeDown(ctx context.Context, path string) (*entities.PlayKubeDownReport, error) {
+	return nil, errors.New("not implemented")
+}
@@ -14,3 +14,9 @@ func (ir *ImageEngine) ShowTrust(ctx context.Context, args []string, options ent
 func (ir *ImageEngine) SetTrust(ctx context.Context, args []string, options entities.SetTrustOptions) error {
 	return errors.New("not implemented")
 }
+
+// Verify is not supported on the remote client, the command is only
+// registered for the local engine.
+func (ir *ImageEngine) Verify(ctx context.Context, nameOrID string, options entities.ImageVerifyOptions) (*entities.ImageVerifyReport, error) {
+	return nil, errors.New("verifying images is not supported on the remote client")
+}
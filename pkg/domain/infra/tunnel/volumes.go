@@ -80,3 +80,16 @@ func (ic *ContainerEngine) VolumeList(ctx context.Context, opts entities.VolumeL
 	options := new(volumes.ListOptions).WithFilters(opts.Filter)
 	return volumes.List(ic.ClientCtx, options)
 }
+
+func (ic *ContainerEngine) VolumeExists(ctx context.Context, name string) (*entities.BoolReport, error) {
+	exists, err := volumes.Exists(ic.ClientCtx, name)
+	return &entities.BoolReport{Value: exists}, err
+}
+
+func (ic *ContainerEngine) VolumeExport(ctx context.Context, nameOrID string, options entities.VolumeExportOptions) error {
+	return volumes.Export(ic.ClientCtx, nameOrID, options.Output, nil)
+}
+
+func (ic *ContainerEngine) VolumeImport(ctx context.Context, nameOrID string, options entities.VolumeImportOptions) error {
+	return volumes.Import(ic.ClientCtx, nameOrID, options.Input, nil)
+}
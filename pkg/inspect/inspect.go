@@ -56,3 +56,39 @@ type ImageResult struct {
 	Labels       map[string]string
 	Dangling     bool
 }
+
+// RemoteImageData describes an image on a registry, as reported by its
+// manifest or manifest list.
+type RemoteImageData struct {
+	// Name of the image including its transport.
+	Name string
+	// Digest of the manifest or manifest list.
+	Digest digest.Digest
+	// MediaType of the manifest or manifest list.
+	MediaType string
+	// Size of the manifest or manifest list in bytes.
+	Size int64
+	// Manifests of the image, one per platform.
+	Manifests []RemoteManifestData
+}
+
+// RemoteManifestData describes the manifest of an image for a single
+// platform and its configuration.
+type RemoteManifestData struct {
+	// Digest of the manifest.
+	Digest digest.Digest
+	// MediaType of the manifest.
+	MediaType string
+	// Size of the manifest in bytes.
+	Size int64
+	// Platform the manifest is for.
+	Platform v1.Platform
+	// Created is the creation time of the image, if known.
+	Created *time.Time `json:",omitempty"`
+	// Config is the configuration of the image.
+	Config v1.ImageConfig
+	// Labels of the image.
+	Labels map[string]string
+	// Layers are the digests of the layers of the image.
+	Layers []digest.Digest
+}
@@ -35,9 +35,9 @@ import (
 	"golang.org/x/sys/unix"
 )
 
-// get NSRunDir returns the dir of where to create the netNS. When running
+// GetNSRunDir returns the dir of where to create the netNS. When running
 // rootless, it needs to be at a location writable by user.
-func getNSRunDir() (string, error) {
+func GetNSRunDir() (string, error) {
 	if rootless.IsRootless() {
 		rootlessDir, err := util.GetRuntimeDir()
 		if err != nil {
@@ -51,16 +51,22 @@ func getNSRunDir() (string, error) {
 // NewNS creates a new persistent (bind-mounted) network namespace and returns
 // an object representing that namespace, without switching to it.
 func NewNS() (ns.NetNS, error) {
-
-	nsRunDir, err := getNSRunDir()
+	b := make([]byte, 16)
+	_, err := rand.Reader.Read(b)
 	if err != nil {
-		return nil, err
+		return nil, fmt.Errorf("failed to generate random netns name: %v", err)
 	}
+	nsName := fmt.Sprintf("cni-%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:])
+	return NewNSWithName(nsName)
+}
 
-	b := make([]byte, 16)
-	_, err = rand.Reader.Read(b)
+// NewNSWithName creates a new persistent (bind-mounted) network namespace
+// with the given name in the netns directory, and returns an object
+// representing that namespace, without switching to it.
+func NewNSWithName(nsName string) (ns.NetNS, error) {
+	nsRunDir, err := GetNSRunDir()
 	if err != nil {
-		return nil, fmt.Errorf("failed to generate random netns name: %v", err)
+		return nil, err
 	}
 
 	// Create the directory for mounting network namespaces
@@ -95,8 +101,6 @@ func NewNS() (ns.NetNS, error) {
 
 	}
 
-	nsName := fmt.Sprintf("cni-%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:])
-
 	// create an empty file at the mount point
 	nsPath := path.Join(nsRunDir, nsName)
 	mountPointFd, err := os.Create(nsPath)
@@ -179,7 +183,7 @@ func NewNS() (ns.NetNS, error) {
 
 // UnmountNS unmounts the NS held by the netns object
 func UnmountNS(ns ns.NetNS) error {
-	nsRunDir, err := getNSRunDir()
+	nsRunDir, err := GetNSRunDir()
 	if err != nil {
 		return err
 	}
@@ -105,6 +105,10 @@ func ListContainerBatch(rt *libpod.Runtime, ctr *libpod.Container, opts entities
 		err                                     error
 		exitCode                                int32
 		exited                                  bool
+		health                                  string
+		restartCount                            uint
+		restarting                              bool
+		stoppedByUser                           bool
 		pid                                     int
 		size                                    *psdefine.ContainerSize
 		startedTime                             time.Time
@@ -123,6 +127,24 @@ func ListContainerBatch(rt *libpod.Runtime, ctr *libpod.Container, opts entities
 		if err != nil {
 			return errors.Wrapf(err, "unable to obtain container exit code")
 		}
+		restarting, _, err = c.Restarting()
+		if err != nil {
+			return errors.Wrapf(err, "unable to obtain container restart state")
+		}
+		restartCount, err = c.RestartCount()
+		if err != nil {
+			return errors.Wrapf(err, "unable to obtain container restart count")
+		}
+		if c.HasHealthCheck() {
+			health, err = c.HealthCheckStatus()
+			if err != nil {
+				return errors.Wrapf(err, "unable to obtain container health status")
+			}
+		}
+		stoppedByUser, err = c.StoppedByUser()
+		if err != nil {
+			return errors.Wrapf(err, "unable to obtain container stop state")
+		}
 		startedTime, err = c.StartedTime()
 		if err != nil {
 			logrus.Errorf("error getting started time for %q: %v", c.ID(), err)
@@ -184,26 +206,31 @@ func ListContainerBatch(rt *libpod.Runtime, ctr *libpod.Container, opts entities
 	}
 
 	ps := entities.ListContainer{
-		AutoRemove: ctr.AutoRemove(),
-		Command:    conConfig.Command,
-		Created:    conConfig.CreatedTime,
-		Exited:     exited,
-		ExitCode:   exitCode,
-		ExitedAt:   exitedTime.Unix(),
-		ID:         conConfig.ID,
-		Image:      conConfig.RootfsImageName,
-		ImageID:    conConfig.RootfsImageID,
-		IsInfra:    conConfig.IsInfra,
-		Labels:     conConfig.Labels,
-		Mounts:     ctr.UserVolumes(),
-		Names:      []string{conConfig.Name},
-		Networks:   networks,
-		Pid:        pid,
-		Pod:        conConfig.Pod,
-		Ports:      portMappings,
-		Size:       size,
-		StartedAt:  startedTime.Unix(),
-		State:      conState.String(),
+		AutoRemove:    ctr.AutoRemove(),
+		Command:       conConfig.Command,
+		Created:       conConfig.CreatedTime,
+		Exited:        exited,
+		ExitCode:      exitCode,
+		ExitedAt:      exitedTime.Unix(),
+		Health:        health,
+		ID:            conConfig.ID,
+		Image:         conConfig.RootfsImageName,
+		ImageID:       conConfig.RootfsImageID,
+		IsInfra:       conConfig.IsInfra,
+		Labels:        conConfig.Labels,
+		Mounts:        ctr.UserVolumes(),
+		Names:         []string{conConfig.Name},
+		Networks:      networks,
+		Pid:           pid,
+		Pod:           conConfig.Pod,
+		Ports:         portMappings,
+		RestartCount:  restartCount,
+		Restarting:    restarting,
+		RestartPolicy: conConfig.RestartPolicy,
+		Size:          size,
+		StartedAt:     startedTime.Unix(),
+		State:         conState.String(),
+		StoppedByUser: stoppedByUser,
 	}
 	if opts.Pod && len(conConfig.Pod) > 0 {
 		podName, err := rt.GetName(conConfig.Pod)
@@ -0,0 +1,317 @@
+// +build linux
+
+package quota
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"unsafe"
+
+	"github.com/containers/storage/pkg/lockfile"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"golang.org/x/sys/unix"
+)
+
+// Definitions from linux/fs.h, linux/quota.h and linux/dqblk_xfs.h
+const (
+	fsIocFsGetXattr     = 0x801c581f // _IOR('X', 31, struct fsxattr)
+	fsIocFsSetXattr     = 0x401c5820 // _IOW('X', 32, struct fsxattr)
+	fsXflagProjInherit  = 0x00000200
+	qXGetPQuota         = 0x580302 // QCMD(Q_XGETQUOTA, PRJQUOTA)
+	qXSetPQLim          = 0x580402 // QCMD(Q_XSETQLIM, PRJQUOTA)
+	fsDquotVersion      = 1
+	fsProjQuota         = 2
+	fsDqIsoft           = 1 << 0
+	fsDqIhard           = 1 << 1
+	fsDqBsoft           = 1 << 2
+	fsDqBhard           = 1 << 3
+	basicBlockSize      = 512
+	backingFsBlockDevFn = "backingFsBlockDev"
+	lockFileName        = "quota.lock"
+)
+
+// fsxattr matches struct fsxattr
+type fsxattr struct {
+	xflags     uint32
+	extsize    uint32
+	nextents   uint32
+	projid     uint32
+	cowextsize uint32
+	pad        [8]byte
+}
+
+// fsDiskQuota matches struct fs_disk_quota
+type fsDiskQuota struct {
+	version      int8
+	flags        int8
+	fieldmask    uint16
+	id           uint32
+	blkHardlimit uint64
+	blkSoftlimit uint64
+	inoHardlimit uint64
+	inoSoftlimit uint64
+	bcount       uint64
+	icount       uint64
+	itimer       int32
+	btimer       int32
+	iwarns       uint16
+	bwarns       uint16
+	itimerHi     int8
+	btimerHi     int8
+	rtbtimerHi   int8
+	padding2     int8
+	rtbHardlimit uint64
+	rtbSoftlimit uint64
+	rtbcount     uint64
+	rtbtimer     int32
+	rtbwarns     uint16
+	padding3     int16
+	padding4     [8]byte
+}
+
+// Control sets project quotas on the subdirectories of a base directory.
+// Each directory gets its own project ID, greater than the project ID of the
+// base directory, which can thus be used to keep the IDs handed out by
+// Control apart from the ones managed with xfs_quota.  Project IDs are
+// allocated under a lock file in the base directory, so that several
+// processes can set quotas concurrently.
+type Control struct {
+	basePath          string
+	backingFsBlockDev string
+	minProjectID      uint32
+	lock              lockfile.Locker
+}
+
+// NewControl checks that basePath is on a filesystem with project quotas
+// enabled, and returns a Control for its subdirectories.
+func NewControl(basePath string) (*Control, error) {
+	minProjectID, err := getProjectID(basePath)
+	if err != nil {
+		return nil, err
+	}
+	minProjectID++
+
+	backingFsBlockDev, err := makeBackingFsDev(basePath)
+	if err != nil {
+		return nil, err
+	}
+
+	// Test if the filesystem supports project quotas by setting an empty
+	// quota on the first project ID, which is never handed out.
+	if err := setProjectQuota(backingFsBlockDev, minProjectID, Quota{}); err != nil {
+		return nil, err
+	}
+
+	lock, err := lockfile.GetLockfile(filepath.Join(basePath, lockFileName))
+	if err != nil {
+		return nil, errors.Wrapf(err, "error acquiring quota lock file in %s", basePath)
+	}
+
+	return &Control{
+		basePath:          basePath,
+		backingFsBlockDev: backingFsBlockDev,
+		minProjectID:      minProjectID,
+		lock:              lock,
+	}, nil
+}
+
+// SetQuota sets the limits of targetPath, which must be a subdirectory of the
+// base directory. A new project ID is assigned to targetPath if it does not
+// have one yet. Files and directories created in targetPath inherit it.
+func (q *Control) SetQuota(targetPath string, quota Quota) error {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+
+	projectID, err := getProjectID(targetPath)
+	if err != nil {
+		return err
+	}
+	if projectID <= q.minProjectID {
+		projectID, err = q.findNextProjectID()
+		if err != nil {
+			return err
+		}
+		if err := setProjectID(targetPath, projectID); err != nil {
+			return err
+		}
+	}
+	logrus.Debugf("Setting quota of %s to %d bytes and %d inodes: projectID=%d", targetPath, quota.Size, quota.Inodes, projectID)
+	return setProjectQuota(q.backingFsBlockDev, projectID, quota)
+}
+
+// GetQuota returns the limits and the current usage of targetPath, which must
+// have been configured with SetQuota.
+func (q *Control) GetQuota(targetPath string) (*Quota, *Usage, error) {
+	projectID, err := getProjectID(targetPath)
+	if err != nil {
+		return nil, nil, err
+	}
+	if projectID <= q.minProjectID {
+		return nil, nil, errors.Errorf("no quota set on %s", targetPath)
+	}
+
+	var d fsDiskQuota
+	if err := quotactl(qXGetPQuota, q.backingFsBlockDev, projectID, &d); err != nil {
+		return nil, nil, errors.Wrapf(err, "failed to get quota of project ID %d on %s", projectID, q.backingFsBlockDev)
+	}
+	quota := &Quota{
+		Size:   d.blkHardlimit * basicBlockSize,
+		Inodes: d.inoHardlimit,
+	}
+	usage := &Usage{
+		Size:   d.bcount * basicBlockSize,
+		Inodes: d.icount,
+	}
+	return quota, usage, nil
+}
+
+// ClearQuota removes the limits of targetPath, so that they do not apply to
+// the next directory given its project ID once targetPath is deleted. It does
+// nothing if targetPath has no quota.
+func (q *Control) ClearQuota(targetPath string) error {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+
+	projectID, err := getProjectID(targetPath)
+	if err != nil {
+		return err
+	}
+	if projectID <= q.minProjectID {
+		return nil
+	}
+	logrus.Debugf("Clearing quota of %s: projectID=%d", targetPath, projectID)
+	return setProjectQuota(q.backingFsBlockDev, projectID, Quota{})
+}
+
+// findNextProjectID scans the subdirectories of the base directory to find
+// the next free project ID.  The directories are scanned on every call, as
+// other processes may have assigned project IDs since the last one.  Must be
+// called with the lock held.
+func (q *Control) findNextProjectID() (uint32, error) {
+	files, err := ioutil.ReadDir(q.basePath)
+	if err != nil {
+		return 0, errors.Wrapf(err, "error reading directory %s", q.basePath)
+	}
+	nextProjectID := q.minProjectID + 1
+	for _, file := range files {
+		if !file.IsDir() {
+			continue
+		}
+		projectID, err := getProjectID(filepath.Join(q.basePath, file.Name()))
+		if err != nil {
+			return 0, err
+		}
+		if nextProjectID <= projectID {
+			nextProjectID = projectID + 1
+		}
+	}
+	return nextProjectID, nil
+}
+
+func setProjectQuota(backingFsBlockDev string, projectID uint32, quota Quota) error {
+	d := fsDiskQuota{
+		version:      fsDquotVersion,
+		flags:        fsProjQuota,
+		id:           projectID,
+		fieldmask:    fsDqBhard | fsDqBsoft | fsDqIhard | fsDqIsoft,
+		blkHardlimit: quota.Size / basicBlockSize,
+		blkSoftlimit: quota.Size / basicBlockSize,
+		inoHardlimit: quota.Inodes,
+		inoSoftlimit: quota.Inodes,
+	}
+	if err := quotactl(qXSetPQLim, backingFsBlockDev, projectID, &d); err != nil {
+		if err == unix.ENOSYS || err == unix.ENOTSUP || err == unix.EINVAL || err == unix.ESRCH {
+			return errors.Wrapf(ErrNotSupported, "%s", backingFsBlockDev)
+		}
+		return errors.Wrapf(err, "failed to set quota of project ID %d on %s", projectID, backingFsBlockDev)
+	}
+	return nil
+}
+
+func quotactl(cmd int, special string, id uint32, d *fsDiskQuota) error {
+	cs, err := unix.BytePtrFromString(special)
+	if err != nil {
+		return err
+	}
+	_, _, errno := unix.Syscall6(unix.SYS_QUOTACTL, uintptr(cmd), uintptr(unsafe.Pointer(cs)), uintptr(id), uintptr(unsafe.Pointer(d)), 0, 0)
+	if errno != 0 {
+		return errno
+	}
+	return nil
+}
+
+func getProjectID(targetPath string) (uint32, error) {
+	fsx, err := getFsxattr(targetPath)
+	if err != nil {
+		return 0, err
+	}
+	return fsx.projid, nil
+}
+
+func setProjectID(targetPath string, projectID uint32) error {
+	dir, err := os.Open(targetPath)
+	if err != nil {
+		return err
+	}
+	defer dir.Close()
+
+	var fsx fsxattr
+	if err := ioctl(dir.Fd(), fsIocFsGetXattr, &fsx); err != nil {
+		return errors.Wrapf(err, "failed to get project ID of %s", targetPath)
+	}
+	fsx.projid = projectID
+	fsx.xflags |= fsXflagProjInherit
+	if err := ioctl(dir.Fd(), fsIocFsSetXattr, &fsx); err != nil {
+		return errors.Wrapf(err, "failed to set project ID of %s", targetPath)
+	}
+	return nil
+}
+
+func getFsxattr(targetPath string) (*fsxattr, error) {
+	dir, err := os.Open(targetPath)
+	if err != nil {
+		return nil, err
+	}
+	defer dir.Close()
+
+	var fsx fsxattr
+	if err := ioctl(dir.Fd(), fsIocFsGetXattr, &fsx); err != nil {
+		if err == unix.ENOTTY || err == unix.ENOTSUP {
+			return nil, errors.Wrapf(ErrNotSupported, "%s", targetPath)
+		}
+		return nil, errors.Wrapf(err, "failed to get project ID of %s", targetPath)
+	}
+	return &fsx, nil
+}
+
+func ioctl(fd uintptr, req uint, fsx *fsxattr) error {
+	_, _, errno := unix.Syscall(unix.SYS_IOCTL, fd, uintptr(req), uintptr(unsafe.Pointer(fsx)))
+	if errno != 0 {
+		return errno
+	}
+	return nil
+}
+
+// makeBackingFsDev creates a block device node for the filesystem of home,
+// to be used by quotactl.
+func makeBackingFsDev(home string) (string, error) {
+	var stat unix.Stat_t
+	if err := unix.Stat(home, &stat); err != nil {
+		return "", err
+	}
+
+	backingFsBlockDev := filepath.Join(home, backingFsBlockDevFn)
+	// Re-create just in case someone copied the home directory over to a new device
+	if err := unix.Unlink(backingFsBlockDev); err != nil && !os.IsNotExist(err) {
+		return "", errors.Wrapf(err, "failed to remove %s", backingFsBlockDev)
+	}
+	if err := unix.Mknod(backingFsBlockDev, unix.S_IFBLK|0600, int(stat.Dev)); err != nil {
+		if os.IsPermission(err) {
+			return "", errors.Wrapf(ErrNotSupported, "cannot create %s", backingFsBlockDev)
+		}
+		return "", errors.Wrapf(err, "failed to mknod %s", backingFsBlockDev)
+	}
+	return backingFsBlockDev, nil
+}
@@ -0,0 +1,16 @@
+// +build linux
+
+package quota
+
+import (
+	"testing"
+	"unsafe"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStructSizes(t *testing.T) {
+	// The structures are passed to the kernel as is
+	assert.Equal(t, uintptr(28), unsafe.Sizeof(fsxattr{}))
+	assert.Equal(t, uintptr(112), unsafe.Sizeof(fsDiskQuota{}))
+}
@@ -0,0 +1,26 @@
+// +build !linux
+
+package quota
+
+// Control sets project quotas on the subdirectories of a base directory.
+type Control struct{}
+
+// NewControl returns ErrNotSupported on this platform.
+func NewControl(basePath string) (*Control, error) {
+	return nil, ErrNotSupported
+}
+
+// SetQuota returns ErrNotSupported on this platform.
+func (q *Control) SetQuota(targetPath string, quota Quota) error {
+	return ErrNotSupported
+}
+
+// GetQuota returns ErrNotSupported on this platform.
+func (q *Control) GetQuota(targetPath string) (*Quota, *Usage, error) {
+	return nil, nil, ErrNotSupported
+}
+
+// ClearQuota returns ErrNotSupported on this platform.
+func (q *Control) ClearQuota(targetPath string) error {
+	return ErrNotSupported
+}
@@ -0,0 +1,27 @@
+// Package quota implements XFS project quotas, used to limit the size and the
+// number of inodes of local volumes.
+package quota
+
+import (
+	"github.com/pkg/errors"
+)
+
+// ErrNotSupported is returned when the filesystem does not support project
+// quotas, or when they are not enabled.
+var ErrNotSupported = errors.New("filesystem does not support, or has not enabled, project quotas")
+
+// Quota contains the limits of a directory. A zero value means no limit.
+type Quota struct {
+	// Size is the maximum size in bytes.
+	Size uint64
+	// Inodes is the maximum number of inodes.
+	Inodes uint64
+}
+
+// Usage contains the current usage of a directory with a quota.
+type Usage struct {
+	// Size is the space used in bytes.
+	Size uint64
+	// Inodes is the number of inodes used.
+	Inodes uint64
+}
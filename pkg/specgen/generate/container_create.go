@@ -345,6 +345,24 @@ func createContainerOptions(ctx context.Context, rt *libpod.Runtime, s *specgen.
 			options = append(options, libpod.WithRestartRetries(*s.RestartRetries))
 		}
 		options = append(options, libpod.WithRestartPolicy(s.RestartPolicy))
+
+		// The backoff is opt-in, containers are restarted immediately
+		// unless one of its settings is given
+		if s.RestartDelay != nil || s.RestartMaxDelay != nil || s.RestartResetAfter != nil {
+			delay, maxDelay, resetAfter := libpod.DefaultRestartDelay, libpod.DefaultRestartMaxDelay, libpod.DefaultRestartResetAfter
+			if s.RestartDelay != nil {
+				delay = *s.RestartDelay
+			}
+			if s.RestartMaxDelay != nil {
+				maxDelay = *s.RestartMaxDelay
+			} else if maxDelay < delay {
+				maxDelay = delay
+			}
+			if s.RestartResetAfter != nil {
+				resetAfter = *s.RestartResetAfter
+			}
+			options = append(options, libpod.WithRestartBackoff(delay, maxDelay, resetAfter))
+		}
 	}
 
 	if s.ContainerHealthCheckConfig.HealthConfig != nil {
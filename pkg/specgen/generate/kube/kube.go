@@ -68,6 +68,8 @@ type CtrSpecGenOptions struct {
 	RestartPolicy string
 	// NetNSIsHost tells the container to use the host netns
 	NetNSIsHost bool
+	// Labels are added to the labels of the image
+	Labels map[string]string
 }
 
 func ToSpecGen(ctx context.Context, opts *CtrSpecGenOptions) (*specgen.SpecGenerator, error) {
@@ -152,6 +154,17 @@ func ToSpecGen(ctx context.Context, opts *CtrSpecGenOptions) (*specgen.SpecGener
 			s.StopSignal = &stopSignal
 		}
 	}
+	if len(opts.Labels) > 0 {
+		// Do not modify the labels of the image
+		labels := make(map[string]string, len(s.Labels)+len(opts.Labels))
+		for k, v := range s.Labels {
+			labels[k] = v
+		}
+		for k, v := range opts.Labels {
+			labels[k] = v
+		}
+		s.Labels = labels
+	}
 	// If only the yaml.Command is specified, set it as the entrypoint and drop the image Cmd
 	if len(opts.Container.Command) != 0 {
 		s.Entrypoint = opts.Container.Command
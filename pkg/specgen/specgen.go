@@ -3,6 +3,7 @@ package specgen
 import (
 	"net"
 	"syscall"
+	"time"
 
 	"github.com/containers/image/v5/manifest"
 	"github.com/containers/storage"
@@ -105,6 +106,23 @@ type ContainerBasicConfig struct {
 	// Only available when RestartPolicy is set to "on-failure".
 	// Optional.
 	RestartRetries *uint `json:"restart_tries,omitempty"`
+	// RestartDelay is the delay before the container is restarted by its
+	// restart policy. It doubles each time the container exits again
+	// before running for RestartResetAfter, up to RestartMaxDelay.
+	// A delay of 0 restarts the container immediately.
+	// Only available when RestartPolicy is set.
+	// Optional.
+	RestartDelay *time.Duration `json:"restart_delay,omitempty"`
+	// RestartMaxDelay is the maximum delay before the container is
+	// restarted by its restart policy.
+	// Only available when RestartPolicy is set.
+	// Optional.
+	RestartMaxDelay *time.Duration `json:"restart_max_delay,omitempty"`
+	// RestartResetAfter is the time the container must run for the
+	// restart delay to be reset.
+	// Only available when RestartPolicy is set.
+	// Optional.
+	RestartResetAfter *time.Duration `json:"restart_reset_after,omitempty"`
 	// OCIRuntime is the name of the OCI runtime that will be used to create
 	// the container.
 	// If not specified, the default will be used.
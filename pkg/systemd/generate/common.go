@@ -1,9 +1,15 @@
 package generate
 
 import (
+	"sort"
 	"strconv"
 	"strings"
 
+	"github.com/containernetworking/cni/libcni"
+	"github.com/containers/podman/v2/libpod"
+	"github.com/containers/podman/v2/libpod/define"
+	"github.com/containers/podman/v2/libpod/network"
+	"github.com/containers/podman/v2/pkg/domain/entities"
 	"github.com/pkg/errors"
 )
 
@@ -98,3 +104,176 @@ func removeArg(arg string, args []string) []string {
 	}
 	return newArgs
 }
+
+// volumeInfo describes a named volume used by a container.
+type volumeInfo struct {
+	// Name of the volume.
+	Name string
+	// Driver of the volume.
+	Driver string
+	// Options of the volume driver.
+	Options map[string]string
+	// Labels of the volume.
+	Labels map[string]string
+}
+
+// namedVolumes returns the named volumes of the container.  Anonymous volumes
+// are created along with the container and are hence skipped.
+func namedVolumes(ctr *libpod.Container) ([]volumeInfo, error) {
+	volumes := []volumeInfo{}
+	for _, namedVolume := range ctr.NamedVolumes() {
+		vol, err := ctr.Runtime().GetVolume(namedVolume.Name)
+		if err != nil {
+			return nil, errors.Wrapf(err, "error looking up volume %s of container %s", namedVolume.Name, ctr.ID())
+		}
+		if vol.Anonymous() {
+			continue
+		}
+		volumes = append(volumes, volumeInfo{
+			Name:    vol.Name(),
+			Driver:  vol.Driver(),
+			Options: vol.Options(),
+			Labels:  vol.Labels(),
+		})
+	}
+	return volumes, nil
+}
+
+// networkInfo describes a network a container is connected to.
+type networkInfo struct {
+	// Name of the network.
+	Name string
+	// Options to create the network with its current settings.
+	Options entities.NetworkCreateOptions
+}
+
+// explicitNetworks returns the networks the container has explicitly been
+// connected to along with their settings.
+func explicitNetworks(ctr *libpod.Container) ([]networkInfo, error) {
+	networks, isDefault, err := ctr.Networks()
+	if err != nil {
+		return nil, err
+	}
+	if isDefault {
+		return nil, nil
+	}
+	runtimeConfig, err := ctr.Runtime().GetConfig()
+	if err != nil {
+		return nil, err
+	}
+	infos := make([]networkInfo, 0, len(networks))
+	for _, name := range networks {
+		path, err := network.GetCNIConfigPathByNameOrID(runtimeConfig, name)
+		if err != nil {
+			return nil, errors.Wrapf(err, "error looking up network %s of container %s", name, ctr.ID())
+		}
+		list, err := libcni.ConfListFromFile(path)
+		if err != nil {
+			return nil, errors.Wrapf(err, "error reading configuration of network %s", name)
+		}
+		options, err := network.GetNetworkCreateOptions(list)
+		if err != nil {
+			return nil, err
+		}
+		infos = append(infos, networkInfo{Name: name, Options: *options})
+	}
+	return infos, nil
+}
+
+// createResourcesCommands returns the commands creating the named volumes and
+// networks that do not exist yet.  The commands are meant to be run in an
+// ExecStartPre, so that failing to create a volume or network lets the service
+// fail.  The executable and the root flags are templated.
+func createResourcesCommands(volumes []volumeInfo, networks []networkInfo) []string {
+	commands := []string{}
+	for _, vol := range volumes {
+		command := []string{"volume", "create"}
+		if vol.Driver != "" && vol.Driver != define.VolumeDriverLocal {
+			command = append(command, "--driver", vol.Driver)
+		}
+		command = append(command, sortedKeyValueArgs("--opt", vol.Options)...)
+		command = append(command, sortedKeyValueArgs("--label", vol.Labels)...)
+		command = append(command, vol.Name)
+		commands = append(commands, existsOrCreateCommand([]string{"volume", "exists", vol.Name}, command))
+	}
+	for _, netInfo := range networks {
+		command := append([]string{"network", "create"}, networkCreateArgs(netInfo.Options)...)
+		command = append(command, netInfo.Name)
+		commands = append(commands, existsOrCreateCommand([]string{"network", "exists", netInfo.Name}, command))
+	}
+	return commands
+}
+
+// existsOrCreateCommand returns a shell command that runs the podman create
+// command unless the exists command succeeds.
+func existsOrCreateCommand(exists, create []string) string {
+	prefix := "{{.Executable}} {{if .RootFlags}}{{ .RootFlags}} {{end}}"
+	script := prefix + strings.Join(shellQuoteArguments(exists), " ") + " || " + prefix + strings.Join(shellQuoteArguments(create), " ")
+	return "/bin/sh -c " + strconv.Quote(script)
+}
+
+// shellQuoteArguments quotes the arguments that contain characters which are
+// special to the shell.
+func shellQuoteArguments(command []string) []string {
+	quoted := make([]string, 0, len(command))
+	for _, arg := range command {
+		if arg == "" || strings.IndexFunc(arg, isShellSpecial) >= 0 {
+			arg = "'" + strings.Replace(arg, "'", `'"'"'`, -1) + "'"
+		}
+		quoted = append(quoted, arg)
+	}
+	return quoted
+}
+
+func isShellSpecial(r rune) bool {
+	switch {
+	case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9':
+		return false
+	}
+	return !strings.ContainsRune("-_=./:,@+%", r)
+}
+
+// networkCreateArgs returns the flags of `podman network create` for the
+// options.
+func networkCreateArgs(options entities.NetworkCreateOptions) []string {
+	args := []string{}
+	if options.MacVLAN != "" {
+		args = append(args, "--macvlan", options.MacVLAN)
+	}
+	if options.Subnet.IP != nil {
+		args = append(args, "--subnet", options.Subnet.String())
+	}
+	if options.Gateway != nil {
+		args = append(args, "--gateway", options.Gateway.String())
+	}
+	if options.Range.IP != nil {
+		args = append(args, "--ip-range", options.Range.String())
+	}
+	if options.IPv6 {
+		args = append(args, "--ipv6")
+	}
+	if options.Internal {
+		args = append(args, "--internal")
+	}
+	if options.DisableDNS && options.MacVLAN == "" {
+		args = append(args, "--disable-dns")
+	}
+	args = append(args, sortedKeyValueArgs("--opt", options.Options)...)
+	args = append(args, sortedKeyValueArgs("--label", options.Labels)...)
+	return args
+}
+
+// sortedKeyValueArgs returns the key=value pairs of the map, each preceded by
+// the flag, sorted by key to assure a deterministic output.
+func sortedKeyValueArgs(flag string, m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	args := make([]string, 0, 2*len(keys))
+	for _, k := range keys {
+		args = append(args, flag, k+"="+m[k])
+	}
+	return args
+}
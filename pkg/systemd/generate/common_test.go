@@ -53,3 +53,28 @@ func TestQuoteArguments(t *testing.T) {
 		assert.Equal(t, test.output, quoted)
 	}
 }
+
+func TestShellQuoteArguments(t *testing.T) {
+	tests := []struct {
+		input  []string
+		output []string
+	}{
+		{
+			[]string{"volume", "create", "--label", "app=web", "data"},
+			[]string{"volume", "create", "--label", "app=web", "data"},
+		},
+		{
+			[]string{"--label", "app=my app", ""},
+			[]string{"--label", "'app=my app'", "''"},
+		},
+		{
+			[]string{"--label", "owner=it's $USER"},
+			[]string{"--label", `'owner=it'"'"'s $USER'`},
+		},
+	}
+
+	for _, test := range tests {
+		quoted := shellQuoteArguments(test.input)
+		assert.Equal(t, test.output, quoted)
+	}
+}
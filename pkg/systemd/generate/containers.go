@@ -39,6 +39,9 @@ type containerInfo struct {
 	// BoundToServices are the services this service binds to.  Note that this
 	// service runs after them.
 	BoundToServices []string
+	// RequiredServices are the services this service requires.  Note that
+	// this service runs after them.
+	RequiredServices []string
 	// PodmanVersion for the header. Will be set internally. Will be auto-filled
 	// if left empty.
 	PodmanVersion string
@@ -53,10 +56,18 @@ type containerInfo struct {
 	// CreateCommand is the full command plus arguments of the process the
 	// container has been created with.
 	CreateCommand []string
+	// Volumes are the named volumes of the container.  Only used with --new.
+	Volumes []volumeInfo
+	// Networks are the networks the container is connected to.  Only used
+	// with --new.
+	Networks []networkInfo
 	// EnvVariable is generate.EnvVariable and must not be set.
 	EnvVariable string
 	// ExecStartPre of the unit.
 	ExecStartPre string
+	// ExecStartPreResources of the unit create the volumes and networks
+	// of the container.
+	ExecStartPreResources []string
 	// ExecStart of the unit.
 	ExecStart string
 	// TimeoutStopSec of the unit.
@@ -76,6 +87,10 @@ const containerTemplate = headerTemplate + `
 BindsTo={{- range $index, $value := .BoundToServices -}}{{if $index}} {{end}}{{ $value }}.service{{end}}
 After={{- range $index, $value := .BoundToServices -}}{{if $index}} {{end}}{{ $value }}.service{{end}}
 {{- end}}
+{{- if .RequiredServices}}
+Requires={{- range $index, $value := .RequiredServices -}}{{if $index}} {{end}}{{ $value }}.service{{end}}
+After={{- range $index, $value := .RequiredServices -}}{{if $index}} {{end}}{{ $value }}.service{{end}}
+{{- end}}
 
 [Service]
 Environment={{.EnvVariable}}=%n
@@ -84,6 +99,9 @@ TimeoutStopSec={{.TimeoutStopSec}}
 {{- if .ExecStartPre}}
 ExecStartPre={{.ExecStartPre}}
 {{- end}}
+{{- range .ExecStartPreResources}}
+ExecStartPre={{.}}
+{{- end}}
 ExecStart={{.ExecStart}}
 ExecStop={{.ExecStop}}
 ExecStopPost={{.ExecStopPost}}
@@ -140,6 +158,21 @@ func generateContainerInfo(ctr *libpod.Container, options entities.GenerateSyste
 		CreateCommand:     createCommand,
 	}
 
+	// New containers may run on a host where the named volumes and
+	// networks do not exist yet.
+	if options.New {
+		volumes, err := namedVolumes(ctr)
+		if err != nil {
+			return nil, err
+		}
+		networks, err := explicitNetworks(ctr)
+		if err != nil {
+			return nil, err
+		}
+		info.Volumes = volumes
+		info.Networks = networks
+	}
+
 	return &info, nil
 }
 
@@ -282,6 +315,7 @@ func executeContainerTemplate(info *containerInfo, options entities.GenerateSyst
 		startCommand = quoteArguments(startCommand)
 
 		info.ExecStartPre = "/bin/rm -f {{.PIDFile}} {{.ContainerIDFile}}"
+		info.ExecStartPreResources = createResourcesCommands(info.Volumes, info.Networks)
 		info.ExecStart = strings.Join(startCommand, " ")
 		info.ExecStop = "{{.Executable}} {{if .RootFlags}}{{ .RootFlags}} {{end}}stop --ignore --cidfile {{.ContainerIDFile}} {{if (ge .StopTimeout 0)}}-t {{.StopTimeout}}{{end}}"
 		info.ExecStopPost = "{{.Executable}} {{if .RootFlags}}{{ .RootFlags}} {{end}}rm --ignore -f --cidfile {{.ContainerIDFile}}"
@@ -298,6 +332,7 @@ func executeContainerTemplate(info *containerInfo, options entities.GenerateSyst
 
 	// Sort the slices to assure a deterministic output.
 	sort.Strings(info.BoundToServices)
+	sort.Strings(info.RequiredServices)
 
 	// Generate the template and compile it.
 	//
@@ -1,6 +1,7 @@
 package generate
 
 import (
+	"net"
 	"testing"
 
 	"github.com/containers/podman/v2/pkg/domain/entities"
@@ -325,6 +326,37 @@ ExecStopPost=/usr/bin/podman container rm --ignore -f --cidfile %t/jadda-jadda.c
 PIDFile=%t/jadda-jadda.pid
 Type=forking
 
+[Install]
+WantedBy=multi-user.target default.target
+`
+
+	goodNewRequiresVolumesNetworks := `# jadda-jadda.service
+# autogenerated by Podman CI
+
+[Unit]
+Description=Podman jadda-jadda.service
+Documentation=man:podman-generate-systemd(1)
+Wants=network.target
+After=network-online.target
+BindsTo=pod.service
+After=pod.service
+Requires=container-a.service container-b.service
+After=container-a.service container-b.service
+
+[Service]
+Environment=PODMAN_SYSTEMD_UNIT=%n
+Restart=always
+TimeoutStopSec=70
+ExecStartPre=/bin/rm -f %t/jadda-jadda.pid %t/jadda-jadda.ctr-id
+ExecStartPre=/bin/sh -c "/usr/bin/podman --events-backend none volume exists data || /usr/bin/podman --events-backend none volume create data"
+ExecStartPre=/bin/sh -c "/usr/bin/podman --events-backend none volume exists db || /usr/bin/podman --events-backend none volume create --driver foo --opt o=bar --opt type=tmpfs --label 'app=my app' --label tier=db db"
+ExecStartPre=/bin/sh -c "/usr/bin/podman --events-backend none network exists net1 || /usr/bin/podman --events-backend none network create --subnet 10.89.0.0/24 --disable-dns --opt mtu=1500 --label app=web net1"
+ExecStart=/usr/bin/podman --events-backend none run --conmon-pidfile %t/jadda-jadda.pid --cidfile %t/jadda-jadda.ctr-id --cgroups=no-conmon -d -v data:/data -v db:/db --network net1 awesome-image:latest
+ExecStop=/usr/bin/podman --events-backend none stop --ignore --cidfile %t/jadda-jadda.ctr-id -t 10
+ExecStopPost=/usr/bin/podman --events-backend none rm --ignore -f --cidfile %t/jadda-jadda.ctr-id
+PIDFile=%t/jadda-jadda.pid
+Type=forking
+
 [Install]
 WantedBy=multi-user.target default.target
 `
@@ -608,6 +640,44 @@ WantedBy=multi-user.target default.target
 			true,
 			false,
 		},
+		{"good --new with required services, volumes and networks",
+			containerInfo{
+				Executable:        "/usr/bin/podman",
+				ServiceName:       "jadda-jadda",
+				ContainerNameOrID: "jadda-jadda",
+				RestartPolicy:     "always",
+				PIDFile:           "/var/run/containers/storage/overlay-containers/639c53578af4d84b8800b4635fa4e680ee80fd67e0e6a2d4eea48d1e3230f401/userdata/conmon.pid",
+				StopTimeout:       10,
+				PodmanVersion:     "CI",
+				BoundToServices:   []string{"pod"},
+				RequiredServices:  []string{"container-b", "container-a"},
+				CreateCommand:     []string{"I'll get stripped", "--events-backend", "none", "run", "-v", "data:/data", "-v", "db:/db", "--network", "net1", "awesome-image:latest"},
+				Volumes: []volumeInfo{
+					{Name: "data", Driver: "local"},
+					{
+						Name:    "db",
+						Driver:  "foo",
+						Options: map[string]string{"type": "tmpfs", "o": "bar"},
+						Labels:  map[string]string{"tier": "db", "app": "my app"},
+					},
+				},
+				Networks: []networkInfo{
+					{
+						Name: "net1",
+						Options: entities.NetworkCreateOptions{
+							DisableDNS: true,
+							Labels:     map[string]string{"app": "web"},
+							Options:    map[string]string{"mtu": "1500"},
+							Subnet:     net.IPNet{IP: net.IP{10, 89, 0, 0}, Mask: net.CIDRMask(24, 32)},
+						},
+					},
+				},
+				EnvVariable: EnvVariable,
+			},
+			goodNewRequiresVolumesNetworks,
+			true,
+			false,
+		},
 	}
 	for _, tt := range tests {
 		test := tt
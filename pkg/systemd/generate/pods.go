@@ -10,7 +10,9 @@ import (
 	"time"
 
 	"github.com/containers/podman/v2/libpod"
+	"github.com/containers/podman/v2/libpod/define"
 	"github.com/containers/podman/v2/pkg/domain/entities"
+	"github.com/containers/podman/v2/pkg/util"
 	"github.com/containers/podman/v2/version"
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
@@ -56,10 +58,15 @@ type podInfo struct {
 	// PodCreateCommand - a post-processed variant of CreateCommand to use
 	// when creating the pod.
 	PodCreateCommand string
+	// Networks are the networks the pod is connected to.  Only used with
+	// --new.
+	Networks []networkInfo
 	// EnvVariable is generate.EnvVariable and must not be set.
 	EnvVariable string
 	// ExecStartPre1 of the unit.
 	ExecStartPre1 string
+	// ExecStartPreResources of the unit create the networks of the pod.
+	ExecStartPreResources []string
 	// ExecStartPre2 of the unit.
 	ExecStartPre2 string
 	// ExecStart of the unit.
@@ -82,6 +89,9 @@ TimeoutStopSec={{.TimeoutStopSec}}
 {{- if .ExecStartPre1}}
 ExecStartPre={{.ExecStartPre1}}
 {{- end}}
+{{- range .ExecStartPreResources}}
+ExecStartPre={{.}}
+{{- end}}
 {{- if .ExecStartPre2}}
 ExecStartPre={{.ExecStartPre2}}
 {{- end}}
@@ -141,13 +151,19 @@ func PodUnits(pod *libpod.Pod, options entities.GenerateSystemdOptions) (map[str
 			return nil, err
 		}
 		// Now add the container's dependencies and at the container as a
-		// required service of the infra container.
+		// required service of the infra container.  Containers are bound
+		// to the pod and require the containers they depend on.
+		// Containers whose volumes are used must exist before a new
+		// container can be created.
+		dependencies = append(dependencies, volumesFromContainers(ctr, containers)...)
 		for _, dep := range dependencies {
 			if dep.ID() == infraID {
 				ctrInfo.BoundToServices = append(ctrInfo.BoundToServices, podInfo.ServiceName)
-			} else {
-				_, serviceName := containerServiceName(dep, options)
-				ctrInfo.BoundToServices = append(ctrInfo.BoundToServices, serviceName)
+				continue
+			}
+			_, serviceName := containerServiceName(dep, options)
+			if !util.StringInSlice(serviceName, ctrInfo.RequiredServices) {
+				ctrInfo.RequiredServices = append(ctrInfo.RequiredServices, serviceName)
 			}
 		}
 		podInfo.RequiredServices = append(podInfo.RequiredServices, ctrInfo.ServiceName)
@@ -214,9 +230,48 @@ func generatePodInfo(pod *libpod.Pod, options entities.GenerateSystemdOptions) (
 		GenerateTimestamp: true,
 		CreateCommand:     createCommand,
 	}
+
+	// The containers of the pod join the network namespace of the infra
+	// container, so the networks must exist before the pod is created.
+	if options.New {
+		networks, err := explicitNetworks(infraCtr)
+		if err != nil {
+			return nil, err
+		}
+		info.Networks = networks
+	}
 	return &info, nil
 }
 
+// volumesFromContainers returns the containers of the pod whose volumes the
+// container uses via --volumes-from.
+func volumesFromContainers(ctr *libpod.Container, podContainers []*libpod.Container) []*libpod.Container {
+	annotation, ok := ctr.Spec().Annotations[define.InspectAnnotationVolumesFrom]
+	if !ok || annotation == "" {
+		return nil
+	}
+	volumesFrom := []*libpod.Container{}
+	for _, nameOrID := range strings.Split(annotation, ",") {
+		// Strip the mount options, e.g., "ctr:ro".
+		nameOrID = strings.SplitN(nameOrID, ":", 2)[0]
+		if nameOrID == "" {
+			continue
+		}
+		found := false
+		for _, podCtr := range podContainers {
+			if podCtr.Name() == nameOrID || strings.HasPrefix(podCtr.ID(), nameOrID) {
+				volumesFrom = append(volumesFrom, podCtr)
+				found = true
+				break
+			}
+		}
+		if !found {
+			logrus.Warnf("Container %s uses the volumes of container %s which is not part of the pod: no dependency on it is generated", ctr.ID(), nameOrID)
+		}
+	}
+	return volumesFrom
+}
+
 // executePodTemplate executes the pod template on the specified podInfo.  Note
 // that the podInfo is also post processed and completed, which allows for an
 // easier unit testing.
@@ -309,6 +364,7 @@ func executePodTemplate(info *podInfo, options entities.GenerateSystemdOptions)
 		startCommand = quoteArguments(startCommand)
 
 		info.ExecStartPre1 = "/bin/rm -f {{.PIDFile}} {{.PodIDFile}}"
+		info.ExecStartPreResources = createResourcesCommands(nil, info.Networks)
 		info.ExecStartPre2 = strings.Join(startCommand, " ")
 		info.ExecStart = "{{.Executable}} {{if .RootFlags}}{{ .RootFlags}} {{end}}pod start --pod-id-file {{.PodIDFile}}"
 		info.ExecStop = "{{.Executable}} {{if .RootFlags}}{{ .RootFlags}} {{end}}pod stop --ignore --pod-id-file {{.PodIDFile}} {{if (ge .StopTimeout 0)}}-t {{.StopTimeout}}{{end}}"
@@ -1,6 +1,7 @@
 package generate
 
 import (
+	"net"
 	"testing"
 
 	"github.com/containers/podman/v2/pkg/domain/entities"
@@ -139,6 +140,35 @@ ExecStopPost=/usr/bin/podman pod rm --ignore -f --pod-id-file %t/pod-123abc.pod-
 PIDFile=%t/pod-123abc.pid
 Type=forking
 
+[Install]
+WantedBy=multi-user.target default.target
+`
+
+	podGoodNamedNewWithNetworks := `# pod-123abc.service
+# autogenerated by Podman CI
+
+[Unit]
+Description=Podman pod-123abc.service
+Documentation=man:podman-generate-systemd(1)
+Wants=network.target
+After=network-online.target
+Requires=container-1.service container-2.service
+Before=container-1.service container-2.service
+
+[Service]
+Environment=PODMAN_SYSTEMD_UNIT=%n
+Restart=on-failure
+TimeoutStopSec=70
+ExecStartPre=/bin/rm -f %t/pod-123abc.pid %t/pod-123abc.pod-id
+ExecStartPre=/bin/sh -c "/usr/bin/podman network exists net1 || /usr/bin/podman network create --subnet 192.168.0.0/24 --gateway 192.168.0.10 --ip-range 192.168.0.128/25 --internal net1"
+ExecStartPre=/bin/sh -c "/usr/bin/podman network exists net2 || /usr/bin/podman network create --macvlan eth0 net2"
+ExecStartPre=/usr/bin/podman pod create --infra-conmon-pidfile %t/pod-123abc.pid --pod-id-file %t/pod-123abc.pod-id --name foo --network net1,net2 --replace
+ExecStart=/usr/bin/podman pod start --pod-id-file %t/pod-123abc.pod-id
+ExecStop=/usr/bin/podman pod stop --ignore --pod-id-file %t/pod-123abc.pod-id -t 10
+ExecStopPost=/usr/bin/podman pod rm --ignore -f --pod-id-file %t/pod-123abc.pod-id
+PIDFile=%t/pod-123abc.pid
+Type=forking
+
 [Install]
 WantedBy=multi-user.target default.target
 `
@@ -230,6 +260,37 @@ WantedBy=multi-user.target default.target
 			true,
 			false,
 		},
+		{"pod --new with networks",
+			podInfo{
+				Executable:       "/usr/bin/podman",
+				ServiceName:      "pod-123abc",
+				InfraNameOrID:    "jadda-jadda-infra",
+				RestartPolicy:    "on-failure",
+				PIDFile:          "/run/containers/storage/overlay-containers/639c53578af4d84b8800b4635fa4e680ee80fd67e0e6a2d4eea48d1e3230f401/userdata/conmon.pid",
+				StopTimeout:      10,
+				PodmanVersion:    "CI",
+				RequiredServices: []string{"container-1", "container-2"},
+				CreateCommand:    []string{"podman", "pod", "create", "--name", "foo", "--network", "net1,net2"},
+				Networks: []networkInfo{
+					{
+						Name: "net1",
+						Options: entities.NetworkCreateOptions{
+							Gateway:  net.IP{192, 168, 0, 10},
+							Internal: true,
+							Range:    net.IPNet{IP: net.IP{192, 168, 0, 128}, Mask: net.CIDRMask(25, 32)},
+							Subnet:   net.IPNet{IP: net.IP{192, 168, 0, 0}, Mask: net.CIDRMask(24, 32)},
+						},
+					},
+					{
+						Name:    "net2",
+						Options: entities.NetworkCreateOptions{DisableDNS: true, MacVLAN: "eth0"},
+					},
+				},
+			},
+			podGoodNamedNewWithNetworks,
+			true,
+			false,
+		},
 	}
 
 	for _, tt := range tests {
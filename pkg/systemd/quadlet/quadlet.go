@@ -0,0 +1,617 @@
+package quadlet
+
+import (
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	// ContainerSection is the section of a .container file.
+	ContainerSection = "Container"
+	// PodSection is the section of a .pod file.
+	PodSection = "Pod"
+	// VolumeSection is the section of a .volume file.
+	VolumeSection = "Volume"
+	// NetworkSection is the section of a .network file.
+	NetworkSection = "Network"
+
+	unitSection    = "Unit"
+	serviceSection = "Service"
+
+	// envVariable is set in all generated services to the name of the
+	// service.  It must match generate.EnvVariable which is used by
+	// auto-update to find the unit of a container.
+	envVariable = "PODMAN_SYSTEMD_UNIT"
+)
+
+// Extensions maps the file extensions of quadlet files to the sections
+// describing the resource.
+var Extensions = map[string]string{
+	".container": ContainerSection,
+	".pod":       PodSection,
+	".volume":    VolumeSection,
+	".network":   NetworkSection,
+}
+
+// Supported keys of the quadlet sections.  Any other key is an error.
+var supportedKeys = map[string][]string{
+	ContainerSection: {
+		"AddCapability",
+		"Annotation",
+		"ContainerName",
+		"DropCapability",
+		"Environment",
+		"EnvironmentFile",
+		"Exec",
+		"Group",
+		"HostName",
+		"Image",
+		"Label",
+		"Network",
+		"NoNewPrivileges",
+		"Pod",
+		"PodmanArgs",
+		"PublishPort",
+		"ReadOnly",
+		"RunInit",
+		"Timezone",
+		"User",
+		"Volume",
+		"WorkingDir",
+	},
+	PodSection: {
+		"Label",
+		"Network",
+		"PodName",
+		"PodmanArgs",
+		"PublishPort",
+	},
+	VolumeSection: {
+		"Device",
+		"Driver",
+		"Group",
+		"Label",
+		"Options",
+		"Type",
+		"User",
+		"VolumeName",
+	},
+	NetworkSection: {
+		"DisableDNS",
+		"Driver",
+		"Gateway",
+		"IPRange",
+		"IPv6",
+		"Internal",
+		"Label",
+		"NetworkName",
+		"Options",
+		"Subnet",
+	},
+}
+
+// Generator converts quadlet files to systemd services.
+type Generator struct {
+	// Executable is the path to the podman executable used in the
+	// services.
+	Executable string
+	// units are the quadlet files indexed by their file name.
+	units map[string]*UnitFile
+}
+
+// NewGenerator returns a generator for the quadlet files, which are indexed
+// by their file name (e.g., "web.container").
+func NewGenerator(executable string, units map[string]*UnitFile) *Generator {
+	return &Generator{Executable: executable, units: units}
+}
+
+// ServiceName returns the name of the service generated for the quadlet file
+// with the specified name.  Services of .container files are named after
+// the file, the others get the type of the file as a suffix (e.g.,
+// "data.volume" becomes "data-volume.service").
+func ServiceName(fileName string) (string, error) {
+	ext := filepath.Ext(fileName)
+	if _, ok := Extensions[ext]; !ok {
+		return "", errors.Errorf("unsupported file type %q", fileName)
+	}
+	base := strings.TrimSuffix(fileName, ext)
+	if ext == ".container" {
+		return base + ".service", nil
+	}
+	return base + "-" + strings.TrimPrefix(ext, ".") + ".service", nil
+}
+
+// resourceName returns the name of the podman resource of the quadlet file,
+// which is the value of nameKey or "systemd-" followed by the base name of
+// the file.
+func resourceName(fileName string, unit *UnitFile, section, nameKey string) string {
+	if name, ok := unit.Lookup(section, nameKey); ok && name != "" {
+		return name
+	}
+	return "systemd-" + strings.TrimSuffix(fileName, filepath.Ext(fileName))
+}
+
+// Generate converts all quadlet files of the generator.  The returned map is
+// indexed by the names of the services.  Files which cannot be converted are
+// skipped and reported in the returned errors.
+func (g *Generator) Generate() (map[string]*UnitFile, []error) {
+	services := make(map[string]*UnitFile)
+	var errs []error
+
+	names := make([]string, 0, len(g.units))
+	for name := range g.units {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		service, err := g.Convert(name)
+		if err != nil {
+			errs = append(errs, errors.Wrapf(err, "error converting %s", name))
+			continue
+		}
+		serviceName, _ := ServiceName(name)
+		services[serviceName] = service
+	}
+	return services, errs
+}
+
+// Convert converts the quadlet file with the specified name to a service.
+func (g *Generator) Convert(fileName string) (*UnitFile, error) {
+	unit, ok := g.units[fileName]
+	if !ok {
+		return nil, errors.Errorf("no such file %q", fileName)
+	}
+	section, ok := Extensions[filepath.Ext(fileName)]
+	if !ok {
+		return nil, errors.Errorf("unsupported file type %q", fileName)
+	}
+	if err := checkKeys(unit, section); err != nil {
+		return nil, err
+	}
+
+	// The service keeps all sections except the quadlet section.
+	service := NewUnitFile()
+	service.ensureSection(unitSection)
+	service.ensureSection(serviceSection)
+	for _, s := range unit.sections {
+		if s.name == section {
+			continue
+		}
+		for _, entry := range s.entries {
+			service.Add(s.name, entry.key, entry.value)
+		}
+	}
+
+	var err error
+	switch section {
+	case ContainerSection:
+		err = g.convertContainer(fileName, unit, service)
+	case PodSection:
+		err = g.convertPod(fileName, unit, service)
+	case VolumeSection:
+		err = g.convertVolume(fileName, unit, service)
+	case NetworkSection:
+		err = g.convertNetwork(fileName, unit, service)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return service, nil
+}
+
+// checkKeys makes sure that the quadlet section exists and only contains
+// supported keys.
+func checkKeys(unit *UnitFile, section string) error {
+	if !unit.HasSection(section) {
+		return errors.Errorf("missing [%s] section", section)
+	}
+	for _, key := range unit.Keys(section) {
+		supported := false
+		for _, k := range supportedKeys[section] {
+			if k == key {
+				supported = true
+				break
+			}
+		}
+		if !supported {
+			return errors.Errorf("unsupported key %q in section [%s]", key, section)
+		}
+	}
+	return nil
+}
+
+// dependency resolves a reference to another quadlet file of the specified
+// type.  It returns the name of its resource and its service, which the
+// service of the referencing file requires.
+func (g *Generator) dependency(ref, ext, section, nameKey string, service *UnitFile) (string, error) {
+	unit, ok := g.units[ref]
+	if !ok {
+		return "", errors.Errorf("reference to unknown %s file %q", strings.TrimPrefix(ext, "."), ref)
+	}
+	serviceName, err := ServiceName(ref)
+	if err != nil {
+		return "", err
+	}
+	service.Add(unitSection, "Requires", serviceName)
+	service.Add(unitSection, "After", serviceName)
+	return resourceName(ref, unit, section, nameKey), nil
+}
+
+func (g *Generator) convertContainer(fileName string, unit *UnitFile, service *UnitFile) error {
+	image, _ := unit.Lookup(ContainerSection, "Image")
+	if image == "" {
+		return errors.Errorf("no Image key in section [%s]", ContainerSection)
+	}
+	name := resourceName(fileName, unit, ContainerSection, "ContainerName")
+
+	fixed := []string{
+		g.Executable, "run",
+		"--conmon-pidfile", "%t/%N.pid",
+		"--cidfile", "%t/%N.ctr-id",
+		"--cgroups=no-conmon",
+		"--replace",
+		"-d",
+	}
+	args := []string{"--name", name}
+
+	if pod, ok := unit.Lookup(ContainerSection, "Pod"); ok && pod != "" {
+		if filepath.Ext(pod) != ".pod" {
+			return errors.Errorf("invalid Pod %q: must refer to a .pod file", pod)
+		}
+		if _, ok := g.units[pod]; !ok {
+			return errors.Errorf("reference to unknown pod file %q", pod)
+		}
+		// The network and ports are set when creating the pod, and
+		// `podman run` rejects them for containers in a pod.
+		for _, key := range []string{"Network", "PublishPort"} {
+			if len(unit.LookupAll(ContainerSection, key)) > 0 {
+				return errors.Errorf("%s cannot be combined with Pod, set it in %s", key, pod)
+			}
+		}
+		podService, err := ServiceName(pod)
+		if err != nil {
+			return err
+		}
+		// Like with `generate systemd --new`, the container is bound
+		// to the service of its pod.
+		service.Add(unitSection, "BindsTo", podService)
+		service.Add(unitSection, "After", podService)
+		fixed = append(fixed, "--pod-id-file", "%t/"+strings.TrimSuffix(podService, ".service")+".pod-id")
+	}
+
+	network, err := g.networkArg(unit, ContainerSection, service)
+	if err != nil {
+		return err
+	}
+	if network != "" {
+		args = append(args, "--network", network)
+	}
+
+	for _, volume := range unit.LookupAll(ContainerSection, "Volume") {
+		split := strings.SplitN(volume, ":", 2)
+		if filepath.Ext(split[0]) == ".volume" {
+			volumeName, err := g.dependency(split[0], ".volume", VolumeSection, "VolumeName", service)
+			if err != nil {
+				return err
+			}
+			split[0] = volumeName
+		}
+		args = append(args, "-v", strings.Join(split, ":"))
+	}
+
+	for _, port := range unit.LookupAll(ContainerSection, "PublishPort") {
+		args = append(args, "-p", port)
+	}
+
+	wordFlags := []struct {
+		key  string
+		flag string
+	}{
+		{"Environment", "--env"},
+		{"Label", "--label"},
+		{"Annotation", "--annotation"},
+		{"AddCapability", "--cap-add"},
+		{"DropCapability", "--cap-drop"},
+	}
+	for _, wf := range wordFlags {
+		words, err := unit.LookupWords(ContainerSection, wf.key)
+		if err != nil {
+			return err
+		}
+		for _, word := range words {
+			args = append(args, wf.flag, word)
+		}
+	}
+	for _, envFile := range unit.LookupAll(ContainerSection, "EnvironmentFile") {
+		args = append(args, "--env-file", envFile)
+	}
+
+	user, hasUser := unit.Lookup(ContainerSection, "User")
+	group, hasGroup := unit.Lookup(ContainerSection, "Group")
+	switch {
+	case hasGroup && !hasUser:
+		return errors.Errorf("Group requires User to be set in section [%s]", ContainerSection)
+	case hasGroup:
+		args = append(args, "--user", user+":"+group)
+	case hasUser:
+		args = append(args, "--user", user)
+	}
+
+	stringFlags := []struct {
+		key  string
+		flag string
+	}{
+		{"HostName", "--hostname"},
+		{"WorkingDir", "--workdir"},
+		{"Timezone", "--tz"},
+	}
+	for _, sf := range stringFlags {
+		if value, ok := unit.Lookup(ContainerSection, sf.key); ok && value != "" {
+			args = append(args, sf.flag, value)
+		}
+	}
+
+	boolFlags := []struct {
+		key  string
+		args []string
+	}{
+		{"ReadOnly", []string{"--read-only"}},
+		{"RunInit", []string{"--init"}},
+		{"NoNewPrivileges", []string{"--security-opt", "no-new-privileges"}},
+	}
+	for _, bf := range boolFlags {
+		set, err := unit.LookupBool(ContainerSection, bf.key, false)
+		if err != nil {
+			return err
+		}
+		if set {
+			args = append(args, bf.args...)
+		}
+	}
+
+	podmanArgs, err := unit.LookupWords(ContainerSection, "PodmanArgs")
+	if err != nil {
+		return err
+	}
+	args = append(args, podmanArgs...)
+	args = append(args, image)
+
+	execArgs, err := unit.LookupWords(ContainerSection, "Exec")
+	if err != nil {
+		return err
+	}
+	args = append(args, execArgs...)
+
+	service.Add(serviceSection, "Environment", envVariable+"=%n")
+	service.Add(serviceSection, "ExecStartPre", "/bin/rm -f %t/%N.pid %t/%N.ctr-id")
+	service.Add(serviceSection, "ExecStart", execCommand(fixed, args...))
+	service.Add(serviceSection, "ExecStop", execCommand([]string{g.Executable, "stop", "--ignore", "--cidfile", "%t/%N.ctr-id"}))
+	service.Add(serviceSection, "ExecStopPost", execCommand([]string{g.Executable, "rm", "--ignore", "-f", "--cidfile", "%t/%N.ctr-id"}))
+	service.Add(serviceSection, "PIDFile", "%t/%N.pid")
+	service.Add(serviceSection, "Type", "forking")
+	return nil
+}
+
+// networkArg returns the value of the --network flag for the Network keys
+// in the section.  References to .network files are replaced with the names
+// of their networks.
+func (g *Generator) networkArg(unit *UnitFile, section string, service *UnitFile) (string, error) {
+	networks := unit.LookupAll(section, "Network")
+	if len(networks) == 0 {
+		return "", nil
+	}
+
+	var cniNetworks []string
+	for _, network := range networks {
+		if network == "pod" {
+			return "", errors.Errorf("invalid Network %q: use the Pod key to join a pod", network)
+		}
+		if isNetworkMode(network) {
+			if len(networks) > 1 {
+				return "", errors.Errorf("Network %q cannot be combined with other networks", network)
+			}
+			return network, nil
+		}
+		for _, name := range strings.Split(network, ",") {
+			if filepath.Ext(name) == ".network" {
+				resolved, err := g.dependency(name, ".network", NetworkSection, "NetworkName", service)
+				if err != nil {
+					return "", err
+				}
+				name = resolved
+			}
+			cniNetworks = append(cniNetworks, name)
+		}
+	}
+	return strings.Join(cniNetworks, ","), nil
+}
+
+// isNetworkMode returns true if the value of a Network key is a network
+// mode, as described for --network in podman-run(1), and not a list of
+// networks.
+func isNetworkMode(network string) bool {
+	switch network {
+	case "bridge", "default", "host", "none", "private", "slirp4netns":
+		return true
+	}
+	for _, prefix := range []string{"container:", "ns:", "slirp4netns:"} {
+		if strings.HasPrefix(network, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+func (g *Generator) convertPod(fileName string, unit *UnitFile, service *UnitFile) error {
+	name := resourceName(fileName, unit, PodSection, "PodName")
+
+	fixed := []string{
+		g.Executable, "pod", "create",
+		"--infra-conmon-pidfile", "%t/%N.pid",
+		"--pod-id-file", "%t/%N.pod-id",
+		"--replace",
+	}
+	args := []string{"--name", name}
+
+	network, err := g.networkArg(unit, PodSection, service)
+	if err != nil {
+		return err
+	}
+	if network != "" {
+		args = append(args, "--network", network)
+	}
+	for _, port := range unit.LookupAll(PodSection, "PublishPort") {
+		args = append(args, "-p", port)
+	}
+	labels, err := unit.LookupWords(PodSection, "Label")
+	if err != nil {
+		return err
+	}
+	for _, label := range labels {
+		args = append(args, "--label", label)
+	}
+	podmanArgs, err := unit.LookupWords(PodSection, "PodmanArgs")
+	if err != nil {
+		return err
+	}
+	args = append(args, podmanArgs...)
+
+	// Starting the pod starts the containers referring to it.
+	var containers []string
+	for ref, other := range g.units {
+		if filepath.Ext(ref) != ".container" {
+			continue
+		}
+		if pod, _ := other.Lookup(ContainerSection, "Pod"); pod == fileName {
+			ctrService, err := ServiceName(ref)
+			if err != nil {
+				return err
+			}
+			containers = append(containers, ctrService)
+		}
+	}
+	sort.Strings(containers)
+	if len(containers) > 0 {
+		service.Add(unitSection, "Wants", strings.Join(containers, " "))
+		service.Add(unitSection, "Before", strings.Join(containers, " "))
+	}
+
+	service.Add(serviceSection, "Environment", envVariable+"=%n")
+	service.Add(serviceSection, "ExecStartPre", "/bin/rm -f %t/%N.pid %t/%N.pod-id")
+	service.Add(serviceSection, "ExecStartPre", execCommand(fixed, args...))
+	service.Add(serviceSection, "ExecStart", execCommand([]string{g.Executable, "pod", "start", "--pod-id-file", "%t/%N.pod-id"}))
+	service.Add(serviceSection, "ExecStop", execCommand([]string{g.Executable, "pod", "stop", "--ignore", "--pod-id-file", "%t/%N.pod-id"}))
+	service.Add(serviceSection, "ExecStopPost", execCommand([]string{g.Executable, "pod", "rm", "--ignore", "-f", "--pod-id-file", "%t/%N.pod-id"}))
+	service.Add(serviceSection, "PIDFile", "%t/%N.pid")
+	service.Add(serviceSection, "Type", "forking")
+	return nil
+}
+
+// addOneshot adds the settings of a service creating a resource.  The
+// command is prefixed with "-" as creating fails if the resource already
+// exists.
+func addOneshot(service *UnitFile, fixed, args []string) {
+	service.Add(serviceSection, "ExecStart", "-"+execCommand(fixed, args...))
+	service.Add(serviceSection, "Type", "oneshot")
+	service.Add(serviceSection, "RemainAfterExit", "yes")
+}
+
+func (g *Generator) convertVolume(fileName string, unit *UnitFile, service *UnitFile) error {
+	name := resourceName(fileName, unit, VolumeSection, "VolumeName")
+
+	fixed := []string{g.Executable, "volume", "create"}
+	var args []string
+	if driver, ok := unit.Lookup(VolumeSection, "Driver"); ok && driver != "" {
+		args = append(args, "--driver", driver)
+	}
+	if device, ok := unit.Lookup(VolumeSection, "Device"); ok && device != "" {
+		args = append(args, "--opt", "device="+device)
+	}
+	if fsType, ok := unit.Lookup(VolumeSection, "Type"); ok && fsType != "" {
+		args = append(args, "--opt", "type="+fsType)
+	}
+
+	var mountOpts []string
+	if opts, ok := unit.Lookup(VolumeSection, "Options"); ok && opts != "" {
+		mountOpts = append(mountOpts, opts)
+	}
+	if user, ok := unit.Lookup(VolumeSection, "User"); ok && user != "" {
+		mountOpts = append(mountOpts, "uid="+user)
+	}
+	if group, ok := unit.Lookup(VolumeSection, "Group"); ok && group != "" {
+		mountOpts = append(mountOpts, "gid="+group)
+	}
+	if len(mountOpts) > 0 {
+		args = append(args, "--opt", "o="+strings.Join(mountOpts, ","))
+	}
+
+	labels, err := unit.LookupWords(VolumeSection, "Label")
+	if err != nil {
+		return err
+	}
+	for _, label := range labels {
+		args = append(args, "--label", label)
+	}
+	args = append(args, name)
+
+	addOneshot(service, fixed, args)
+	return nil
+}
+
+func (g *Generator) convertNetwork(fileName string, unit *UnitFile, service *UnitFile) error {
+	name := resourceName(fileName, unit, NetworkSection, "NetworkName")
+
+	fixed := []string{g.Executable, "network", "create"}
+	var args []string
+	stringFlags := []struct {
+		key  string
+		flag string
+	}{
+		{"Driver", "--driver"},
+		{"Subnet", "--subnet"},
+		{"Gateway", "--gateway"},
+		{"IPRange", "--ip-range"},
+	}
+	for _, sf := range stringFlags {
+		if value, ok := unit.Lookup(NetworkSection, sf.key); ok && value != "" {
+			args = append(args, sf.flag, value)
+		}
+	}
+
+	boolFlags := []struct {
+		key  string
+		flag string
+	}{
+		{"Internal", "--internal"},
+		{"DisableDNS", "--disable-dns"},
+		{"IPv6", "--ipv6"},
+	}
+	for _, bf := range boolFlags {
+		set, err := unit.LookupBool(NetworkSection, bf.key, false)
+		if err != nil {
+			return err
+		}
+		if set {
+			args = append(args, bf.flag)
+		}
+	}
+
+	for _, opt := range unit.LookupAll(NetworkSection, "Options") {
+		args = append(args, "--opt", opt)
+	}
+	labels, err := unit.LookupWords(NetworkSection, "Label")
+	if err != nil {
+		return err
+	}
+	for _, label := range labels {
+		args = append(args, "--label", label)
+	}
+	args = append(args, name)
+
+	addOneshot(service, fixed, args)
+	return nil
+}
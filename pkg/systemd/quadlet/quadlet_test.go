@@ -0,0 +1,230 @@
+package quadlet
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func parseUnits(t *testing.T, files map[string]string) map[string]*UnitFile {
+	units := make(map[string]*UnitFile)
+	for name, content := range files {
+		unit, err := ParseUnitFile(strings.NewReader(content))
+		assert.NoError(t, err)
+		units[name] = unit
+	}
+	return units
+}
+
+func TestServiceName(t *testing.T) {
+	tests := []struct {
+		input  string
+		output string
+		fail   bool
+	}{
+		{"web.container", "web.service", false},
+		{"app.pod", "app-pod.service", false},
+		{"data.volume", "data-volume.service", false},
+		{"net.network", "net-network.service", false},
+		{"web.service", "", true},
+	}
+	for _, test := range tests {
+		name, err := ServiceName(test.input)
+		if test.fail {
+			assert.Error(t, err, test.input)
+			continue
+		}
+		assert.NoError(t, err, test.input)
+		assert.Equal(t, test.output, name)
+	}
+}
+
+func TestGenerate(t *testing.T) {
+	files := map[string]string{
+		"web.container": `[Unit]
+Description=Web server
+
+[Container]
+Image=docker.io/library/nginx:latest
+Pod=app.pod
+Volume=data.volume:/data:Z
+Volume=/srv:/srv
+Environment=FOO=bar "GREETING=hello world"
+Label=app=web
+User=1000
+Group=1000
+ReadOnly=yes
+Exec=nginx -g "daemon off;"
+
+[Service]
+Restart=always
+
+[Install]
+WantedBy=default.target
+`,
+		"app.pod": `[Pod]
+PodName=app
+Network=app.network
+PublishPort=8080:80
+`,
+		"data.volume": `[Volume]
+VolumeName=web-data
+User=1000
+Options=nodev
+Label=app=web
+`,
+		"app.network": `[Network]
+Subnet=10.89.10.0/24
+Internal=true
+`,
+	}
+
+	expected := map[string]string{
+		"web.service": `[Unit]
+Description=Web server
+BindsTo=app-pod.service
+After=app-pod.service
+Requires=data-volume.service
+After=data-volume.service
+
+[Service]
+Restart=always
+Environment=PODMAN_SYSTEMD_UNIT=%n
+ExecStartPre=/bin/rm -f %t/%N.pid %t/%N.ctr-id
+ExecStart=/usr/bin/podman run --conmon-pidfile %t/%N.pid --cidfile %t/%N.ctr-id --cgroups=no-conmon --replace -d --pod-id-file %t/app-pod.pod-id --name systemd-web -v web-data:/data:Z -v /srv:/srv --env FOO=bar --env "GREETING=hello world" --label app=web --user 1000:1000 --read-only docker.io/library/nginx:latest nginx -g "daemon off;"
+ExecStop=/usr/bin/podman stop --ignore --cidfile %t/%N.ctr-id
+ExecStopPost=/usr/bin/podman rm --ignore -f --cidfile %t/%N.ctr-id
+PIDFile=%t/%N.pid
+Type=forking
+
+[Install]
+WantedBy=default.target
+`,
+		"app-pod.service": `[Unit]
+Requires=app-network.service
+After=app-network.service
+Wants=web.service
+Before=web.service
+
+[Service]
+Environment=PODMAN_SYSTEMD_UNIT=%n
+ExecStartPre=/bin/rm -f %t/%N.pid %t/%N.pod-id
+ExecStartPre=/usr/bin/podman pod create --infra-conmon-pidfile %t/%N.pid --pod-id-file %t/%N.pod-id --replace --name app --network systemd-app -p 8080:80
+ExecStart=/usr/bin/podman pod start --pod-id-file %t/%N.pod-id
+ExecStop=/usr/bin/podman pod stop --ignore --pod-id-file %t/%N.pod-id
+ExecStopPost=/usr/bin/podman pod rm --ignore -f --pod-id-file %t/%N.pod-id
+PIDFile=%t/%N.pid
+Type=forking
+`,
+		"data-volume.service": `[Unit]
+
+[Service]
+ExecStart=-/usr/bin/podman volume create --opt o=nodev,uid=1000 --label app=web web-data
+Type=oneshot
+RemainAfterExit=yes
+`,
+		"app-network.service": `[Unit]
+
+[Service]
+ExecStart=-/usr/bin/podman network create --subnet 10.89.10.0/24 --internal systemd-app
+Type=oneshot
+RemainAfterExit=yes
+`,
+	}
+
+	generator := NewGenerator("/usr/bin/podman", parseUnits(t, files))
+	services, errs := generator.Generate()
+	assert.Empty(t, errs)
+	assert.Len(t, services, len(expected))
+	for name, content := range expected {
+		service, ok := services[name]
+		if assert.True(t, ok, name) {
+			assert.Equal(t, content, service.String(), name)
+		}
+	}
+}
+
+func TestGenerateContainerNetwork(t *testing.T) {
+	tests := []struct {
+		name    string
+		network string
+		arg     string
+		fail    bool
+	}{
+		{"host", "Network=host", "--network host", false},
+		{"cni networks", "Network=a,b\nNetwork=c", "--network a,b,c", false},
+		{"quadlet network", "Network=net.network", "--network systemd-net", false},
+		{"host and cni network", "Network=host\nNetwork=a", "", true},
+		{"pod", "Network=pod", "", true},
+		{"unknown quadlet network", "Network=missing.network", "", true},
+	}
+	for _, test := range tests {
+		files := map[string]string{
+			"ctr.container": "[Container]\nImage=alpine\n" + test.network + "\n",
+			"net.network":   "[Network]\n",
+		}
+		generator := NewGenerator("/usr/bin/podman", parseUnits(t, files))
+		service, err := generator.Convert("ctr.container")
+		if test.fail {
+			assert.Error(t, err, test.name)
+			continue
+		}
+		assert.NoError(t, err, test.name)
+		execStart, _ := service.Lookup("Service", "ExecStart")
+		assert.Contains(t, execStart, test.arg+" alpine", test.name)
+	}
+}
+
+func TestGenerateErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		file    string
+		content string
+	}{
+		{"missing section", "ctr.container", "[Unit]\nDescription=foo\n"},
+		{"missing image", "ctr.container", "[Container]\nExec=true\n"},
+		{"unsupported key", "ctr.container", "[Container]\nImage=alpine\nFoo=bar\n"},
+		{"group without user", "ctr.container", "[Container]\nImage=alpine\nGroup=1000\n"},
+		{"invalid boolean", "ctr.container", "[Container]\nImage=alpine\nReadOnly=maybe\n"},
+		{"unknown pod", "ctr.container", "[Container]\nImage=alpine\nPod=missing.pod\n"},
+		{"unknown volume", "ctr.container", "[Container]\nImage=alpine\nVolume=missing.volume:/data\n"},
+		{"pod and network", "ctr.container", "[Container]\nImage=alpine\nPod=app.pod\nNetwork=host\n"},
+		{"pod and published port", "ctr.container", "[Container]\nImage=alpine\nPod=app.pod\nPublishPort=80:80\n"},
+		{"unsupported volume key", "data.volume", "[Volume]\nImage=alpine\n"},
+	}
+	for _, test := range tests {
+		files := map[string]string{
+			test.file: test.content,
+			"app.pod": "[Pod]\n",
+		}
+		generator := NewGenerator("/usr/bin/podman", parseUnits(t, files))
+		_, err := generator.Convert(test.file)
+		assert.Error(t, err, test.name)
+	}
+}
+
+func TestGenerateEscaping(t *testing.T) {
+	files := map[string]string{
+		"ctr.container": `[Container]
+Image=alpine
+ContainerName=ctr%i
+Environment=PASS=a$b
+Label=pct=50%%
+Volume=%h/data:/data
+Exec=sh -c "echo $HOME"
+`,
+		"data.volume": "[Volume]\nLabel=pct=50%%\n",
+	}
+	generator := NewGenerator("/usr/bin/podman", parseUnits(t, files))
+
+	service, err := generator.Convert("ctr.container")
+	assert.NoError(t, err)
+	execStart, _ := service.Lookup("Service", "ExecStart")
+	assert.Equal(t, `/usr/bin/podman run --conmon-pidfile %t/%N.pid --cidfile %t/%N.ctr-id --cgroups=no-conmon --replace -d --name ctr%i -v %h/data:/data --env PASS=a$$b --label pct=50%% alpine sh -c "echo $$HOME"`, execStart)
+
+	service, err = generator.Convert("data.volume")
+	assert.NoError(t, err)
+	execStart, _ = service.Lookup("Service", "ExecStart")
+	assert.Equal(t, `-/usr/bin/podman volume create --label pct=50%% systemd-data`, execStart)
+}
@@ -0,0 +1,331 @@
+package quadlet
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// UnitFile is a systemd unit file.  Sections and their entries keep the order
+// in which they have been parsed or added, and keys may occur multiple times.
+type UnitFile struct {
+	sections []*unitFileSection
+}
+
+type unitFileSection struct {
+	name    string
+	entries []unitFileEntry
+}
+
+type unitFileEntry struct {
+	key   string
+	value string
+}
+
+// NewUnitFile returns an empty unit file.
+func NewUnitFile() *UnitFile {
+	return &UnitFile{}
+}
+
+// ParseUnitFile parses a unit file in the syntax described in
+// systemd.syntax(7).  Comments are dropped, and lines ending in a backslash
+// are joined with the following line.
+func ParseUnitFile(r io.Reader) (*UnitFile, error) {
+	unit := NewUnitFile()
+	var (
+		section *unitFileSection
+		pending string
+		lineNum int
+	)
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		lineNum++
+		line := strings.TrimSpace(scanner.Text())
+		if strings.HasPrefix(line, "#") || strings.HasPrefix(line, ";") {
+			continue
+		}
+		if strings.HasSuffix(line, "\\") {
+			pending += strings.TrimRight(strings.TrimSuffix(line, "\\"), " \t") + " "
+			continue
+		}
+		line = strings.TrimSpace(pending + line)
+		pending = ""
+		if line == "" {
+			continue
+		}
+
+		if strings.HasPrefix(line, "[") {
+			if !strings.HasSuffix(line, "]") || len(line) < 3 {
+				return nil, errors.Errorf("invalid section header in line %d: %q", lineNum, line)
+			}
+			section = unit.ensureSection(line[1 : len(line)-1])
+			continue
+		}
+		if section == nil {
+			return nil, errors.Errorf("assignment outside of a section in line %d: %q", lineNum, line)
+		}
+		split := strings.SplitN(line, "=", 2)
+		if len(split) != 2 || strings.TrimSpace(split[0]) == "" {
+			return nil, errors.Errorf("invalid assignment in line %d: %q", lineNum, line)
+		}
+		section.entries = append(section.entries, unitFileEntry{
+			key:   strings.TrimSpace(split[0]),
+			value: strings.TrimSpace(split[1]),
+		})
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	if pending != "" {
+		return nil, errors.Errorf("unexpected end of file after line continuation in line %d", lineNum)
+	}
+	return unit, nil
+}
+
+// ParseUnitFilePath parses the unit file at the specified path.
+func ParseUnitFilePath(path string) (*UnitFile, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	unit, err := ParseUnitFile(f)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error parsing %s", path)
+	}
+	return unit, nil
+}
+
+func (u *UnitFile) findSection(name string) *unitFileSection {
+	for _, section := range u.sections {
+		if section.name == name {
+			return section
+		}
+	}
+	return nil
+}
+
+func (u *UnitFile) ensureSection(name string) *unitFileSection {
+	section := u.findSection(name)
+	if section == nil {
+		section = &unitFileSection{name: name}
+		u.sections = append(u.sections, section)
+	}
+	return section
+}
+
+// HasSection returns true if the unit file has a section with the specified
+// name.
+func (u *UnitFile) HasSection(name string) bool {
+	return u.findSection(name) != nil
+}
+
+// Sections returns the names of all sections.
+func (u *UnitFile) Sections() []string {
+	names := make([]string, 0, len(u.sections))
+	for _, section := range u.sections {
+		names = append(names, section.name)
+	}
+	return names
+}
+
+// Keys returns the keys set in the section in the order of their first
+// occurrence.
+func (u *UnitFile) Keys(section string) []string {
+	s := u.findSection(section)
+	if s == nil {
+		return nil
+	}
+	keys := []string{}
+	seen := make(map[string]bool)
+	for _, entry := range s.entries {
+		if !seen[entry.key] {
+			seen[entry.key] = true
+			keys = append(keys, entry.key)
+		}
+	}
+	return keys
+}
+
+// Lookup returns the last value of the key in the section.  The returned
+// bool indicates whether the key is set.
+func (u *UnitFile) Lookup(section, key string) (string, bool) {
+	s := u.findSection(section)
+	if s == nil {
+		return "", false
+	}
+	for i := len(s.entries) - 1; i >= 0; i-- {
+		if s.entries[i].key == key {
+			return s.entries[i].value, true
+		}
+	}
+	return "", false
+}
+
+// LookupAll returns all values of the key in the section.  As for list
+// settings in systemd, an empty value resets the list.
+func (u *UnitFile) LookupAll(section, key string) []string {
+	s := u.findSection(section)
+	if s == nil {
+		return nil
+	}
+	var values []string
+	for _, entry := range s.entries {
+		if entry.key != key {
+			continue
+		}
+		if entry.value == "" {
+			values = nil
+			continue
+		}
+		values = append(values, entry.value)
+	}
+	return values
+}
+
+// LookupBool returns the last value of the key in the section parsed as a
+// boolean, or def if the key is not set.
+func (u *UnitFile) LookupBool(section, key string, def bool) (bool, error) {
+	value, ok := u.Lookup(section, key)
+	if !ok {
+		return def, nil
+	}
+	switch strings.ToLower(value) {
+	case "1", "yes", "y", "true", "t", "on":
+		return true, nil
+	case "0", "no", "n", "false", "f", "off":
+		return false, nil
+	}
+	return false, errors.Errorf("invalid boolean %q for %s in section [%s]", value, key, section)
+}
+
+// LookupWords returns all values of the key in the section split into
+// words.
+func (u *UnitFile) LookupWords(section, key string) ([]string, error) {
+	var words []string
+	for _, value := range u.LookupAll(section, key) {
+		split, err := SplitWords(value)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid value of %s in section [%s]", key, section)
+		}
+		words = append(words, split...)
+	}
+	return words, nil
+}
+
+// Add appends the key with the value to the section, which is created if
+// needed.
+func (u *UnitFile) Add(section, key, value string) {
+	s := u.ensureSection(section)
+	s.entries = append(s.entries, unitFileEntry{key: key, value: value})
+}
+
+// Write writes the unit file to w.
+func (u *UnitFile) Write(w io.Writer) error {
+	for i, section := range u.sections {
+		if i > 0 {
+			if _, err := fmt.Fprintln(w); err != nil {
+				return err
+			}
+		}
+		if _, err := fmt.Fprintf(w, "[%s]\n", section.name); err != nil {
+			return err
+		}
+		for _, entry := range section.entries {
+			if _, err := fmt.Fprintf(w, "%s=%s\n", entry.key, entry.value); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// String returns the content of the unit file.
+func (u *UnitFile) String() string {
+	var b strings.Builder
+	_ = u.Write(&b)
+	return b.String()
+}
+
+// SplitWords splits the value into words separated by whitespace.  Single
+// and double quotes group words, and a backslash escapes the next character
+// outside of single quotes.
+func SplitWords(value string) ([]string, error) {
+	var (
+		words   []string
+		word    strings.Builder
+		inWord  bool
+		quote   rune
+		escaped bool
+	)
+	for _, r := range value {
+		switch {
+		case escaped:
+			word.WriteRune(r)
+			escaped = false
+		case r == '\\' && quote != '\'':
+			escaped = true
+			inWord = true
+		case quote != 0:
+			if r == quote {
+				quote = 0
+			} else {
+				word.WriteRune(r)
+			}
+		case r == '"' || r == '\'':
+			quote = r
+			inWord = true
+		case r == ' ' || r == '\t' || r == '\n':
+			if inWord {
+				words = append(words, word.String())
+				word.Reset()
+				inWord = false
+			}
+		default:
+			word.WriteRune(r)
+			inWord = true
+		}
+	}
+	if escaped || quote != 0 {
+		return nil, errors.Errorf("unterminated quote or escape in %q", value)
+	}
+	if inWord {
+		words = append(words, word.String())
+	}
+	return words, nil
+}
+
+// quoteArgument quotes the argument if needed to be passed as a single
+// argument in an Exec command of a unit.
+func quoteArgument(arg string) string {
+	if arg == "" || strings.ContainsAny(arg, " \t\n\"'\\") {
+		return strconv.Quote(arg)
+	}
+	return arg
+}
+
+// escapeValue escapes variables in a value of a quadlet file, so that systemd
+// does not expand them in an Exec command. Specifiers such as %h are kept, so
+// they are resolved by systemd.
+func escapeValue(value string) string {
+	return strings.Replace(value, "$", "$$", -1)
+}
+
+// execCommand joins the arguments to a command line for an Exec setting.
+// The fixed arguments are used as is and may contain specifiers such as %t,
+// the variables in the values from quadlet files are escaped.
+func execCommand(fixed []string, values ...string) string {
+	quoted := make([]string, 0, len(fixed)+len(values))
+	for _, arg := range fixed {
+		quoted = append(quoted, quoteArgument(arg))
+	}
+	for _, value := range values {
+		quoted = append(quoted, quoteArgument(escapeValue(value)))
+	}
+	return strings.Join(quoted, " ")
+}
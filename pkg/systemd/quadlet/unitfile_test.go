@@ -0,0 +1,101 @@
+package quadlet
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseUnitFile(t *testing.T) {
+	input := `# comment
+[Unit]
+Description=Some service
+; another comment
+
+[Container]
+Image = alpine
+Exec=sleep \
+  100
+Volume=a:/a
+Volume=
+Volume=b:/b
+Volume=c:/c
+ReadOnly=yes
+`
+	unit, err := ParseUnitFile(strings.NewReader(input))
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"Unit", "Container"}, unit.Sections())
+	assert.Equal(t, []string{"Image", "Exec", "Volume", "ReadOnly"}, unit.Keys("Container"))
+
+	image, ok := unit.Lookup("Container", "Image")
+	assert.True(t, ok)
+	assert.Equal(t, "alpine", image)
+
+	exec, _ := unit.Lookup("Container", "Exec")
+	assert.Equal(t, "sleep 100", exec)
+
+	assert.Equal(t, []string{"b:/b", "c:/c"}, unit.LookupAll("Container", "Volume"))
+
+	readOnly, err := unit.LookupBool("Container", "ReadOnly", false)
+	assert.NoError(t, err)
+	assert.True(t, readOnly)
+
+	_, ok = unit.Lookup("Container", "User")
+	assert.False(t, ok)
+
+	expected := `[Unit]
+Description=Some service
+
+[Container]
+Image=alpine
+Exec=sleep 100
+Volume=a:/a
+Volume=
+Volume=b:/b
+Volume=c:/c
+ReadOnly=yes
+`
+	assert.Equal(t, expected, unit.String())
+}
+
+func TestParseUnitFileErrors(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+	}{
+		{"assignment outside of section", "Image=alpine\n"},
+		{"invalid section header", "[Container\nImage=alpine\n"},
+		{"missing assignment", "[Container]\nImage\n"},
+		{"unterminated continuation", "[Container]\nExec=sleep \\\n"},
+	}
+	for _, test := range tests {
+		_, err := ParseUnitFile(strings.NewReader(test.input))
+		assert.Error(t, err, test.name)
+	}
+}
+
+func TestSplitWords(t *testing.T) {
+	tests := []struct {
+		input  string
+		output []string
+		fail   bool
+	}{
+		{"", nil, false},
+		{"a b  c", []string{"a", "b", "c"}, false},
+		{`A="hello world" B=1`, []string{"A=hello world", "B=1"}, false},
+		{`'single "quoted"' plain`, []string{`single "quoted"`, "plain"}, false},
+		{`escaped\ space ""`, []string{"escaped space", ""}, false},
+		{`"unterminated`, nil, true},
+		{`trailing\`, nil, true},
+	}
+	for _, test := range tests {
+		words, err := SplitWords(test.input)
+		if test.fail {
+			assert.Error(t, err, test.input)
+			continue
+		}
+		assert.NoError(t, err, test.input)
+		assert.Equal(t, test.output, words, test.input)
+	}
+}
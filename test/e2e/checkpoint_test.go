@@ -822,4 +822,72 @@ var _ = Describe("Podman checkpoint", func() {
 		os.Remove(checkpointFileName)
 		os.Remove(preCheckpointFileName)
 	})
+
+	It("podman checkpoint --create-image and restore from the image", func() {
+		localRunString := getRunString([]string{"--name", "checkpoint-src", ALPINE, "top"})
+		session := podmanTest.Podman(localRunString)
+		session.WaitWithDefaultTimeout()
+		Expect(session.ExitCode()).To(Equal(0))
+		cid := session.OutputToString()
+		checkpointImage := "localhost/checkpoint-image:latest"
+
+		result := podmanTest.Podman([]string{"container", "checkpoint", "--create-image", checkpointImage, cid})
+		result.WaitWithDefaultTimeout()
+		Expect(result.ExitCode()).To(Equal(0))
+		Expect(podmanTest.NumberOfContainersRunning()).To(Equal(0))
+		Expect(podmanTest.GetContainerStatus()).To(ContainSubstring("Exited"))
+
+		result = podmanTest.Podman([]string{"image", "exists", checkpointImage})
+		result.WaitWithDefaultTimeout()
+		Expect(result.ExitCode()).To(Equal(0))
+
+		// The container no longer exists, so it is re-created from the image
+		result = podmanTest.Podman([]string{"rm", "-f", cid})
+		result.WaitWithDefaultTimeout()
+		Expect(result.ExitCode()).To(Equal(0))
+
+		result = podmanTest.Podman([]string{"container", "restore", checkpointImage})
+		result.WaitWithDefaultTimeout()
+		Expect(result.ExitCode()).To(Equal(0))
+		Expect(podmanTest.NumberOfContainersRunning()).To(Equal(1))
+		Expect(podmanTest.GetContainerStatus()).To(ContainSubstring("Up"))
+
+		inspect := podmanTest.Podman([]string{"inspect", "--format", "{{.Name}}", "checkpoint-src"})
+		inspect.WaitWithDefaultTimeout()
+		Expect(inspect.ExitCode()).To(Equal(0))
+		Expect(inspect.OutputToString()).To(Equal("checkpoint-src"))
+
+		// A second copy needs a different name and IP address
+		result = podmanTest.Podman([]string{"container", "restore", "--name", "checkpoint-copy", "--ignore-static-ip", checkpointImage})
+		result.WaitWithDefaultTimeout()
+		Expect(result.ExitCode()).To(Equal(0))
+		Expect(podmanTest.NumberOfContainersRunning()).To(Equal(2))
+
+		result = podmanTest.Podman([]string{"rm", "-af"})
+		result.WaitWithDefaultTimeout()
+		Expect(result.ExitCode()).To(Equal(0))
+		result = podmanTest.Podman([]string{"rmi", checkpointImage})
+		result.WaitWithDefaultTimeout()
+		Expect(result.ExitCode()).To(Equal(0))
+	})
+
+	It("podman restore from an image that is not a checkpoint image", func() {
+		result := podmanTest.Podman([]string{"container", "restore", ALPINE})
+		result.WaitWithDefaultTimeout()
+		Expect(result).To(ExitWithError())
+		Expect(result.ErrorToString()).To(ContainSubstring("is not a checkpoint image"))
+	})
+
+	It("podman checkpoint --create-image with --pre-checkpoint", func() {
+		localRunString := getRunString([]string{ALPINE, "top"})
+		session := podmanTest.Podman(localRunString)
+		session.WaitWithDefaultTimeout()
+		Expect(session.ExitCode()).To(Equal(0))
+		cid := session.OutputToString()
+
+		result := podmanTest.Podman([]string{"container", "checkpoint", "--pre-checkpoint", "--create-image", "checkpoint-image", cid})
+		result.WaitWithDefaultTimeout()
+		Expect(result).To(ExitWithError())
+		Expect(podmanTest.NumberOfContainersRunning()).To(Equal(1))
+	})
 })
@@ -4,6 +4,7 @@ import (
 	"io/ioutil"
 	"os"
 	"path/filepath"
+	"strings"
 
 	. "github.com/containers/podman/v2/test/utils"
 	. "github.com/onsi/ginkgo"
@@ -279,4 +280,54 @@ var _ = Describe("Podman commit", func() {
 		data := check.InspectImageJSON()
 		Expect(data[0].ID).To(Equal(string(id)))
 	})
+
+	It("podman commit with --squash and --squash-new", func() {
+		session := podmanTest.Podman([]string{"inspect", "--format", "{{.RootFS.Layers}}", ALPINE})
+		session.WaitWithDefaultTimeout()
+		Expect(session.ExitCode()).To(Equal(0))
+		baseLayers := len(strings.Fields(session.OutputToString()))
+
+		// Commit twice to get an image with two layers on top of the base image
+		session = podmanTest.Podman([]string{"run", "--name", "squash1", ALPINE, "touch", "/squash1"})
+		session.WaitWithDefaultTimeout()
+		Expect(session.ExitCode()).To(Equal(0))
+		session = podmanTest.Podman([]string{"commit", "-q", "squash1", "squash-img1"})
+		session.WaitWithDefaultTimeout()
+		Expect(session.ExitCode()).To(Equal(0))
+		session = podmanTest.Podman([]string{"run", "--name", "squash2", "squash-img1", "rm", "/etc/services"})
+		session.WaitWithDefaultTimeout()
+		Expect(session.ExitCode()).To(Equal(0))
+
+		session = podmanTest.Podman([]string{"commit", "-q", "--squash-new", ALPINE, "squash2", "squash-new"})
+		session.WaitWithDefaultTimeout()
+		Expect(session.ExitCode()).To(Equal(0))
+		session = podmanTest.Podman([]string{"inspect", "--format", "{{.RootFS.Layers}}", "squash-new"})
+		session.WaitWithDefaultTimeout()
+		Expect(session.ExitCode()).To(Equal(0))
+		Expect(len(strings.Fields(session.OutputToString()))).To(Equal(baseLayers + 1))
+
+		session = podmanTest.Podman([]string{"commit", "-q", "--squash", "squash2", "squash-all"})
+		session.WaitWithDefaultTimeout()
+		Expect(session.ExitCode()).To(Equal(0))
+		session = podmanTest.Podman([]string{"inspect", "--format", "{{.RootFS.Layers}}", "squash-all"})
+		session.WaitWithDefaultTimeout()
+		Expect(session.ExitCode()).To(Equal(0))
+		Expect(len(strings.Fields(session.OutputToString()))).To(Equal(1))
+
+		// Both images have the changes of both containers
+		for _, img := range []string{"squash-new", "squash-all"} {
+			session = podmanTest.Podman([]string{"run", "--rm", img, "sh", "-c", "test -f /squash1 && test ! -e /etc/services"})
+			session.WaitWithDefaultTimeout()
+			Expect(session.ExitCode()).To(Equal(0))
+		}
+
+		// The container must be based on the image
+		session = podmanTest.Podman([]string{"commit", "-q", "--squash-new", BB, "squash2", "squash-bogus"})
+		session.WaitWithDefaultTimeout()
+		Expect(session).To(ExitWithError())
+
+		session = podmanTest.Podman([]string{"commit", "-q", "--squash", "--squash-new", ALPINE, "squash2", "squash-bogus"})
+		session.WaitWithDefaultTimeout()
+		Expect(session).To(ExitWithError())
+	})
 })
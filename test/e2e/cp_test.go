@@ -210,6 +210,42 @@ var _ = Describe("Podman cp", func() {
 		Expect(session.OutputToString()).To(ContainSubstring("root"))
 	})
 
+	// Copy a file from one container into another one and make sure that
+	// the contents and the ownership match.
+	It("podman cp from ctr to ctr", func() {
+		session := podmanTest.RunTopContainer("srcctr")
+		session.WaitWithDefaultTimeout()
+		Expect(session.ExitCode()).To(Equal(0))
+
+		session = podmanTest.Podman([]string{"exec", "srcctr", "sh", "-c", "echo podman cp ctr to ctr test > /tmp/testfile && chown 1234:5678 /tmp/testfile"})
+		session.WaitWithDefaultTimeout()
+		Expect(session.ExitCode()).To(Equal(0))
+
+		session = podmanTest.RunTopContainer("destctr")
+		session.WaitWithDefaultTimeout()
+		Expect(session.ExitCode()).To(Equal(0))
+
+		session = podmanTest.Podman([]string{"cp", "srcctr:/tmp/testfile", "destctr:/srv/copied"})
+		session.WaitWithDefaultTimeout()
+		Expect(session.ExitCode()).To(Equal(0))
+
+		session = podmanTest.Podman([]string{"exec", "destctr", "cat", "/srv/copied"})
+		session.WaitWithDefaultTimeout()
+		Expect(session.ExitCode()).To(Equal(0))
+		Expect(session.OutputToString()).To(Equal("podman cp ctr to ctr test"))
+
+		// The ownership is preserved.
+		session = podmanTest.Podman([]string{"exec", "destctr", "stat", "-c", "%u:%g", "/srv/copied"})
+		session.WaitWithDefaultTimeout()
+		Expect(session.ExitCode()).To(Equal(0))
+		Expect(session.OutputToString()).To(Equal("1234:5678"))
+
+		// Cannot copy a nonexistent path.
+		session = podmanTest.Podman([]string{"cp", "srcctr:/IdoNotExist", "destctr:/srv"})
+		session.WaitWithDefaultTimeout()
+		Expect(session).To(ExitWithError())
+	})
+
 	// Copy the root dir "/" of a container to the host.
 	It("podman cp the root directory from the ctr to an existing directory on the host ", func() {
 		container := "copyroottohost"
@@ -92,4 +92,48 @@ var _ = Describe("Podman diff", func() {
 		Expect(session.LineInOutputContains("A /tmp/diff-test")).To(BeTrue())
 		Expect(session.ExitCode()).To(Equal(0))
 	})
+
+	It("podman diff container and image", func() {
+		session := podmanTest.Podman([]string{"run", "--name", "diff-test", ALPINE, "sh", "-c", "echo diff-test > /tmp/diff-test"})
+		session.WaitWithDefaultTimeout()
+		Expect(session.ExitCode()).To(Equal(0))
+		session = podmanTest.Podman([]string{"commit", "diff-test", "diff-test-img"})
+		session.WaitWithDefaultTimeout()
+		Expect(session.ExitCode()).To(Equal(0))
+
+		session = podmanTest.Podman([]string{"diff", ALPINE, "diff-test-img"})
+		session.WaitWithDefaultTimeout()
+		Expect(session.ExitCode()).To(Equal(0))
+		Expect(session.LineInOutputContains("A /tmp/diff-test")).To(BeTrue())
+
+		session = podmanTest.Podman([]string{"diff", "diff-test-img", "diff-test"})
+		session.WaitWithDefaultTimeout()
+		Expect(session.ExitCode()).To(Equal(0))
+		Expect(session.LineInOutputContains("/tmp/diff-test")).To(BeFalse())
+
+		session = podmanTest.Podman([]string{"image", "diff", "diff-test-img", ALPINE})
+		session.WaitWithDefaultTimeout()
+		Expect(session.ExitCode()).To(Equal(0))
+		Expect(session.LineInOutputContains("D /tmp/diff-test")).To(BeTrue())
+	})
+
+	It("podman diff with details", func() {
+		session := podmanTest.Podman([]string{"run", "--name", "diff-test", ALPINE, "sh", "-c", "echo diff-test > /tmp/diff-test && chmod 0600 /tmp/diff-test"})
+		session.WaitWithDefaultTimeout()
+		Expect(session.ExitCode()).To(Equal(0))
+
+		session = podmanTest.Podman([]string{"diff", "--detail", "diff-test"})
+		session.WaitWithDefaultTimeout()
+		Expect(session.ExitCode()).To(Equal(0))
+		Expect(session.LineInOutputContains("A /tmp/diff-test")).To(BeTrue())
+		Expect(session.LineInOutputContains("size: 10")).To(BeTrue())
+		Expect(session.LineInOutputContains("mode: -rw-------")).To(BeTrue())
+		Expect(session.LineInOutputContains("digest: sha256:")).To(BeTrue())
+
+		session = podmanTest.Podman([]string{"diff", "--detail", "--format", "json", "diff-test"})
+		session.WaitWithDefaultTimeout()
+		Expect(session.ExitCode()).To(Equal(0))
+		Expect(session.IsJSONOutputValid()).To(BeTrue())
+		Expect(session.OutputToString()).To(ContainSubstring(`"path":"/tmp/diff-test"`))
+	})
 })
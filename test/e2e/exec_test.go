@@ -527,4 +527,84 @@ RUN useradd -u 1000 auser`
 		stop.WaitWithDefaultTimeout()
 		Expect(stop.ExitCode()).To(Equal(0))
 	})
+
+	It("podman exec --detach keeps exited session for exec-sessions ls", func() {
+		setup := podmanTest.RunTopContainer("test1")
+		setup.WaitWithDefaultTimeout()
+		Expect(setup.ExitCode()).To(Equal(0))
+
+		exec := podmanTest.Podman([]string{"exec", "-d", "--rm-session=false", "test1", "sh", "-c", "exit 3"})
+		exec.WaitWithDefaultTimeout()
+		Expect(exec.ExitCode()).To(Equal(0))
+		sessionID := exec.OutputToString()
+
+		Eventually(func() string {
+			ls := podmanTest.Podman([]string{"container", "exec-sessions", "ls", "--format", "{{.ID}} {{.Status}}", "test1"})
+			ls.WaitWithDefaultTimeout()
+			return ls.OutputToString()
+		}, 10).Should(Equal(sessionID + " Exited (3)"))
+	})
+
+	It("podman exec --detach removes session by default", func() {
+		setup := podmanTest.RunTopContainer("test1")
+		setup.WaitWithDefaultTimeout()
+		Expect(setup.ExitCode()).To(Equal(0))
+
+		exec := podmanTest.Podman([]string{"exec", "-d", "test1", "true"})
+		exec.WaitWithDefaultTimeout()
+		Expect(exec.ExitCode()).To(Equal(0))
+
+		Eventually(func() string {
+			ls := podmanTest.Podman([]string{"container", "exec-sessions", "ls", "-q", "test1"})
+			ls.WaitWithDefaultTimeout()
+			return ls.OutputToString()
+		}, 10).Should(BeEmpty())
+	})
+
+	It("podman exec --attach to running session", func() {
+		setup := podmanTest.RunTopContainer("test1")
+		setup.WaitWithDefaultTimeout()
+		Expect(setup.ExitCode()).To(Equal(0))
+
+		exec := podmanTest.Podman([]string{"exec", "-d", "--rm-session=false", "test1", "sh", "-c", "sleep 2; echo attached; exit 4"})
+		exec.WaitWithDefaultTimeout()
+		Expect(exec.ExitCode()).To(Equal(0))
+		sessionID := exec.OutputToString()
+
+		attach := podmanTest.Podman([]string{"exec", "--attach", sessionID})
+		attach.WaitWithDefaultTimeout()
+		Expect(attach.ExitCode()).To(Equal(4))
+		Expect(attach.OutputToString()).To(Equal("attached"))
+
+		attach = podmanTest.Podman([]string{"exec", "--attach", sessionID})
+		attach.WaitWithDefaultTimeout()
+		Expect(attach.ExitCode()).To(Equal(125))
+	})
+
+	It("podman exec --attach to running session started with default --rm-session", func() {
+		setup := podmanTest.RunTopContainer("test1")
+		setup.WaitWithDefaultTimeout()
+		Expect(setup.ExitCode()).To(Equal(0))
+
+		exec := podmanTest.Podman([]string{"exec", "-d", "test1", "sh", "-c", "sleep 2; echo attached; exit 4"})
+		exec.WaitWithDefaultTimeout()
+		Expect(exec.ExitCode()).To(Equal(0))
+		sessionID := exec.OutputToString()
+
+		attach := podmanTest.Podman([]string{"exec", "--attach", sessionID})
+		attach.WaitWithDefaultTimeout()
+		Expect(attach.ExitCode()).To(Equal(4))
+		Expect(attach.OutputToString()).To(Equal("attached"))
+
+		ls := podmanTest.Podman([]string{"container", "exec-sessions", "ls", "-q", "test1"})
+		ls.WaitWithDefaultTimeout()
+		Expect(ls.ExitCode()).To(Equal(0))
+		Expect(ls.OutputToString()).To(BeEmpty())
+	})
+
+	It("podman exec --attach with container arguments fails", func() {
+		attach := podmanTest.Podman([]string{"exec", "--attach", "abc", "test1", "ls"})
+		attach.WaitWithDefaultTimeout()
+		Expect(attach.ExitCode()).To(Equal(125))
+	})
 })
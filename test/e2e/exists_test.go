@@ -9,7 +9,7 @@ import (
 	. "github.com/onsi/gomega/gexec"
 )
 
-var _ = Describe("Podman image|container exists", func() {
+var _ = Describe("Podman image|container|pod|volume|network exists", func() {
 	var (
 		tempdir    string
 		err        error
@@ -115,4 +115,32 @@ var _ = Describe("Podman image|container exists", func() {
 		session.WaitWithDefaultTimeout()
 		Expect(session).Should(Exit(1))
 	})
+	It("podman volume exists", func() {
+		session := podmanTest.Podman([]string{"volume", "exists", "myvol"})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(Exit(1))
+
+		setup := podmanTest.Podman([]string{"volume", "create", "myvol"})
+		setup.WaitWithDefaultTimeout()
+		Expect(setup).Should(Exit(0))
+
+		session = podmanTest.Podman([]string{"volume", "exists", "myvol"})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(Exit(0))
+	})
+	It("podman network exists", func() {
+		netName := "existsnet"
+		session := podmanTest.Podman([]string{"network", "exists", netName})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(Exit(1))
+
+		setup := podmanTest.Podman([]string{"network", "create", netName})
+		setup.WaitWithDefaultTimeout()
+		defer podmanTest.removeCNINetwork(netName)
+		Expect(setup).Should(Exit(0))
+
+		session = podmanTest.Podman([]string{"network", "exists", netName})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(Exit(0))
+	})
 })
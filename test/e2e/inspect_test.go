@@ -44,6 +44,23 @@ var _ = Describe("Podman inspect", func() {
 		Expect(imageData[0].RepoTags[0]).To(Equal("quay.io/libpod/alpine:latest"))
 	})
 
+	It("podman image inspect --remote", func() {
+		session := podmanTest.Podman([]string{"image", "inspect", "--remote", ALPINE})
+		session.WaitWithDefaultTimeout()
+		Expect(session.ExitCode()).To(Equal(0))
+		Expect(session.IsJSONOutputValid()).To(BeTrue())
+		Expect(session.OutputToString()).To(ContainSubstring("docker://quay.io/libpod/alpine:latest"))
+
+		session = podmanTest.Podman([]string{"image", "inspect", "--remote", "--format", "{{.Digest}} {{range .Manifests}}{{.Platform.OS}} {{end}}", ALPINE})
+		session.WaitWithDefaultTimeout()
+		Expect(session.ExitCode()).To(Equal(0))
+		Expect(session.OutputToString()).To(MatchRegexp("^sha256:[0-9a-f]{64} linux"))
+
+		session = podmanTest.Podman([]string{"image", "inspect", "--remote", "quay.io/libpod/nonesuch:latest"})
+		session.WaitWithDefaultTimeout()
+		Expect(session).To(ExitWithError())
+	})
+
 	It("podman inspect bogus container", func() {
 		session := podmanTest.Podman([]string{"inspect", "foobar4321"})
 		session.WaitWithDefaultTimeout()
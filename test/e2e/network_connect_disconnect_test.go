@@ -214,4 +214,49 @@ var _ = Describe("Podman network connect and disconnect", func() {
 		exec.WaitWithDefaultTimeout()
 		Expect(exec.ExitCode()).ToNot(BeZero())
 	})
+
+	It("podman network connect with static ip and mac address", func() {
+		SkipIfRootless("network connect and disconnect are only rootful")
+		netName := "staticTest" + stringid.GenerateNonCryptoID()
+		session := podmanTest.Podman([]string{"network", "create", "--subnet", "10.50.60.0/24", netName})
+		session.WaitWithDefaultTimeout()
+		Expect(session.ExitCode()).To(BeZero())
+		defer podmanTest.removeCNINetwork(netName)
+
+		ctr := podmanTest.Podman([]string{"create", "--name", "test", ALPINE, "top"})
+		ctr.WaitWithDefaultTimeout()
+		Expect(ctr.ExitCode()).To(BeZero())
+
+		connect := podmanTest.Podman([]string{"network", "connect", "--ip", "10.50.60.5", "--mac-address", "92:d0:c6:0a:29:33", netName, "test"})
+		connect.WaitWithDefaultTimeout()
+		Expect(connect.ExitCode()).To(BeZero())
+
+		start := podmanTest.Podman([]string{"start", "test"})
+		start.WaitWithDefaultTimeout()
+		Expect(start.ExitCode()).To(BeZero())
+
+		exec := podmanTest.Podman([]string{"exec", "-it", "test", "ip", "addr", "show", "eth1"})
+		exec.WaitWithDefaultTimeout()
+		Expect(exec.ExitCode()).To(BeZero())
+		Expect(exec.OutputToString()).To(ContainSubstring("10.50.60.5/24"))
+		Expect(exec.OutputToString()).To(ContainSubstring("92:d0:c6:0a:29:33"))
+
+		// The addresses are kept across restarts
+		restart := podmanTest.Podman([]string{"restart", "test"})
+		restart.WaitWithDefaultTimeout()
+		Expect(restart.ExitCode()).To(BeZero())
+
+		exec = podmanTest.Podman([]string{"exec", "-it", "test", "ip", "addr", "show", "eth1"})
+		exec.WaitWithDefaultTimeout()
+		Expect(exec.ExitCode()).To(BeZero())
+		Expect(exec.OutputToString()).To(ContainSubstring("10.50.60.5/24"))
+	})
+
+	It("podman network connect with invalid ip should fail", func() {
+		SkipIfRootless("network connect and disconnect are only rootful")
+		dis := podmanTest.Podman([]string{"network", "connect", "--ip", "foobar", "podman", "test"})
+		dis.WaitWithDefaultTimeout()
+		Expect(dis.ExitCode()).ToNot(BeZero())
+		Expect(dis.ErrorToString()).To(ContainSubstring("not an ip address"))
+	})
 })
@@ -237,6 +237,41 @@ var _ = Describe("Podman network", func() {
 		Expect(session.LineInOutputContains("0.3.0")).To(BeTrue())
 	})
 
+	It("podman network inspect lists connected containers", func() {
+		netName := "testNetInspectCtrs"
+		network := podmanTest.Podman([]string{"network", "create", "--subnet", "10.50.52.0/24", netName})
+		network.WaitWithDefaultTimeout()
+		Expect(network.ExitCode()).To(BeZero())
+		defer podmanTest.removeCNINetwork(netName)
+
+		ctrName := "testCtr"
+		container := podmanTest.Podman([]string{"run", "-dt", "--network", netName, "--network-alias", "web", "--name", ctrName, ALPINE, "top"})
+		container.WaitWithDefaultTimeout()
+		Expect(container.ExitCode()).To(BeZero())
+		cid := container.OutputToString()
+
+		inspect := podmanTest.Podman([]string{"network", "inspect", netName, "--format", "{{range $id, $ctr := .containers}}{{$id}} {{$ctr.name}} {{$ctr.ipv4Address}} {{$ctr.aliases}}{{end}}"})
+		inspect.WaitWithDefaultTimeout()
+		Expect(inspect.ExitCode()).To(BeZero())
+		fields := strings.Fields(inspect.OutputToString())
+		Expect(len(fields)).To(BeNumerically(">=", 4))
+		Expect(fields[0]).To(Equal(cid))
+		Expect(fields[1]).To(Equal(ctrName))
+		Expect(fields[2]).To(HavePrefix("10.50.52."))
+		Expect(fields[2]).To(HaveSuffix("/24"))
+		Expect(inspect.OutputToString()).To(ContainSubstring("web"))
+
+		// Necessary to ensure the CNI network is removed cleanly
+		rmAll := podmanTest.Podman([]string{"rm", "-f", ctrName})
+		rmAll.WaitWithDefaultTimeout()
+		Expect(rmAll.ExitCode()).To(BeZero())
+
+		inspect = podmanTest.Podman([]string{"network", "inspect", netName, "--format", "{{len .containers}}"})
+		inspect.WaitWithDefaultTimeout()
+		Expect(inspect.ExitCode()).To(BeZero())
+		Expect(inspect.OutputToString()).To(Equal("0"))
+	})
+
 	It("podman inspect container single CNI network", func() {
 		netName := "testNetSingleCNI"
 		network := podmanTest.Podman([]string{"network", "create", "--subnet", "10.50.50.0/24", netName})
@@ -456,4 +491,79 @@ var _ = Describe("Podman network", func() {
 		nc.WaitWithDefaultTimeout()
 		Expect(nc.ExitCode()).To(Equal(0))
 	})
+
+	It("podman network update labels", func() {
+		netName := "updatenet" + stringid.GenerateNonCryptoID()
+		session := podmanTest.Podman([]string{"network", "create", "--label", "env=dev", "--label", "team=web", netName})
+		session.WaitWithDefaultTimeout()
+		Expect(session.ExitCode()).To(BeZero())
+		defer podmanTest.removeCNINetwork(netName)
+
+		update := podmanTest.Podman([]string{"network", "update", "--label-add", "env=prod", "--label-rm", "team", netName})
+		update.WaitWithDefaultTimeout()
+		Expect(update.ExitCode()).To(BeZero())
+
+		session = podmanTest.Podman([]string{"network", "ls", "--filter", "label=env=prod", "--format", "{{.Name}}"})
+		session.WaitWithDefaultTimeout()
+		Expect(session.ExitCode()).To(BeZero())
+		Expect(session.OutputToStringArray()).To(ContainElement(netName))
+
+		session = podmanTest.Podman([]string{"network", "ls", "--filter", "label=team", "--format", "{{.Name}}"})
+		session.WaitWithDefaultTimeout()
+		Expect(session.ExitCode()).To(BeZero())
+		Expect(session.OutputToStringArray()).ToNot(ContainElement(netName))
+
+		// Removing a label the network does not have fails
+		update = podmanTest.Podman([]string{"network", "update", "--label-rm", "team", netName})
+		update.WaitWithDefaultTimeout()
+		Expect(update.ExitCode()).ToNot(BeZero())
+	})
+
+	It("podman network update dns servers", func() {
+		SkipIfRootless("network reload is only supported for rootful containers")
+		netName := "updatenet" + stringid.GenerateNonCryptoID()
+		session := podmanTest.Podman([]string{"network", "create", "--disable-dns", netName})
+		session.WaitWithDefaultTimeout()
+		Expect(session.ExitCode()).To(BeZero())
+		defer podmanTest.removeCNINetwork(netName)
+
+		ctr := podmanTest.Podman([]string{"run", "-dt", "--name", "test", "--network", netName, ALPINE, "top"})
+		ctr.WaitWithDefaultTimeout()
+		Expect(ctr.ExitCode()).To(BeZero())
+
+		update := podmanTest.Podman([]string{"network", "update", "--dns-add", "10.50.70.53", netName})
+		update.WaitWithDefaultTimeout()
+		Expect(update.ExitCode()).To(BeZero())
+
+		inspect := podmanTest.Podman([]string{"network", "inspect", netName, "--format", "{{(index .plugins 0).dns.nameservers}}"})
+		inspect.WaitWithDefaultTimeout()
+		Expect(inspect.ExitCode()).To(BeZero())
+		Expect(inspect.OutputToString()).To(Equal("[10.50.70.53]"))
+
+		// The running container was reloaded
+		exec := podmanTest.Podman([]string{"exec", "test", "cat", "/etc/resolv.conf"})
+		exec.WaitWithDefaultTimeout()
+		Expect(exec.ExitCode()).To(BeZero())
+		Expect(exec.OutputToString()).To(ContainSubstring("nameserver 10.50.70.53"))
+
+		update = podmanTest.Podman([]string{"network", "update", "--dns-drop", "10.50.70.53", netName})
+		update.WaitWithDefaultTimeout()
+		Expect(update.ExitCode()).To(BeZero())
+
+		exec = podmanTest.Podman([]string{"exec", "test", "cat", "/etc/resolv.conf"})
+		exec.WaitWithDefaultTimeout()
+		Expect(exec.ExitCode()).To(BeZero())
+		Expect(exec.OutputToString()).ToNot(ContainSubstring("10.50.70.53"))
+
+		// Necessary to ensure the CNI network is removed cleanly
+		rmAll := podmanTest.Podman([]string{"rm", "-f", "test"})
+		rmAll.WaitWithDefaultTimeout()
+		Expect(rmAll.ExitCode()).To(BeZero())
+	})
+
+	It("podman network update without changes should fail", func() {
+		session := podmanTest.Podman([]string{"network", "update", "podman"})
+		session.WaitWithDefaultTimeout()
+		Expect(session.ExitCode()).ToNot(BeZero())
+	})
 })
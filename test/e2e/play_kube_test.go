@@ -595,6 +595,12 @@ func getCtr(options ...ctrOption) *Ctr {
 
 type ctrOption func(*Ctr)
 
+func withName(name string) ctrOption {
+	return func(c *Ctr) {
+		c.Name = name
+	}
+}
+
 func withCmd(cmd []string) ctrOption {
 	return func(c *Ctr) {
 		c.Cmd = cmd
@@ -1636,4 +1642,64 @@ MemoryReservation: {{ .HostConfig.MemoryReservation }}`})
 		Expect(inspect.ExitCode()).To(Equal(0))
 		Expect(inspect.OutputToString()).To(Equal("true"))
 	})
+
+	It("podman play kube --down removes the pods", func() {
+		SkipIfRemote("podman-remote does not support --down")
+		deployment := getDeployment(withReplicas(2))
+		err := generateKubeYaml("deployment", deployment, kubeYaml)
+		Expect(err).To(BeNil())
+
+		kube := podmanTest.Podman([]string{"play", "kube", kubeYaml})
+		kube.WaitWithDefaultTimeout()
+		Expect(kube.ExitCode()).To(Equal(0))
+
+		pods := podmanTest.Podman([]string{"pod", "ps", "-q"})
+		pods.WaitWithDefaultTimeout()
+		Expect(pods.ExitCode()).To(Equal(0))
+		Expect(len(pods.OutputToStringArray())).To(Equal(2))
+
+		down := podmanTest.Podman([]string{"play", "kube", "--down", kubeYaml})
+		down.WaitWithDefaultTimeout()
+		Expect(down.ExitCode()).To(Equal(0))
+		for _, pod := range pods.OutputToStringArray() {
+			Expect(down.OutputToString()).To(ContainSubstring(pod))
+		}
+
+		pods = podmanTest.Podman([]string{"pod", "ps", "-q"})
+		pods.WaitWithDefaultTimeout()
+		Expect(pods.ExitCode()).To(Equal(0))
+		Expect(len(pods.OutputToStringArray())).To(Equal(0))
+
+		// Tearing down again is a no-op
+		down = podmanTest.Podman([]string{"play", "kube", "--down", kubeYaml})
+		down.WaitWithDefaultTimeout()
+		Expect(down.ExitCode()).To(Equal(0))
+		Expect(down.OutputToString()).To(Equal(""))
+	})
+
+	It("podman play kube applies auto-update annotations to containers", func() {
+		SkipIfRemote("the PODMAN_SYSTEMD_UNIT environment variable is not passed to the service")
+		ctr := getCtr(withName("ctr2"))
+		pod := getPod(withCtr(getCtr()), withCtr(ctr),
+			withAnnotation("io.containers.autoupdate", "image"),
+			withAnnotation("io.containers.autoupdate/ctr2", "disabled"))
+		err := generateKubeYaml("pod", pod, kubeYaml)
+		Expect(err).To(BeNil())
+
+		os.Setenv("PODMAN_SYSTEMD_UNIT", "podman-kube@test.service")
+		kube := podmanTest.Podman([]string{"play", "kube", kubeYaml})
+		kube.WaitWithDefaultTimeout()
+		os.Unsetenv("PODMAN_SYSTEMD_UNIT")
+		Expect(kube.ExitCode()).To(Equal(0))
+
+		inspect := podmanTest.Podman([]string{"inspect", getCtrNameInPod(pod), "--format", "{{ index .Config.Labels \"io.containers.autoupdate\" }} {{ index .Config.Labels \"PODMAN_SYSTEMD_UNIT\" }}"})
+		inspect.WaitWithDefaultTimeout()
+		Expect(inspect.ExitCode()).To(Equal(0))
+		Expect(inspect.OutputToString()).To(Equal("image podman-kube@test.service"))
+
+		inspect = podmanTest.Podman([]string{"inspect", pod.Name + "-ctr2", "--format", "{{ index .Config.Labels \"io.containers.autoupdate\" }}"})
+		inspect.WaitWithDefaultTimeout()
+		Expect(inspect.ExitCode()).To(Equal(0))
+		Expect(inspect.OutputToString()).To(Equal("disabled"))
+	})
 })
@@ -147,6 +147,104 @@ var _ = Describe("Podman prune", func() {
 		Expect(len(images.OutputToStringArray())).To(Equal(len(CACHE_IMAGES)))
 	})
 
+	It("podman image prune --dry-run keeps images", func() {
+		podmanTest.AddImageToRWStore(ALPINE)
+		prune := podmanTest.Podman([]string{"image", "prune", "-a", "--dry-run"})
+		prune.WaitWithDefaultTimeout()
+		Expect(prune.ExitCode()).To(Equal(0))
+		Expect(len(prune.OutputToStringArray()) > 0).To(BeTrue())
+
+		images := podmanTest.Podman([]string{"images", "-aq"})
+		images.WaitWithDefaultTimeout()
+		Expect(len(images.OutputToStringArray())).To(Equal(len(CACHE_IMAGES) + 1))
+	})
+
+	It("podman image prune --until", func() {
+		podmanTest.AddImageToRWStore(ALPINE)
+		prune := podmanTest.Podman([]string{"image", "prune", "-af", "--until", "0s"})
+		prune.WaitWithDefaultTimeout()
+		Expect(prune.ExitCode()).To(Equal(0))
+
+		images := podmanTest.Podman([]string{"images", "-aq"})
+		images.WaitWithDefaultTimeout()
+		Expect(len(images.OutputToStringArray())).To(Equal(len(CACHE_IMAGES)))
+	})
+
+	It("podman image prune --gc with a disabled policy removes nothing", func() {
+		podmanTest.AddImageToRWStore(ALPINE)
+		prune := podmanTest.Podman([]string{"image", "prune", "--gc", "--gc-high-threshold", "0", "-f"})
+		prune.WaitWithDefaultTimeout()
+		Expect(prune.ExitCode()).To(Equal(0))
+		Expect(len(prune.OutputToStringArray())).To(Equal(0))
+	})
+
+	It("podman image prune --gc evicts unused images", func() {
+		podmanTest.AddImageToRWStore(ALPINE)
+		podmanTest.AddImageToRWStore(BB)
+
+		// Using BB records its last-used time, so it is too young to
+		// be evicted.
+		session := podmanTest.Podman([]string{"create", "--name", "gc", BB, "true"})
+		session.WaitWithDefaultTimeout()
+		Expect(session.ExitCode()).To(Equal(0))
+		session = podmanTest.Podman([]string{"rm", "gc"})
+		session.WaitWithDefaultTimeout()
+		Expect(session.ExitCode()).To(Equal(0))
+
+		// Any usage exceeds the high threshold and nothing satisfies the
+		// low threshold, so all eligible images are evicted.
+		gc := []string{"image", "prune", "--gc", "--gc-high-threshold", "1", "--gc-low-threshold", "0", "--gc-min-age", "1h"}
+		prune := podmanTest.Podman(append(gc, "--dry-run"))
+		prune.WaitWithDefaultTimeout()
+		Expect(prune.ExitCode()).To(Equal(0))
+		Expect(prune.OutputToStringArray()).To(Equal([]string{ALPINE}))
+
+		images := podmanTest.Podman([]string{"images", "-aq"})
+		images.WaitWithDefaultTimeout()
+		Expect(len(images.OutputToStringArray())).To(Equal(len(CACHE_IMAGES) + 2))
+
+		prune = podmanTest.Podman(append(gc, "-f"))
+		prune.WaitWithDefaultTimeout()
+		Expect(prune.ExitCode()).To(Equal(0))
+		Expect(prune.OutputToStringArray()).To(Equal([]string{ALPINE}))
+
+		images = podmanTest.Podman([]string{"images", "-aq"})
+		images.WaitWithDefaultTimeout()
+		Expect(len(images.OutputToStringArray())).To(Equal(len(CACHE_IMAGES) + 1))
+	})
+
+	It("podman commit evicts unused images with image_gc_high_threshold in containers.conf", func() {
+		os.Setenv("CONTAINERS_CONF", "config/containers-image-gc.conf")
+		defer os.Unsetenv("CONTAINERS_CONF")
+		if IsRemote() {
+			podmanTest.RestartRemoteService()
+		}
+		podmanTest.AddImageToRWStore(ALPINE)
+		podmanTest.AddImageToRWStore(BB)
+
+		session := podmanTest.Podman([]string{"create", "--name", "gc", BB, "true"})
+		session.WaitWithDefaultTimeout()
+		Expect(session.ExitCode()).To(Equal(0))
+
+		images := podmanTest.Podman([]string{"images", "-aq"})
+		images.WaitWithDefaultTimeout()
+		Expect(len(images.OutputToStringArray())).To(Equal(len(CACHE_IMAGES) + 2))
+
+		// The committed image and BB, which is in use, are kept while
+		// the unused ALPINE is evicted.
+		session = podmanTest.Podman([]string{"commit", "gc", "gc-commit"})
+		session.WaitWithDefaultTimeout()
+		Expect(session.ExitCode()).To(Equal(0))
+
+		images = podmanTest.Podman([]string{"images", "-aq"})
+		images.WaitWithDefaultTimeout()
+		Expect(len(images.OutputToStringArray())).To(Equal(len(CACHE_IMAGES) + 2))
+
+		session = podmanTest.Podman([]string{"image", "exists", "gc-commit"})
+		session.WaitWithDefaultTimeout()
+		Expect(session.ExitCode()).To(Equal(0))
+	})
+
 	It("podman system image prune unused images", func() {
 		podmanTest.AddImageToRWStore(ALPINE)
 		podmanTest.BuildImage(pruneImage, "alpine_bash:latest", "true")
@@ -788,4 +788,51 @@ var _ = Describe("Podman ps", func() {
 		Expect(session.OutputToString()).To(Or(Equal(net1+","+net2), Equal(net2+","+net1)))
 	})
 
+	It("podman ps restart count, exit code and health", func() {
+		session := podmanTest.Podman([]string{"run", "-d", "--name", "hc", "--health-cmd", "true", ALPINE, "top"})
+		session.WaitWithDefaultTimeout()
+		Expect(session.ExitCode()).To(Equal(0))
+		hc := podmanTest.Podman([]string{"healthcheck", "run", "hc"})
+		hc.WaitWithDefaultTimeout()
+		Expect(hc.ExitCode()).To(Equal(0))
+
+		session = podmanTest.Podman([]string{"run", "--name", "exited", ALPINE, "sh", "-c", "exit 2"})
+		session.WaitWithDefaultTimeout()
+		Expect(session.ExitCode()).To(Equal(2))
+
+		result := podmanTest.Podman([]string{"ps", "-a", "--format", "{{.Names}}:{{.RestartCount}}:{{.ExitCode}}:{{.Health}}", "--sort", "names"})
+		result.WaitWithDefaultTimeout()
+		Expect(result.ExitCode()).To(Equal(0))
+		Expect(result.OutputToStringArray()).To(Equal([]string{"exited:0:2:", "hc:0:0:healthy"}))
+
+		result = podmanTest.Podman([]string{"ps", "--format", "{{.Status}}", "--filter", "name=hc"})
+		result.WaitWithDefaultTimeout()
+		Expect(result.ExitCode()).To(Equal(0))
+		Expect(result.OutputToString()).To(ContainSubstring("(healthy)"))
+	})
+
+	It("podman ps filter restart-count", func() {
+		session := podmanTest.Podman([]string{"run", "-d", "--name", "restarted", "--restart", "on-failure:2", ALPINE, "false"})
+		session.WaitWithDefaultTimeout()
+		Expect(session.ExitCode()).To(Equal(0))
+		session = podmanTest.Podman([]string{"create", "--name", "created", ALPINE, "ls"})
+		session.WaitWithDefaultTimeout()
+		Expect(session.ExitCode()).To(Equal(0))
+
+		Eventually(func() []string {
+			result := podmanTest.Podman([]string{"ps", "-a", "--format", "{{.Names}}", "--filter", "restart-count>0"})
+			result.WaitWithDefaultTimeout()
+			return result.OutputToStringArray()
+		}, 30).Should(Equal([]string{"restarted"}))
+
+		result := podmanTest.Podman([]string{"ps", "-a", "--format", "{{.Names}}", "--filter", "restart-count=0"})
+		result.WaitWithDefaultTimeout()
+		Expect(result.ExitCode()).To(Equal(0))
+		Expect(result.OutputToStringArray()).To(Equal([]string{"created"}))
+
+		result = podmanTest.Podman([]string{"ps", "-a", "--filter", "restart-count>x"})
+		result.WaitWithDefaultTimeout()
+		Expect(result.ExitCode()).To(Equal(125))
+	})
+
 })
@@ -3,9 +3,14 @@
 package integration
 
 import (
+	"encoding/json"
 	"fmt"
+	"io/ioutil"
 	"os"
+	"path/filepath"
 	"strconv"
+	"strings"
+	"syscall"
 	"time"
 
 	. "github.com/containers/podman/v2/test/utils"
@@ -104,6 +109,84 @@ var _ = Describe("Podman stats", func() {
 		Expect(stats.IsJSONOutputValid()).To(BeTrue())
 	})
 
+	It("podman stats --output records json samples with the networks", func() {
+		session := podmanTest.RunTopContainer("")
+		session.WaitWithDefaultTimeout()
+		Expect(session.ExitCode()).To(Equal(0))
+		cid := session.OutputToString()
+
+		output := filepath.Join(podmanTest.TempDir, "stats.json")
+		stats := podmanTest.Podman([]string{"stats", "--no-stream", "--output", output, cid})
+		stats.WaitWithDefaultTimeout()
+		Expect(stats.ExitCode()).To(Equal(0))
+		Expect(stats.OutputToString()).To(BeEmpty())
+
+		content, err := ioutil.ReadFile(output)
+		Expect(err).To(BeNil())
+		lines := strings.Split(strings.TrimSpace(string(content)), "\n")
+		Expect(len(lines)).To(Equal(1))
+		var record struct {
+			Time        time.Time
+			ContainerID string
+			MemUsage    uint64
+			Network     map[string]interface{}
+		}
+		Expect(json.Unmarshal([]byte(lines[0]), &record)).To(BeNil())
+		Expect(record.ContainerID).To(Equal(cid))
+		Expect(record.Time.IsZero()).To(BeFalse())
+		Expect(record.MemUsage).To(BeNumerically(">", 0))
+		Expect(len(record.Network)).To(BeNumerically(">", 0))
+	})
+
+	It("podman stats --output appends csv samples with a single header", func() {
+		session := podmanTest.RunTopContainer("")
+		session.WaitWithDefaultTimeout()
+		Expect(session.ExitCode()).To(Equal(0))
+		cid := session.OutputToString()
+
+		output := filepath.Join(podmanTest.TempDir, "stats.csv")
+		for i := 0; i < 2; i++ {
+			stats := podmanTest.Podman([]string{"stats", "--no-stream", "--output", output, "--output-format", "csv", cid})
+			stats.WaitWithDefaultTimeout()
+			Expect(stats.ExitCode()).To(Equal(0))
+		}
+
+		content, err := ioutil.ReadFile(output)
+		Expect(err).To(BeNil())
+		lines := strings.Split(strings.TrimSpace(string(content)), "\n")
+		Expect(len(lines)).To(Equal(3))
+		Expect(lines[0]).To(HavePrefix("time,id,name,"))
+		Expect(lines[1]).To(ContainSubstring(cid))
+		Expect(lines[2]).To(ContainSubstring(cid))
+	})
+
+	It("podman stats --interval", func() {
+		session := podmanTest.RunTopContainer("")
+		session.WaitWithDefaultTimeout()
+		Expect(session.ExitCode()).To(Equal(0))
+		cid := session.OutputToString()
+
+		output := filepath.Join(podmanTest.TempDir, "stats.json")
+		stats := podmanTest.Podman([]string{"stats", "--interval", "2s", "--output", output, cid})
+		time.Sleep(5 * time.Second)
+		stats.Signal(syscall.SIGTERM)
+		stats.WaitWithDefaultTimeout()
+
+		// Samples are taken right away and every two seconds after.
+		content, err := ioutil.ReadFile(output)
+		Expect(err).To(BeNil())
+		lines := strings.Split(strings.TrimSpace(string(content)), "\n")
+		Expect(len(lines)).To(BeNumerically(">=", 2))
+		Expect(len(lines)).To(BeNumerically("<=", 3))
+	})
+
+	It("podman stats --interval below one second fails", func() {
+		stats := podmanTest.Podman([]string{"stats", "--interval", "500ms", "--no-stream", "-a"})
+		stats.WaitWithDefaultTimeout()
+		Expect(stats.ExitCode()).To(Equal(125))
+		Expect(stats.ErrorToString()).To(ContainSubstring("--interval must be at least one second"))
+	})
+
 	It("podman stats on a container with no net ns", func() {
 		session := podmanTest.Podman([]string{"run", "-d", "--net", "none", ALPINE, "top"})
 		session.WaitWithDefaultTimeout()
@@ -6,6 +6,7 @@ import (
 	"os"
 	"path/filepath"
 
+	"github.com/containers/podman/v2/pkg/domain/entities"
 	. "github.com/containers/podman/v2/test/utils"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -95,4 +96,30 @@ var _ = Describe("Podman trust", func() {
 		Expect(session.OutputToString()).To(ContainSubstring("default"))
 		Expect(session.OutputToString()).To(ContainSubstring("insecureAcceptAnything"))
 	})
+
+	It("podman image verify", func() {
+		path, err := os.Getwd()
+		if err != nil {
+			os.Exit(1)
+		}
+		policyPath := filepath.Join(filepath.Dir(path), "policy.json")
+		session := podmanTest.Podman([]string{"image", "verify", "--policypath", policyPath, "--json", ALPINE})
+		session.WaitWithDefaultTimeout()
+		Expect(session.ExitCode()).To(Equal(0))
+		Expect(session.IsJSONOutputValid()).To(BeTrue())
+		var report entities.ImageVerifyReport
+		err = json.Unmarshal(session.Out.Contents(), &report)
+		Expect(err).To(BeNil())
+		Expect(report.Allowed).To(BeTrue())
+		Expect(report.Scope).To(Equal(""))
+		Expect(len(report.Requirements)).To(Equal(1))
+		Expect(report.Requirements[0].Type).To(Equal("insecureAcceptAnything"))
+
+		session = podmanTest.Podman([]string{"image", "verify", "--policypath", policyPath, "docker.io/library/hello-world"})
+		session.WaitWithDefaultTimeout()
+		Expect(session.ExitCode()).To(Equal(125))
+		Expect(session.OutputToString()).To(ContainSubstring("docker.io/library/hello-world"))
+		Expect(session.OutputToString()).To(ContainSubstring("reject"))
+		Expect(session.ErrorToString()).To(ContainSubstring("does not satisfy the trust policy"))
+	})
 })
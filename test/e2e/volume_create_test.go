@@ -3,6 +3,9 @@ package integration
 import (
 	"fmt"
 	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
 
 	. "github.com/containers/podman/v2/test/utils"
 	. "github.com/onsi/ginkgo"
@@ -91,4 +94,71 @@ var _ = Describe("Podman volume create", func() {
 		Expect(inspectOpts.ExitCode()).To(Equal(0))
 		Expect(inspectOpts.OutputToString()).To(Equal(optionStrFormatExpect))
 	})
+
+	It("podman create volume with size and inodes quotas", func() {
+		SkipIfRootless("project quotas require root privileges")
+		SkipIfRemote("the filesystem with quotas is mounted on the volume path of the local storage")
+		if _, err := exec.LookPath("mkfs.xfs"); err != nil {
+			Skip("mkfs.xfs is not available")
+		}
+
+		// Mount a loopback XFS filesystem with project quotas on the
+		// volume path.
+		volumePath := filepath.Join(podmanTest.CrioRoot, "volumes")
+		Expect(os.MkdirAll(volumePath, 0700)).To(BeNil())
+		image := filepath.Join(tempdir, "xfs.img")
+		f, err := os.Create(image)
+		Expect(err).To(BeNil())
+		Expect(f.Truncate(300 * 1024 * 1024)).To(BeNil())
+		f.Close()
+		mkfs := SystemExec("mkfs.xfs", []string{"-q", image})
+		Expect(mkfs.ExitCode()).To(Equal(0))
+		mount := SystemExec("mount", []string{"-o", "loop,prjquota", image, volumePath})
+		Expect(mount.ExitCode()).To(Equal(0))
+		defer SystemExec("umount", []string{volumePath})
+
+		// Create the volumes concurrently, each of them must get its
+		// own project ID.
+		var sessions []*PodmanSessionIntegration
+		for i := 0; i < 4; i++ {
+			session := podmanTest.Podman([]string{"volume", "create", "--opt", "size=10m", "--opt", "inodes=100", fmt.Sprintf("quotavol%d", i)})
+			sessions = append(sessions, session)
+		}
+		defer podmanTest.Podman([]string{"volume", "rm", "-a", "-f"}).WaitWithDefaultTimeout()
+		for _, session := range sessions {
+			session.WaitWithDefaultTimeout()
+			Expect(session.ExitCode()).To(Equal(0))
+		}
+
+		inspect := podmanTest.Podman([]string{"volume", "inspect", "--format", "{{ .Quota.Size }} {{ .Quota.Inodes }}", "quotavol0"})
+		inspect.WaitWithDefaultTimeout()
+		Expect(inspect.ExitCode()).To(Equal(0))
+		Expect(inspect.OutputToString()).To(Equal(fmt.Sprintf("%d 100", 10*1024*1024)))
+
+		// Volumes sharing a project ID would share the limit, so
+		// writing 6MB to each of them only succeeds with distinct IDs.
+		for i := range sessions {
+			session := podmanTest.Podman([]string{"run", "--rm", "-v", fmt.Sprintf("quotavol%d:/data", i), ALPINE, "dd", "if=/dev/zero", "of=/data/file", "bs=1M", "count=6"})
+			session.WaitWithDefaultTimeout()
+			Expect(session.ExitCode()).To(Equal(0))
+		}
+
+		inspect = podmanTest.Podman([]string{"volume", "inspect", "--format", "{{ .Quota.SizeUsed }}", "quotavol0"})
+		inspect.WaitWithDefaultTimeout()
+		Expect(inspect.ExitCode()).To(Equal(0))
+		sizeUsed, err := strconv.Atoi(inspect.OutputToString())
+		Expect(err).To(BeNil())
+		Expect(sizeUsed).To(BeNumerically(">=", 6*1024*1024))
+
+		// The size limit is enforced...
+		session := podmanTest.Podman([]string{"run", "--rm", "-v", "quotavol0:/data", ALPINE, "dd", "if=/dev/zero", "of=/data/file2", "bs=1M", "count=6"})
+		session.WaitWithDefaultTimeout()
+		Expect(session).To(ExitWithError())
+		Expect(session.ErrorToString()).To(ContainSubstring("No space left on device"))
+
+		// ...and so is the inodes limit.
+		session = podmanTest.Podman([]string{"run", "--rm", "-v", "quotavol1:/data", ALPINE, "sh", "-c", "for i in $(seq 200); do touch /data/f$i || exit 1; done"})
+		session.WaitWithDefaultTimeout()
+		Expect(session).To(ExitWithError())
+	})
 })
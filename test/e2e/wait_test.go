@@ -130,4 +130,76 @@ var _ = Describe("Podman wait", func() {
 		Expect(session.ExitCode()).To(Equal(0))
 		Expect(session.OutputToStringArray()).To(Equal([]string{"0", "0", "0"}))
 	})
+
+	It("podman wait --condition running", func() {
+		session := podmanTest.Podman([]string{"create", "--name", "test", ALPINE, "top"})
+		session.WaitWithDefaultTimeout()
+		Expect(session.ExitCode()).To(Equal(0))
+
+		wait := podmanTest.Podman([]string{"wait", "--condition", "running", "test"})
+		start := podmanTest.Podman([]string{"start", "test"})
+		start.WaitWithDefaultTimeout()
+		Expect(start.ExitCode()).To(Equal(0))
+		wait.WaitWithDefaultTimeout()
+		Expect(wait.ExitCode()).To(Equal(0))
+		Expect(wait.OutputToString()).To(Equal("-1"))
+	})
+
+	It("podman wait with multiple conditions ends at the first one met", func() {
+		session := podmanTest.Podman([]string{"run", "-d", "--name", "test", ALPINE, "sh", "-c", "exit 3"})
+		session.WaitWithDefaultTimeout()
+		Expect(session.ExitCode()).To(Equal(0))
+
+		wait := podmanTest.Podman([]string{"wait", "--condition", "removed", "--condition", "stopped", "test"})
+		wait.WaitWithDefaultTimeout()
+		Expect(wait.ExitCode()).To(Equal(0))
+		Expect(wait.OutputToString()).To(Equal("3"))
+	})
+
+	It("podman wait --condition removed", func() {
+		session := podmanTest.Podman([]string{"run", "-d", "--name", "test", ALPINE, "top"})
+		session.WaitWithDefaultTimeout()
+		Expect(session.ExitCode()).To(Equal(0))
+
+		wait := podmanTest.Podman([]string{"wait", "--condition", "removed", "test"})
+		rm := podmanTest.Podman([]string{"rm", "-f", "-t", "0", "test"})
+		rm.WaitWithDefaultTimeout()
+		Expect(rm.ExitCode()).To(Equal(0))
+		wait.WaitWithDefaultTimeout()
+		Expect(wait.ExitCode()).To(Equal(0))
+	})
+
+	It("podman wait --condition healthy", func() {
+		session := podmanTest.Podman([]string{"run", "-d", "--name", "test", "--health-cmd", "true", ALPINE, "top"})
+		session.WaitWithDefaultTimeout()
+		Expect(session.ExitCode()).To(Equal(0))
+
+		wait := podmanTest.Podman([]string{"wait", "--condition", "healthy", "test"})
+		hc := podmanTest.Podman([]string{"healthcheck", "run", "test"})
+		hc.WaitWithDefaultTimeout()
+		Expect(hc.ExitCode()).To(Equal(0))
+		wait.WaitWithDefaultTimeout()
+		Expect(wait.ExitCode()).To(Equal(0))
+		Expect(wait.OutputToString()).To(Equal("-1"))
+	})
+
+	It("podman wait --condition healthy without healthcheck", func() {
+		session := podmanTest.RunTopContainer("test")
+		session.WaitWithDefaultTimeout()
+		Expect(session.ExitCode()).To(Equal(0))
+
+		wait := podmanTest.Podman([]string{"wait", "--condition", "healthy", "test"})
+		wait.WaitWithDefaultTimeout()
+		Expect(wait.ExitCode()).To(Equal(125))
+	})
+
+	It("podman wait with bogus condition", func() {
+		session := podmanTest.RunTopContainer("test")
+		session.WaitWithDefaultTimeout()
+		Expect(session.ExitCode()).To(Equal(0))
+
+		wait := podmanTest.Podman([]string{"wait", "--condition", "bogus", "test"})
+		wait.WaitWithDefaultTimeout()
+		Expect(wait.ExitCode()).To(Equal(125))
+	})
 })
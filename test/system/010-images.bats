@@ -241,4 +241,64 @@ Labels.created_at | 20[0-9-]\\\+T[0-9:]\\\+Z
 
     run_podman rmi test:1.0
 }
+
+@test "podman system service --registry - pull through local storage" {
+    skip_if_remote "the registry serves the local storage of the service"
+
+    # FIXME: randomize port
+    local port=5099
+    local regroot=${PODMAN_TMPDIR}/registry-client
+
+    $PODMAN system service --time 0 --registry tcp:127.0.0.1:$port &
+    local service_pid=$!
+
+    local timeout=10
+    while ! curl -s -f http://127.0.0.1:$port/v2/ >/dev/null; do
+        timeout=$((timeout - 1))
+        if [[ $timeout -eq 0 ]]; then
+            kill $service_pid
+            die "Timed out waiting for registry service"
+        fi
+        sleep 1
+    done
+
+    # Tags are listed by the path of the image within its registry...
+    run curl -s http://127.0.0.1:$port/v2/$PODMAN_TEST_IMAGE_USER/$PODMAN_TEST_IMAGE_NAME/tags/list
+    is "$output" ".*\"$PODMAN_TEST_IMAGE_TAG\".*" "tags of the test image"
+
+    # ...and the registry is read-only
+    run curl -s -o /dev/null -w "%{http_code}" -X DELETE \
+        http://127.0.0.1:$port/v2/$PODMAN_TEST_IMAGE_USER/$PODMAN_TEST_IMAGE_NAME/manifests/$PODMAN_TEST_IMAGE_TAG
+    is "$output" "405" "registry rejects deletions"
+
+    # Blobs are only served for the repository of their image
+    run_podman image inspect --format '{{.ID}}' $IMAGE
+    local config_digest=sha256:$output
+    run curl -s -o /dev/null -w "%{http_code}" \
+        http://127.0.0.1:$port/v2/$PODMAN_TEST_IMAGE_USER/$PODMAN_TEST_IMAGE_NAME/blobs/$config_digest
+    is "$output" "200" "config blob of the test image"
+    run curl -s -o /dev/null -w "%{http_code}" \
+        http://127.0.0.1:$port/v2/nonesuch/image/blobs/$config_digest
+    is "$output" "404" "config blob requested in another repository"
+
+    run_podman --root $regroot pull --tls-verify=false \
+        127.0.0.1:$port/$PODMAN_TEST_IMAGE_USER/$PODMAN_TEST_IMAGE_NAME:$PODMAN_TEST_IMAGE_TAG
+    run_podman inspect --format '{{.ID}}' $IMAGE
+    local iid=$output
+    run_podman --root $regroot inspect --format '{{.ID}}' \
+        127.0.0.1:$port/$PODMAN_TEST_IMAGE_USER/$PODMAN_TEST_IMAGE_NAME:$PODMAN_TEST_IMAGE_TAG
+    is "$output" "$iid" "pulled image has the ID of the local image"
+
+    kill $service_pid
+    wait $service_pid || true
+    run_podman --root $regroot rmi -a
+}
+
+@test "podman system service --registry - only loopback addresses by default" {
+    skip_if_remote "the registry serves the local storage of the service"
+
+    run_podman 125 system service --time 0 --registry tcp:0.0.0.0:5099
+    is "$output" ".*use --registry-public to listen on \"tcp:0.0.0.0:5099\"" \
+       "registry refuses non-loopback addresses"
+}
 # vim: filetype=sh
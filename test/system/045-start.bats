@@ -0,0 +1,43 @@
+#!/usr/bin/env bats
+
+load helpers
+
+@test "podman start --all --filter restart-policy" {
+    run_podman create --name c_always --restart always $IMAGE sleep 60
+    cid_always="$output"
+    run_podman create --name c_no $IMAGE sleep 60
+    run_podman run -d --name c_unless_stopped --restart unless-stopped $IMAGE sleep 60
+    run_podman stop -t 0 c_unless_stopped
+
+    # Explicitly stopped unless-stopped containers must stay stopped
+    run_podman start --all --filter restart-policy=always --filter restart-policy=unless-stopped
+    run_podman ps --format '{{.Names}}'
+    is "$output" "c_always" "only the container with the always policy is started"
+
+    run_podman start --all
+    if [[ "$output" =~ "$cid_always" ]]; then
+        die "start --all must skip the running container c_always"
+    fi
+    run_podman ps --format '{{.Names}}' --sort names
+    is "$output" "c_always.*c_no.*c_unless_stopped" "all containers are started"
+
+    run_podman 125 start --all c_no
+    is "$output" "Error: --all, --latest and containers cannot be used together" \
+       "--all with container names"
+
+    run_podman rm -f c_always c_no c_unless_stopped
+}
+
+@test "podman stop --system-shutdown keeps unless-stopped containers for the next boot" {
+    run_podman run -d --name c_unless_stopped --restart unless-stopped $IMAGE sleep 60
+
+    # This is what podman-restart.service does when it is stopped
+    run_podman stop -t 0 --system-shutdown c_unless_stopped
+    run_podman start --all --filter restart-policy=always --filter restart-policy=unless-stopped
+    run_podman ps --format '{{.Names}}'
+    is "$output" "c_unless_stopped" "container stopped at shutdown is started again"
+
+    run_podman rm -f c_unless_stopped
+}
+
+# vim: filetype=sh
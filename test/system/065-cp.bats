@@ -179,6 +179,77 @@ load helpers
 }
 
 
+@test "podman cp file from container to container" {
+    # Create 3 files with random content in the source container.
+    local -a randomcontent=(
+        random-0-$(random_string 10)
+        random-1-$(random_string 15)
+        random-2-$(random_string 20)
+    )
+    run_podman run -d --name cpcontainer-src --workdir=/srv $IMAGE sleep infinity
+    run_podman exec cpcontainer-src sh -c "echo ${randomcontent[0]} > /tmp/containerfile"
+    run_podman exec cpcontainer-src sh -c "echo ${randomcontent[1]} > /srv/containerfile1"
+    run_podman exec cpcontainer-src sh -c "mkdir /srv/subdir; echo ${randomcontent[2]} > /srv/subdir/containerfile2"
+
+    run_podman run -d --name cpcontainer-dest --workdir=/srv $IMAGE sleep infinity
+    run_podman exec cpcontainer-dest mkdir /srv/subdir
+
+    # format is: <id> | <source arg to cp> | <destination arg to cp> | <full dest path> | <test name>
+    tests="
+0 | /tmp/containerfile    | /tmp/                 | /tmp/containerfile          | copy to /tmp/
+0 | /tmp/containerfile    | /tmp/.                | /tmp/containerfile          | copy to /tmp/.
+0 | /tmp/containerfile    | /tmp/newfile          | /tmp/newfile                | copy to /tmp, new name
+1 | containerfile1        | .                     | /srv/containerfile1         | copy from workdir to workdir (rel path)
+2 | subdir/containerfile2 | subdir                | /srv/subdir/containerfile2  | copy to workdir/subdir (rel path)
+2 | subdir/containerfile2 | subdir/containerfile1 | /srv/subdir/containerfile1  | copy to workdir/subdir (rel path), new name
+"
+
+    while read id src dest dest_fullname description; do
+        run_podman cp cpcontainer-src:$src cpcontainer-dest:$dest
+        run_podman exec cpcontainer-dest cat $dest_fullname
+        is "$output" "${randomcontent[$id]}" "$description (cp ctr:$src -> ctr:$dest)"
+        run_podman exec cpcontainer-dest rm $dest_fullname
+    done < <(parse_table "$tests")
+
+    # The ownership of the files is preserved.
+    run_podman exec cpcontainer-src chown 1234:5678 /tmp/containerfile
+    run_podman cp cpcontainer-src:/tmp/containerfile cpcontainer-dest:/tmp
+    run_podman exec cpcontainer-dest stat -c %u:%g /tmp/containerfile
+    is "$output" "1234:5678" "ownership of the copied file"
+
+    # Source path does not exist.
+    run_podman 125 cp cpcontainer-src:/IdoNotExist cpcontainer-dest:/tmp
+    is "$output" 'Error: "/IdoNotExist" could not be found on container cpcontainer-src: No such file or directory' \
+       "copy nonexistent path from container"
+
+    run_podman rm -f cpcontainer-src cpcontainer-dest
+}
+
+
+@test "podman cp dir from container to container" {
+    run_podman run -d --name cpcontainer-src --workdir=/srv $IMAGE sleep infinity
+    run_podman exec cpcontainer-src sh -c 'mkdir /srv/subdir; echo "This first file is on the container" > /srv/subdir/containerfile1'
+    run_podman exec cpcontainer-src sh -c 'echo "This second file is on the container as well" > /srv/subdir/containerfile2'
+
+    run_podman run -d --name cpcontainer-dest $IMAGE sleep infinity
+
+    run_podman cp cpcontainer-src:/srv cpcontainer-dest:/tmp
+    run_podman exec cpcontainer-dest cat /tmp/srv/subdir/containerfile1
+    is "$output" "This first file is on the container"
+    run_podman exec cpcontainer-dest cat /tmp/srv/subdir/containerfile2
+    is "$output" "This second file is on the container as well"
+    run_podman exec cpcontainer-dest rm -rf /tmp/srv
+
+    run_podman cp cpcontainer-src:/srv/subdir/. cpcontainer-dest:/tmp
+    run_podman exec cpcontainer-dest cat /tmp/containerfile1
+    is "$output" "This first file is on the container"
+    run_podman exec cpcontainer-dest cat /tmp/containerfile2
+    is "$output" "This second file is on the container as well"
+
+    run_podman rm -f cpcontainer-src cpcontainer-dest
+}
+
+
 @test "podman cp file from host to container volume" {
     srcdir=$PODMAN_TMPDIR/cp-test-volume
     mkdir -p $srcdir
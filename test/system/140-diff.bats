@@ -54,4 +54,36 @@ load helpers
     buildah rm buildahctr
 }
 
+@test "podman diff between images with details" {
+    n=$(random_string 10)          # container name
+    rand_file=$(random_string 10)
+    rand_content=$(random_string 20)
+    run_podman run --name $n $IMAGE sh -c "echo $rand_content > /$rand_file; chmod 0640 /$rand_file; chown 1:2 /$rand_file"
+    run_podman commit -q $n diff-test-$n
+    iid="$output"
+
+    run_podman diff $IMAGE $iid
+    is "$output" ".*A /$rand_file.*" "added file in diff between images"
+
+    run_podman diff --detail --format json $IMAGE $iid
+    entry=$(jq -c ".[] | select(.path == \"/$rand_file\")" <<<"$output")
+    is "$(jq -r .kind <<<"$entry")"       "1"          "kind of added file"
+    is "$(jq -r .before <<<"$entry")"     "null"       "no details before adding file"
+    is "$(jq -r .after.size <<<"$entry")" "21"         "size of added file"
+    is "$(jq -r .after.mode <<<"$entry")" "-rw-r-----" "mode of added file"
+    is "$(jq -r '.after.uid,.after.gid' <<<"$entry" | tr '\n' ' ')" "1 2 " "owner of added file"
+    expect_digest=$(echo $rand_content | sha256sum | awk '{print $1}')
+    is "$(jq -r .after.digest <<<"$entry")" "sha256:$expect_digest" "digest of added file"
+
+    # The other way round, the file is deleted
+    run_podman diff --detail --format json $iid $IMAGE
+    entry=$(jq -c ".[] | select(.path == \"/$rand_file\")" <<<"$output")
+    is "$(jq -r .kind <<<"$entry")"        "2"          "kind of deleted file"
+    is "$(jq -r .before.mode <<<"$entry")" "-rw-r-----" "mode of deleted file"
+    is "$(jq -r .after <<<"$entry")"       "null"       "no details after deleting file"
+
+    run_podman rm $n
+    run_podman rmi $iid
+}
+
 # vim: filetype=sh
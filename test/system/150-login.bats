@@ -164,6 +164,64 @@ function setup() {
     is "$output" "{}" "credentials removed from $authfile"
 }
 
+@test "podman login - credential helper" {
+    authfile=${PODMAN_LOGIN_WORKDIR}/auth-$(random_string 10).json
+    helperdir=${PODMAN_LOGIN_WORKDIR}/credhelper-$(random_string 10)
+    mkdir -p $helperdir/store
+
+    registry=localhost:${PODMAN_LOGIN_REGISTRY_PORT}
+
+    # Minimal credential helper keeping one file per server
+    cat >$helperdir/docker-credential-podmantest <<EOF
+#!/bin/bash
+case "\$1" in
+    store) input=\$(cat); server=\$(jq -r .ServerURL <<<"\$input")
+           echo "\$input" >"$helperdir/store/\$server" ;;
+    get)   server=\$(cat)
+           if [ ! -e "$helperdir/store/\$server" ]; then
+               echo "credentials not found in native keychain"; exit 1
+           fi
+           cat "$helperdir/store/\$server" ;;
+    erase) rm "$helperdir/store/\$(cat)" ;;
+esac
+EOF
+    chmod 755 $helperdir/docker-credential-podmantest
+
+    cat >$authfile <<EOF
+{
+    "auths": {},
+    "credHelpers": {
+            "$registry": "podmantest"
+    }
+}
+EOF
+
+    PATH=$helperdir:$PATH run_podman login --authfile=$authfile \
+        --tls-verify=false \
+        --username ${PODMAN_LOGIN_USER} \
+        --password ${PODMAN_LOGIN_PASS} \
+        $registry
+    is "$output" "Login Succeeded!" "output from podman login"
+
+    # Credentials are stored by the helper, not in the authfile
+    run jq -r '.auths' <$authfile
+    is "$output" "{}" "no credentials stored in $authfile"
+    run jq -r .Secret <$helperdir/store/$registry
+    is "$output" "${PODMAN_LOGIN_PASS}" "credentials stored by helper"
+
+    PATH=$helperdir:$PATH run_podman login --authfile=$authfile --get-login $registry
+    is "$output" "${PODMAN_LOGIN_USER}" "podman login --get-login"
+
+    PATH=$helperdir:$PATH run_podman logout --authfile=$authfile $registry
+    test ! -e $helperdir/store/$registry || \
+        die "podman logout did not erase credentials from helper"
+
+    PATH=$helperdir:$PATH run_podman 125 login --authfile=$authfile --get-login $registry
+    is "$output" "Error: not logged into $registry" "podman login --get-login after logout"
+
+    rm -rf $authfile $helperdir
+}
+
 # Some push tests
 @test "podman push fail" {
 
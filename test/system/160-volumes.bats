@@ -247,4 +247,45 @@ EOF
 }
 
 
+# Export a volume and import it into another one
+@test "podman volume export/import" {
+    myvolume=myvol$(random_string)
+    myvolume2=myvol2$(random_string)
+    mytext=$(random_string)
+
+    run_podman volume create $myvolume
+    run_podman run --rm -v $myvolume:/vol:z $IMAGE sh -c "echo $mytext >/vol/file"
+
+    run_podman volume export $myvolume --output=$PODMAN_TMPDIR/vol.tar
+    tar tf $PODMAN_TMPDIR/vol.tar | grep -q file || die "file missing from exported archive"
+
+    run_podman volume create $myvolume2
+    run_podman volume import $myvolume2 $PODMAN_TMPDIR/vol.tar
+    run_podman run --rm -v $myvolume2:/vol:z $IMAGE cat /vol/file
+    is "$output" "$mytext" "contents of the imported volume"
+
+    run_podman volume rm $myvolume $myvolume2
+}
+
+# Symlinks in the volume must not redirect an import to the host
+@test "podman volume import does not follow symlinks out of the volume" {
+    myvolume=myvol$(random_string)
+    hostdir=$PODMAN_TMPDIR/hostdir
+    mkdir -p $hostdir
+
+    run_podman volume create $myvolume
+    run_podman run --rm -v $myvolume:/vol:z $IMAGE ln -s $hostdir /vol/dir
+
+    mkdir -p $PODMAN_TMPDIR/src/dir
+    echo evil >$PODMAN_TMPDIR/src/dir/file
+    tar -C $PODMAN_TMPDIR/src -cf $PODMAN_TMPDIR/evil.tar dir/file
+
+    # Whether the import fails or not, nothing may be written to the host
+    run_podman '?' volume import $myvolume $PODMAN_TMPDIR/evil.tar
+    test -e $hostdir/file && die "volume import wrote $hostdir/file on the host"
+
+    run_podman volume rm $myvolume
+}
+
+
 # vim: filetype=sh
@@ -25,7 +25,7 @@ function setup() {
 
 function teardown() {
     run '?' $SYSTEMCTL stop "$SERVICE_NAME"
-    rm -f "$UNIT_FILE"
+    rm -f "$UNIT_FILE" "$UNIT_DIR/${SERVICE_NAME}-kube@.service"
     $SYSTEMCTL daemon-reload
     basic_teardown
 }
@@ -81,4 +81,65 @@ function teardown() {
     $SYSTEMCTL daemon-reload
 }
 
+@test "podman-kube@.service template" {
+    template=$BATS_TEST_DIRNAME/../../contrib/systemd/system/podman-kube@.service
+    if [ ! -e $template ]; then
+        skip "podman-kube@.service template not found"
+    fi
+
+    # podman initializes this if unset, but systemctl doesn't
+    if is_rootless; then
+        if [ -z "$XDG_RUNTIME_DIR" ]; then
+            export XDG_RUNTIME_DIR=/run/user/$(id -u)
+        fi
+    fi
+
+    # Install the template under a unique name, running the podman under test
+    local template_name="${SERVICE_NAME}-kube@"
+    local template_file="$UNIT_DIR/$template_name.service"
+    sed -e "s;/usr/bin/podman;$(realpath $(command -v $PODMAN));g" <$template >$template_file
+    $SYSTEMCTL daemon-reload
+
+    local yaml=$PODMAN_TMPDIR/test.yaml
+    cat >$yaml <<EOF
+apiVersion: v1
+kind: Pod
+metadata:
+  annotations:
+    io.containers.autoupdate: image
+  name: test_pod
+spec:
+  containers:
+  - command:
+    - top
+    image: $IMAGE
+    name: test
+EOF
+
+    local unit="$template_name$(systemd-escape $yaml).service"
+    run $SYSTEMCTL start "$unit"
+    if [ $status -ne 0 ]; then
+        die "Error starting systemd unit $unit, output: $output"
+    fi
+
+    run $SYSTEMCTL is-active "$unit"
+    is "$output" "active" "systemd unit $unit is active"
+
+    run_podman container inspect --format "{{.State.Running}}" test_pod-test
+    is "$output" "true" "container started by the unit is running"
+
+    run_podman container inspect --format '{{index .Config.Labels "io.containers.autoupdate"}} {{index .Config.Labels "PODMAN_SYSTEMD_UNIT"}}' test_pod-test
+    is "$output" "image $unit" "container labels for auto-update"
+
+    run $SYSTEMCTL stop "$unit"
+    if [ $status -ne 0 ]; then
+        die "Error stopping systemd unit $unit, output: $output"
+    fi
+
+    run_podman 1 pod exists test_pod
+
+    rm -f $template_file
+    $SYSTEMCTL daemon-reload
+}
+
 # vim: filetype=sh
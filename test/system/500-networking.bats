@@ -80,7 +80,8 @@ load helpers
     run_podman rm $cid
 }
 
-# "network create" now works rootless, with the help of a special container
+# "network create" works rootless, the CNI plugins run in a network namespace
+# shared by all rootless containers
 @test "podman network create" {
     skip_if_remote "FIXME: pending #7808"
 
@@ -107,13 +108,30 @@ load helpers
 
     run_podman network rm $mynetname
     run_podman 1 network rm $mynetname
+}
 
-    # rootless CNI leaves behind an image pulled by SHA, hence with no tag.
-    # Remove it if present; we can only remove it by ID.
-    run_podman images --format '{{.Id}}' rootless-cni-infra
-    if [ -n "$output" ]; then
-        run_podman rmi $output
-    fi
+@test "podman network: containers on the same network can connect" {
+    skip_if_remote "FIXME: pending #7808"
+
+    local mynetname=testnet-$(random_string 10)
+    local mysubnet=$(random_rfc1918_subnet)
+    run_podman network create --subnet "${mysubnet}.0/24" $mynetname
+
+    run_podman run -d --network $mynetname $IMAGE nc -l -p 5555
+    cid="$output"
+    run_podman inspect $cid --format "{{(index .NetworkSettings.Networks \"$mynetname\").IPAddress}}"
+    ip="$output"
+    is "$ip" "${mysubnet}\.[0-9]\+" "IP address of the server container"
+
+    # The server exits as soon as 'nc' receives input
+    teststring=$(random_string 30)
+    run_podman run --rm --network $mynetname $IMAGE sh -c "echo $teststring | nc $ip 5555"
+    run_podman wait $cid
+    run_podman logs $cid
+    is "$output" "$teststring" "test string received on container"
+
+    run_podman rm $cid
+    run_podman network rm $mynetname
 }
 
 @test "podman network reload" {